@@ -0,0 +1,64 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AssumeRoleGrantSpec defines the desired state of AssumeRoleGrant
+type AssumeRoleGrantSpec struct {
+	// IAMRoleARN is the ARN of IAM role that TargetGroupBindings in the selected namespaces are permitted to assume.
+	// +kubebuilder:validation:Pattern="^arn:[^:]+:iam::[0-9]{12}:role/.+"
+	IAMRoleARN string `json:"iamRoleArn"`
+
+	// AssumeRoleExternalID is the external ID that TargetGroupBindings must pass when assuming the IAM role.
+	// When unspecified, any external ID is permitted.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
+
+	// NamespaceSelector selects the namespaces whose TargetGroupBindings are permitted to assume the IAM role.
+	// An empty selector selects all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.iamRoleArn",description="The IAM role permitted to be assumed"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// AssumeRoleGrant is the Schema for the AssumeRoleGrant API.
+// It permits TargetGroupBindings in selected namespaces to assume an IAM role, which is denied otherwise.
+type AssumeRoleGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AssumeRoleGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AssumeRoleGrantList contains a list of AssumeRoleGrant
+type AssumeRoleGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AssumeRoleGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AssumeRoleGrant{}, &AssumeRoleGrantList{})
+}
@@ -0,0 +1,77 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackendGrantFrom describes the namespace whose Ingresses and ListenerActions are permitted to reference Services.
+type BackendGrantFrom struct {
+	// Namespace is the namespace of the referencing Ingresses and ListenerActions.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// BackendGrantTo describes the Services that may be referenced.
+type BackendGrantTo struct {
+	// Name is the name of the Service in the same namespace as the BackendGrant.
+	// When unspecified, all Services in the namespace may be referenced.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// BackendGrantSpec defines the desired state of BackendGrant
+type BackendGrantSpec struct {
+	// From describes the namespaces permitted to reference Services in the namespace of BackendGrant.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	From []BackendGrantFrom `json:"from"`
+
+	// To describes the Services permitted to be referenced.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	To []BackendGrantTo `json:"to"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// BackendGrant is the Schema for the BackendGrant API.
+// It permits Ingresses and ListenerActions in other namespaces to use Services in its namespace as backend.
+type BackendGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BackendGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendGrantList contains a list of BackendGrant
+type BackendGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackendGrant{}, &BackendGrantList{})
+}
@@ -41,6 +41,20 @@ const (
 	LoadBalancerSchemeInternetFacing LoadBalancerScheme = "internet-facing"
 )
 
+// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+// LoadBalancerDeletionPolicy is the policy for AWS resources of load balancer once it's no longer desired.
+//
+// * Delete deletes the load balancer along with its target groups and security groups.
+// * Retain keeps them with deletion protection enabled, so that they can be reused by a later Ingress or Service with the same name.
+// * Orphan keeps them as is, and stops tracking them.
+type LoadBalancerDeletionPolicy string
+
+const (
+	LoadBalancerDeletionPolicyDelete LoadBalancerDeletionPolicy = "Delete"
+	LoadBalancerDeletionPolicyRetain LoadBalancerDeletionPolicy = "Retain"
+	LoadBalancerDeletionPolicyOrphan LoadBalancerDeletionPolicy = "Orphan"
+)
+
 // SubnetID specifies a subnet ID.
 // +kubebuilder:validation:Pattern=subnet-[0-9a-f]+
 type SubnetID string
@@ -84,6 +98,207 @@ type Attribute struct {
 	Value string `json:"value"`
 }
 
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+// ListenerProtocol is the protocol of an Ingress listener.
+type ListenerProtocol string
+
+const (
+	ListenerProtocolHTTP  ListenerProtocol = "HTTP"
+	ListenerProtocolHTTPS ListenerProtocol = "HTTPS"
+)
+
+// ListenPort defines a listener port and protocol.
+type ListenPort struct {
+	// The port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// The protocol of the listener.
+	Protocol ListenerProtocol `json:"protocol"`
+}
+
+// CertificateSelector selects the certificates for HTTPS listeners.
+type CertificateSelector struct {
+	// ARNs specifies the ACM or IAM certificate ARNs. The first one is used as the default certificate.
+	// +optional
+	ARNs []string `json:"arns,omitempty"`
+
+	// Hostnames specifies the hostnames to discover matching ACM certificates for.
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// DefaultAction defines the action for requests that don't match any Ingress rule.
+// Exactly one of fixedResponse or redirect must be specified.
+type DefaultAction struct {
+	// FixedResponse returns a custom HTTP response.
+	// +optional
+	FixedResponse *FixedResponseActionConfig `json:"fixedResponse,omitempty"`
+
+	// Redirect redirects the request to a different URL.
+	// +optional
+	Redirect *RedirectActionConfig `json:"redirect,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=off;passthrough;verify
+// MutualAuthenticationMode is the mutual TLS authentication mode of a listener.
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// MutualAuthenticationAttributes defines the mutual TLS authentication settings of an HTTPS listener.
+type MutualAuthenticationAttributes struct {
+	// Port is the port of the HTTPS listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Mode is the mutual TLS authentication mode.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// TrustStore is the name or ARN of the trust store, required in verify mode.
+	// +optional
+	TrustStore *string `json:"trustStore,omitempty"`
+
+	// IgnoreClientCertificateExpiry indicates whether expired client certificates are ignored.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// TargetGroupStringParam defines a string target group setting.
+type TargetGroupStringParam struct {
+	// Value is the value of the setting, in the same format as the corresponding annotation.
+	Value string `json:"value"`
+
+	// Enforced indicates whether Value takes precedence over Service and Ingress annotations.
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+}
+
+// TargetGroupInt64Param defines an integer target group setting.
+type TargetGroupInt64Param struct {
+	// Value is the value of the setting.
+	Value int64 `json:"value"`
+
+	// Enforced indicates whether Value takes precedence over Service and Ingress annotations.
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+}
+
+// TargetGroupAttributeParam defines a target group attribute.
+type TargetGroupAttributeParam struct {
+	// The key of the attribute.
+	Key string `json:"key"`
+
+	// The value of the attribute.
+	Value string `json:"value"`
+
+	// Enforced indicates whether Value takes precedence over Service and Ingress annotations.
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+}
+
+// TargetGroupParams defines the target group settings.
+// Each setting is a default for Service and Ingress annotations, unless it's enforced.
+type TargetGroupParams struct {
+	// TargetType is the target type of target groups, either instance or ip.
+	// +optional
+	TargetType *TargetGroupStringParam `json:"targetType,omitempty"`
+
+	// BackendProtocol is the protocol used to route traffic to targets, either HTTP or HTTPS.
+	// +optional
+	BackendProtocol *TargetGroupStringParam `json:"backendProtocol,omitempty"`
+
+	// BackendProtocolVersion is the protocol version used to route traffic to targets, one of HTTP1, HTTP2 or GRPC.
+	// +optional
+	BackendProtocolVersion *TargetGroupStringParam `json:"backendProtocolVersion,omitempty"`
+
+	// HealthCheckPort is the port used for health checks, either a port number, a named Service port or traffic-port.
+	// +optional
+	HealthCheckPort *TargetGroupStringParam `json:"healthCheckPort,omitempty"`
+
+	// HealthCheckProtocol is the protocol used for health checks, either HTTP or HTTPS.
+	// +optional
+	HealthCheckProtocol *TargetGroupStringParam `json:"healthCheckProtocol,omitempty"`
+
+	// HealthCheckPath is the path used for health checks.
+	// +optional
+	HealthCheckPath *TargetGroupStringParam `json:"healthCheckPath,omitempty"`
+
+	// SuccessCodes are the HTTP or gRPC status codes of healthy targets.
+	// +optional
+	SuccessCodes *TargetGroupStringParam `json:"successCodes,omitempty"`
+
+	// HealthCheckIntervalSeconds is the approximate interval between health checks.
+	// +optional
+	HealthCheckIntervalSeconds *TargetGroupInt64Param `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// HealthCheckTimeoutSeconds is the timeout of a health check.
+	// +optional
+	HealthCheckTimeoutSeconds *TargetGroupInt64Param `json:"healthCheckTimeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of consecutive successful health checks before a target is healthy.
+	// +optional
+	HealthyThresholdCount *TargetGroupInt64Param `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of consecutive failed health checks before a target is unhealthy.
+	// +optional
+	UnhealthyThresholdCount *TargetGroupInt64Param `json:"unhealthyThresholdCount,omitempty"`
+
+	// Attributes are the target group attributes, such as deregistration_delay.timeout_seconds,
+	// slow_start.duration_seconds or load_balancing.algorithm.type.
+	// +optional
+	Attributes []TargetGroupAttributeParam `json:"attributes,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=group;scheme;inboundCIDRs;sslPolicy;subnets;ipAddressType;tags;loadBalancerAttributes;listenPorts;sslRedirectPort;certificates;defaultAction;mutualAuthentication;targetGroup
+// TenantOverrideField is a field of NamespacedIngressClassParams that tenants may override.
+type TenantOverrideField string
+
+const (
+	TenantOverrideFieldGroup                  TenantOverrideField = "group"
+	TenantOverrideFieldScheme                 TenantOverrideField = "scheme"
+	TenantOverrideFieldInboundCIDRs           TenantOverrideField = "inboundCIDRs"
+	TenantOverrideFieldSSLPolicy              TenantOverrideField = "sslPolicy"
+	TenantOverrideFieldSubnets                TenantOverrideField = "subnets"
+	TenantOverrideFieldIPAddressType          TenantOverrideField = "ipAddressType"
+	TenantOverrideFieldTags                   TenantOverrideField = "tags"
+	TenantOverrideFieldLoadBalancerAttributes TenantOverrideField = "loadBalancerAttributes"
+	TenantOverrideFieldListenPorts            TenantOverrideField = "listenPorts"
+	TenantOverrideFieldSSLRedirectPort        TenantOverrideField = "sslRedirectPort"
+	TenantOverrideFieldCertificates           TenantOverrideField = "certificates"
+	TenantOverrideFieldDefaultAction          TenantOverrideField = "defaultAction"
+	TenantOverrideFieldMutualAuthentication   TenantOverrideField = "mutualAuthentication"
+	TenantOverrideFieldTargetGroup            TenantOverrideField = "targetGroup"
+)
+
+// TenantOverride defines a field that tenants may override.
+type TenantOverride struct {
+	// Field is the field of NamespacedIngressClassParams.
+	Field TenantOverrideField `json:"field"`
+
+	// AllowedValues restricts the values tenants may set for the field. If empty, any value is allowed.
+	// Only supported for group, scheme, inboundCIDRs, sslPolicy, ipAddressType and sslRedirectPort.
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// TenantPolicy defines which fields tenants may override via NamespacedIngressClassParams.
+type TenantPolicy struct {
+	// IngressClassNames are the IngressClasses referencing NamespacedIngressClassParams that this policy applies to.
+	// +kubebuilder:validation:MinItems=1
+	IngressClassNames []string `json:"ingressClassNames"`
+
+	// AllowedOverrides are the fields tenants may override.
+	// +optional
+	AllowedOverrides []TenantOverride `json:"allowedOverrides,omitempty"`
+}
+
 // IngressClassParamsSpec defines the desired state of IngressClassParams
 type IngressClassParamsSpec struct {
 	// NamespaceSelector restrict the namespaces of Ingresses that are allowed to specify the IngressClass with this IngressClassParams.
@@ -121,6 +336,58 @@ type IngressClassParamsSpec struct {
 	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this IngressClassParams.
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// ListenPorts defines the listen ports allowed for Ingresses that belong to IngressClass with this IngressClassParams.
+	// * if an Ingress doesn't specify listen-ports annotation, it listens on all of these ports.
+	// * if an Ingress specifies listen-ports annotation, each of its ports must be one of these ports.
+	// +optional
+	ListenPorts []ListenPort `json:"listenPorts,omitempty"`
+
+	// SSLRedirectPort enforces HTTP to HTTPS redirection to this port for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	SSLRedirectPort *int32 `json:"sslRedirectPort,omitempty"`
+
+	// Certificates defines the certificates for HTTPS listeners of all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	Certificates *CertificateSelector `json:"certificates,omitempty"`
+
+	// DefaultAction defines the action for requests that don't match any rule of Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	DefaultAction *DefaultAction `json:"defaultAction,omitempty"`
+
+	// MutualAuthentication defines the mutual TLS authentication settings for HTTPS listeners of all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	MutualAuthentication []MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
+	// TargetGroup defines the target group settings for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	TargetGroup *TargetGroupParams `json:"targetGroup,omitempty"`
+
+	// DeletionPolicy defines the deletion policy of load balancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	DeletionPolicy *LoadBalancerDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptableLoadBalancers are the ARNs of existing load balancers that Ingresses that belong to IngressClass with this IngressClassParams
+	// may adopt via the load-balancer-arn annotation. Adopting load balancers that are not listed is denied.
+	// +optional
+	AdoptableLoadBalancers []string `json:"adoptableLoadBalancers,omitempty"`
+
+	// TenantPolicy makes this IngressClassParams the base settings for IngressClasses with namespace-scoped NamespacedIngressClassParams,
+	// and defines which fields tenants may override.
+	// +optional
+	TenantPolicy *TenantPolicy `json:"tenantPolicy,omitempty"`
+
+	// IAMRoleARNToAssume is the ARN of IAM role to assume for provisioning AWS resources of Ingresses that belong to IngressClass with this IngressClassParams,
+	// e.g. to provision load balancers in another AWS account or a shared VPC. The controller's own credentials are used if unspecified.
+	// +kubebuilder:validation:Pattern="^arn:[^:]+:iam::[0-9]{12}:role/.+"
+	// +optional
+	IAMRoleARNToAssume string `json:"iamRoleArnToAssume,omitempty"`
+
+	// AssumeRoleExternalID is the external ID to pass when assuming IAMRoleARNToAssume.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,284 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+// ListenerActionType is the type of action performed by ListenerAction.
+// Authenticate actions are not supported as a type, they're configured via authenticateConfig and performed before the action instead.
+type ListenerActionType string
+
+const (
+	ListenerActionTypeForward       ListenerActionType = "forward"
+	ListenerActionTypeRedirect      ListenerActionType = "redirect"
+	ListenerActionTypeFixedResponse ListenerActionType = "fixed-response"
+)
+
+// FixedResponseActionConfig defines an action that returns a custom HTTP response.
+type FixedResponseActionConfig struct {
+	// The content type.
+	// +kubebuilder:validation:Enum=text/plain;text/css;text/html;application/javascript;application/json
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The message.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+
+	// The HTTP response code.
+	// +kubebuilder:validation:Pattern=`^(2|4|5)\d\d$`
+	StatusCode string `json:"statusCode"`
+}
+
+// RedirectActionConfig defines a redirect action.
+type RedirectActionConfig struct {
+	// The hostname.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path, starting with the leading "/".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The port.
+	// +kubebuilder:validation:Pattern=`^(#\{port\}|[1-9][0-9]{0,4})$`
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The protocol.
+	// +kubebuilder:validation:Pattern=`^(HTTPS?|#\{protocol\})$`
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The query parameters.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// The HTTP redirect code.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// TargetGroupTuple defines how traffic will be distributed to a target group in a forward action.
+// Exactly one of targetGroupARN or serviceName must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.targetGroupARN) != has(self.serviceName)",message="exactly one of targetGroupARN and serviceName must be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceName) || has(self.servicePort)",message="servicePort is required when serviceName is specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceNamespace) || has(self.serviceName)",message="serviceName is required when serviceNamespace is specified"
+type TargetGroupTuple struct {
+	// The Amazon Resource Name (ARN) of the target group.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupARN,omitempty"`
+
+	// The name of the Kubernetes Service.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// The namespace of the Kubernetes Service, defaults to the namespace of ListenerAction.
+	// A Service in another namespace can only be referenced when permitted by a BackendGrant in that namespace.
+	// +optional
+	ServiceNamespace *string `json:"serviceNamespace,omitempty"`
+
+	// The port of the Kubernetes Service. Required when serviceName is specified.
+	// +optional
+	ServicePort *intstr.IntOrString `json:"servicePort,omitempty"`
+
+	// The weight. Required when forwarding to multiple target groups.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// TargetGroupStickinessConfig defines the target group stickiness for a forward action.
+type TargetGroupStickinessConfig struct {
+	// Indicates whether target group stickiness is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The time period, in seconds, during which requests from a client should be routed to the same target group.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
+// ForwardActionConfig defines an action that distributes requests among one or more target groups.
+type ForwardActionConfig struct {
+	// One or more target groups.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	TargetGroups []TargetGroupTuple `json:"targetGroups"`
+
+	// The target group stickiness for the rule.
+	// +optional
+	TargetGroupStickinessConfig *TargetGroupStickinessConfig `json:"targetGroupStickinessConfig,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=cognito;oidc
+// AuthenticateType is the identity provider type of authenticate action.
+type AuthenticateType string
+
+const (
+	AuthenticateTypeCognito AuthenticateType = "cognito"
+	AuthenticateTypeOIDC    AuthenticateType = "oidc"
+)
+
+// +kubebuilder:validation:Enum=authenticate;allow;deny
+// AuthenticateOnUnauthenticatedRequest is the behavior if the user is not authenticated.
+type AuthenticateOnUnauthenticatedRequest string
+
+// AuthenticateCognitoConfig defines the Amazon Cognito identity provider.
+type AuthenticateCognitoConfig struct {
+	// The Amazon Resource Name (ARN) of the Amazon Cognito user pool.
+	UserPoolARN string `json:"userPoolARN"`
+
+	// The ID of the Amazon Cognito user pool client.
+	UserPoolClientID string `json:"userPoolClientID"`
+
+	// The domain prefix or fully-qualified domain name of the Amazon Cognito user pool.
+	UserPoolDomain string `json:"userPoolDomain"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +kubebuilder:validation:MaxProperties=10
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthenticateOIDCConfig defines the OpenID Connect identity provider.
+type AuthenticateOIDCConfig struct {
+	// The OIDC issuer identifier of the IdP.
+	Issuer string `json:"issuer"`
+
+	// The authorization endpoint of the IdP.
+	AuthorizationEndpoint string `json:"authorizationEndpoint"`
+
+	// The token endpoint of the IdP.
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// The user info endpoint of the IdP.
+	UserInfoEndpoint string `json:"userInfoEndpoint"`
+
+	// The name of the Kubernetes Secret in the same namespace that contains clientID and clientSecret.
+	SecretName string `json:"secretName"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +kubebuilder:validation:MaxProperties=10
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthenticateActionConfig defines an authenticate action performed before the ListenerAction on HTTPS listeners.
+// Only the identity provider configuration matching type can be specified.
+// +kubebuilder:validation:XValidation:rule="self.type != 'cognito' || has(self.cognito)",message="cognito is required when type is cognito"
+// +kubebuilder:validation:XValidation:rule="self.type != 'oidc' || has(self.oidc)",message="oidc is required when type is oidc"
+// +kubebuilder:validation:XValidation:rule="self.type == 'cognito' || !has(self.cognito)",message="cognito can only be specified when type is cognito"
+// +kubebuilder:validation:XValidation:rule="self.type == 'oidc' || !has(self.oidc)",message="oidc can only be specified when type is oidc"
+type AuthenticateActionConfig struct {
+	// Type is the identity provider type.
+	Type AuthenticateType `json:"type"`
+
+	// Cognito configures the Amazon Cognito identity provider. Required when type is cognito.
+	// +optional
+	Cognito *AuthenticateCognitoConfig `json:"cognito,omitempty"`
+
+	// OIDC configures the OpenID Connect identity provider. Required when type is oidc.
+	// +optional
+	OIDC *AuthenticateOIDCConfig `json:"oidc,omitempty"`
+
+	// The behavior if the user is not authenticated.
+	// +optional
+	OnUnauthenticatedRequest *AuthenticateOnUnauthenticatedRequest `json:"onUnauthenticatedRequest,omitempty"`
+
+	// The set of user claims to be requested from the IdP.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+
+	// The name of the cookie used to maintain session information.
+	// +optional
+	SessionCookieName *string `json:"sessionCookieName,omitempty"`
+
+	// The maximum duration of the authentication session, in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+}
+
+// ListenerActionSpec defines the desired state of ListenerAction
+// Only the action configuration matching type can be specified, along with an optional authenticateConfig.
+// +kubebuilder:validation:XValidation:rule="self.type != 'forward' || has(self.forwardConfig)",message="forwardConfig is required when type is forward"
+// +kubebuilder:validation:XValidation:rule="self.type != 'redirect' || has(self.redirectConfig)",message="redirectConfig is required when type is redirect"
+// +kubebuilder:validation:XValidation:rule="self.type != 'fixed-response' || has(self.fixedResponseConfig)",message="fixedResponseConfig is required when type is fixed-response"
+// +kubebuilder:validation:XValidation:rule="self.type == 'forward' || !has(self.forwardConfig)",message="forwardConfig can only be specified when type is forward"
+// +kubebuilder:validation:XValidation:rule="self.type == 'redirect' || !has(self.redirectConfig)",message="redirectConfig can only be specified when type is redirect"
+// +kubebuilder:validation:XValidation:rule="self.type == 'fixed-response' || !has(self.fixedResponseConfig)",message="fixedResponseConfig can only be specified when type is fixed-response"
+type ListenerActionSpec struct {
+	// Type is the type of action.
+	Type ListenerActionType `json:"type"`
+
+	// ForwardConfig configures a forward action. Required when type is forward.
+	// +optional
+	ForwardConfig *ForwardActionConfig `json:"forwardConfig,omitempty"`
+
+	// RedirectConfig configures a redirect action. Required when type is redirect.
+	// +optional
+	RedirectConfig *RedirectActionConfig `json:"redirectConfig,omitempty"`
+
+	// FixedResponseConfig configures a fixed-response action. Required when type is fixed-response.
+	// +optional
+	FixedResponseConfig *FixedResponseActionConfig `json:"fixedResponseConfig,omitempty"`
+
+	// AuthenticateConfig configures an authenticate action that is performed before this action on HTTPS listeners.
+	// When specified, it takes precedence over auth annotations on the Ingress and Services.
+	// +optional
+	AuthenticateConfig *AuthenticateActionConfig `json:"authenticateConfig,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.type",description="The action type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ListenerAction is the Schema for the ListenerAction API
+type ListenerAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ListenerActionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerActionList contains a list of ListenerAction
+type ListenerActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerAction `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ListenerAction{}, &ListenerActionList{})
+}
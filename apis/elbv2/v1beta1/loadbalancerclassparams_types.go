@@ -0,0 +1,144 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=instance;ip
+// LoadBalancerTargetType is the target type of TargetGroups for Services.
+type LoadBalancerTargetType string
+
+const (
+	LoadBalancerTargetTypeInstance LoadBalancerTargetType = "instance"
+	LoadBalancerTargetTypeIP       LoadBalancerTargetType = "ip"
+)
+
+// AccessLogsConfig defines the access logs configuration of load balancer.
+type AccessLogsConfig struct {
+	// Enabled specifies whether access logs are enabled.
+	Enabled bool `json:"enabled"`
+
+	// S3BucketName specifies the S3 bucket to store access logs.
+	// +optional
+	S3BucketName string `json:"s3BucketName,omitempty"`
+
+	// S3BucketPrefix specifies the prefix of access logs within the S3 bucket.
+	// +optional
+	S3BucketPrefix string `json:"s3BucketPrefix,omitempty"`
+}
+
+// LoadBalancerClassSettings defines the settings for Services that belong to a loadBalancerClass.
+type LoadBalancerClassSettings struct {
+	// Scheme defines the scheme of load balancer.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// Subnets defines the subnets of load balancer.
+	// +optional
+	Subnets *SubnetSelector `json:"subnets,omitempty"`
+
+	// IPAddressType defines the ip address type of load balancer.
+	// +optional
+	IPAddressType *IPAddressType `json:"ipAddressType,omitempty"`
+
+	// TargetType defines the target type of TargetGroups.
+	// +optional
+	TargetType *LoadBalancerTargetType `json:"targetType,omitempty"`
+
+	// LoadBalancerAttributes defines the custom attributes of load balancer.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// TargetGroupAttributes defines the custom attributes of TargetGroups.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// SecurityGroups defines the frontend security groups of load balancer, specified by name or ID.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// AccessLogs defines the access logs configuration of load balancer.
+	// +optional
+	AccessLogs *AccessLogsConfig `json:"accessLogs,omitempty"`
+
+	// Tags defines list of Tags on AWS resources.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// DeletionPolicy defines the deletion policy of load balancer.
+	// +optional
+	DeletionPolicy *LoadBalancerDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// LoadBalancerClassParamsSpec defines the desired state of LoadBalancerClassParams
+type LoadBalancerClassParamsSpec struct {
+	// LoadBalancerClass is the `spec.loadBalancerClass` of Services that this LoadBalancerClassParams applies to.
+	// +kubebuilder:validation:MinLength=1
+	LoadBalancerClass string `json:"loadBalancerClass"`
+
+	// Defaults defines the settings used when Service doesn't specify them via annotations.
+	// +optional
+	Defaults *LoadBalancerClassSettings `json:"defaults,omitempty"`
+
+	// Enforced defines the settings that take priority over annotations on Services.
+	// Services with annotations conflicting with these settings are rejected.
+	// +optional
+	Enforced *LoadBalancerClassSettings `json:"enforced,omitempty"`
+
+	// AdoptableLoadBalancers are the ARNs of existing load balancers that Services that this LoadBalancerClassParams applies to
+	// may adopt via the aws-load-balancer-arn annotation. Adopting load balancers that are not listed is denied.
+	// +optional
+	AdoptableLoadBalancers []string `json:"adoptableLoadBalancers,omitempty"`
+
+	// IAMRoleARNToAssume is the ARN of IAM role to assume for provisioning AWS resources of Services that this LoadBalancerClassParams applies to,
+	// e.g. to provision load balancers in another AWS account or a shared VPC. The controller's own credentials are used if unspecified.
+	// +kubebuilder:validation:Pattern="^arn:[^:]+:iam::[0-9]{12}:role/.+"
+	// +optional
+	IAMRoleARNToAssume string `json:"iamRoleArnToAssume,omitempty"`
+
+	// AssumeRoleExternalID is the external ID to pass when assuming IAMRoleARNToAssume.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="LOAD-BALANCER-CLASS",type="string",JSONPath=".spec.loadBalancerClass",description="The loadBalancerClass of Services"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerClassParams is the Schema for the LoadBalancerClassParams API
+type LoadBalancerClassParams struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerClassParamsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerClassParamsList contains a list of LoadBalancerClassParams
+type LoadBalancerClassParamsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerClassParams `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerClassParams{}, &LoadBalancerClassParamsList{})
+}
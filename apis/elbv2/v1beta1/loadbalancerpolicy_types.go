@@ -0,0 +1,105 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=TLSv1.2;TLSv1.3
+// TLSVersion is the version of TLS protocol.
+type TLSVersion string
+
+const (
+	TLSVersion12 TLSVersion = "TLSv1.2"
+	TLSVersion13 TLSVersion = "TLSv1.3"
+)
+
+// InternetFacingRule restricts internet-facing load balancers to specific namespaces.
+type InternetFacingRule struct {
+	// NamespaceSelector selects the namespaces that are allowed to have internet-facing load balancers.
+	// A load balancer serving resources in multiple namespaces is allowed only if all namespaces are selected.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// DeniedInboundCIDRsRule denies CIDRs from inbound traffic of load balancers.
+type DeniedInboundCIDRsRule struct {
+	// CIDRs is the list of CIDRs that are not allowed to access load balancers.
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []string `json:"cidrs"`
+
+	// ExemptNamespaceSelector selects the namespaces that are exempted from this rule.
+	// A load balancer serving resources in multiple namespaces is exempted only if all namespaces are selected.
+	// +optional
+	ExemptNamespaceSelector *metav1.LabelSelector `json:"exemptNamespaceSelector,omitempty"`
+}
+
+// LoadBalancerPolicyRules defines the rules that load balancers must follow.
+type LoadBalancerPolicyRules struct {
+	// InternetFacing restricts internet-facing load balancers to specific namespaces.
+	// +optional
+	InternetFacing *InternetFacingRule `json:"internetFacing,omitempty"`
+
+	// MinimumTLSVersion is the minimum TLS version that SSL policies of HTTPS and TLS listeners must enforce.
+	// +optional
+	MinimumTLSVersion *TLSVersion `json:"minimumTLSVersion,omitempty"`
+
+	// RequireAccessLogs specifies whether load balancers must have access logs enabled.
+	// +optional
+	RequireAccessLogs *bool `json:"requireAccessLogs,omitempty"`
+
+	// DeniedInboundCIDRs denies CIDRs from inbound traffic of load balancers.
+	// +optional
+	DeniedInboundCIDRs *DeniedInboundCIDRsRule `json:"deniedInboundCIDRs,omitempty"`
+}
+
+// LoadBalancerPolicySpec defines the desired state of LoadBalancerPolicy
+type LoadBalancerPolicySpec struct {
+	// NamespaceSelector selects the namespaces that this policy applies to.
+	// The policy applies to a load balancer if it serves resources in any of the selected namespaces.
+	// If unspecified, the policy applies to all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Rules defines the rules that load balancers must follow.
+	Rules LoadBalancerPolicyRules `json:"rules"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerPolicy is the Schema for the LoadBalancerPolicy API
+type LoadBalancerPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerPolicyList contains a list of LoadBalancerPolicy
+type LoadBalancerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerPolicy{}, &LoadBalancerPolicyList{})
+}
@@ -0,0 +1,221 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=IngressGroup;Service
+// LoadBalancerSourceKind is the kind of Kubernetes resource that a load balancer is reconciled for.
+type LoadBalancerSourceKind string
+
+const (
+	LoadBalancerSourceKindIngressGroup LoadBalancerSourceKind = "IngressGroup"
+	LoadBalancerSourceKindService      LoadBalancerSourceKind = "Service"
+)
+
+// +kubebuilder:validation:Enum=Success;Failure
+// ReconcileResult is the result of a reconcile.
+type ReconcileResult string
+
+const (
+	ReconcileResultSuccess ReconcileResult = "Success"
+	ReconcileResultFailure ReconcileResult = "Failure"
+)
+
+// LoadBalancerSource identifies the Kubernetes resource that a load balancer is reconciled for.
+type LoadBalancerSource struct {
+	// Kind is the kind of the source.
+	Kind LoadBalancerSourceKind `json:"kind"`
+
+	// Namespace is the namespace of the source, it's empty for explicit IngressGroups.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the source, it's the group name for explicit IngressGroups.
+	Name string `json:"name"`
+}
+
+// LoadBalancerStateSpec defines the desired state of LoadBalancerState
+type LoadBalancerStateSpec struct {
+	// Source is the Kubernetes resource that the load balancer is reconciled for.
+	Source LoadBalancerSource `json:"source"`
+}
+
+// ListenerState is the reconciled state of a listener.
+type ListenerState struct {
+	// Port is the port of the listener.
+	Port int64 `json:"port"`
+
+	// Protocol is the protocol of the listener.
+	Protocol string `json:"protocol"`
+
+	// ListenerARN is the Amazon Resource Name (ARN) of the listener.
+	ListenerARN string `json:"listenerARN"`
+
+	// RuleCount is the number of rules on the listener, excluding the default rule.
+	// +optional
+	RuleCount int32 `json:"ruleCount,omitempty"`
+}
+
+// TargetGroupBindingReference is a reference to the TargetGroupBinding of a target group.
+type TargetGroupBindingReference struct {
+	// Namespace is the namespace of the TargetGroupBinding.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the TargetGroupBinding.
+	Name string `json:"name"`
+
+	// ServiceRef is the Service that the TargetGroupBinding registers targets from.
+	ServiceRef ServiceReference `json:"serviceRef"`
+}
+
+// TargetGroupState is the reconciled state of a target group.
+type TargetGroupState struct {
+	// TargetGroupARN is the Amazon Resource Name (ARN) of the target group.
+	TargetGroupARN string `json:"targetGroupARN"`
+
+	// TargetType is the target type of the target group.
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+
+	// Binding is the TargetGroupBinding of the target group.
+	// +optional
+	Binding *TargetGroupBindingReference `json:"binding,omitempty"`
+}
+
+const (
+	// LoadBalancerStateMemberConditionQuarantined is the condition of a member that is quarantined from its source,
+	// because it failed model building.
+	LoadBalancerStateMemberConditionQuarantined = "Quarantined"
+)
+
+// LoadBalancerStateMember is a member of the source that the load balancer is reconciled for.
+type LoadBalancerStateMember struct {
+	// Namespace is the namespace of the member.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the member.
+	Name string `json:"name"`
+
+	// ObservedGeneration is the generation of the member observed by the last reconcile.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Conditions are the conditions of the member observed by the last reconcile.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// LastReconcile is the result of the last reconcile.
+type LastReconcile struct {
+	// Result is the result of the reconcile.
+	Result ReconcileResult `json:"result"`
+
+	// Reason is the reason of a failed reconcile, in CamelCase.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the error message of a failed reconcile.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Time is the time of the reconcile.
+	Time metav1.Time `json:"time"`
+}
+
+// LoadBalancerStateStatus defines the observed state of LoadBalancerState
+type LoadBalancerStateStatus struct {
+	// LoadBalancerARN is the Amazon Resource Name (ARN) of the load balancer.
+	// +optional
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// DNSName is the DNS name of the load balancer.
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+
+	// Listeners are the listeners of the load balancer.
+	// +optional
+	Listeners []ListenerState `json:"listeners,omitempty"`
+
+	// RuleCount is the number of listener rules on the load balancer, excluding default rules.
+	// +optional
+	RuleCount int32 `json:"ruleCount,omitempty"`
+
+	// RuleQuota is the default quota of listener rules per application load balancer, excluding default rules.
+	// +optional
+	RuleQuota int32 `json:"ruleQuota,omitempty"`
+
+	// TargetGroups are the target groups of the load balancer.
+	// +optional
+	TargetGroups []TargetGroupState `json:"targetGroups,omitempty"`
+
+	// SecurityGroups are the IDs of security groups attached to the load balancer.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// WAFv2WebACLARN is the Amazon Resource Name (ARN) of the WAFv2 web ACL associated with the load balancer.
+	// +optional
+	WAFv2WebACLARN string `json:"wafv2WebACLARN,omitempty"`
+
+	// WAFRegionalWebACLID is the ID of the WAF Regional web ACL associated with the load balancer.
+	// +optional
+	WAFRegionalWebACLID string `json:"wafRegionalWebACLID,omitempty"`
+
+	// ShieldProtection indicates whether AWS Shield Advanced protection is enabled for the load balancer.
+	// +optional
+	ShieldProtection bool `json:"shieldProtection,omitempty"`
+
+	// Members are the members of the source, with the generation observed by the last reconcile.
+	// +optional
+	Members []LoadBalancerStateMember `json:"members,omitempty"`
+
+	// LastReconcile is the result of the last reconcile.
+	// +optional
+	LastReconcile *LastReconcile `json:"lastReconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.source.kind"
+// +kubebuilder:printcolumn:name="DNS-NAME",type="string",JSONPath=".status.dnsName"
+// +kubebuilder:printcolumn:name="RESULT",type="string",JSONPath=".status.lastReconcile.result"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerState is the Schema for the LoadBalancerState API.
+// It's owned by the controller and reports the reconciled AWS state of the load balancer for an IngressGroup or Service.
+type LoadBalancerState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerStateSpec   `json:"spec,omitempty"`
+	Status LoadBalancerStateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerStateList contains a list of LoadBalancerState
+type LoadBalancerStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerState `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerState{}, &LoadBalancerStateList{})
+}
@@ -0,0 +1,110 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespacedIngressClassParamsSpec defines the desired state of NamespacedIngressClassParams.
+// Each field overrides the same field of the IngressClassParams whose tenantPolicy covers the IngressClass,
+// and must be allowed by that tenantPolicy.
+type NamespacedIngressClassParamsSpec struct {
+	// Group defines the IngressGroup for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	Group *IngressGroup `json:"group,omitempty"`
+
+	// Scheme defines the scheme for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// InboundCIDRs specifies the CIDRs that are allowed to access the Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	InboundCIDRs []string `json:"inboundCIDRs,omitempty"`
+
+	// SSLPolicy specifies the SSL Policy for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	SSLPolicy string `json:"sslPolicy,omitempty"`
+
+	// Subnets defines the subnets for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	Subnets *SubnetSelector `json:"subnets,omitempty"`
+
+	// IPAddressType defines the ip address type for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	IPAddressType *IPAddressType `json:"ipAddressType,omitempty"`
+
+	// Tags defines list of Tags on AWS resources provisioned for Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// ListenPorts defines the listen ports allowed for Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	ListenPorts []ListenPort `json:"listenPorts,omitempty"`
+
+	// SSLRedirectPort enforces HTTP to HTTPS redirection to this port for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	SSLRedirectPort *int32 `json:"sslRedirectPort,omitempty"`
+
+	// Certificates defines the certificates for HTTPS listeners of all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	Certificates *CertificateSelector `json:"certificates,omitempty"`
+
+	// DefaultAction defines the action for requests that don't match any rule of Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	DefaultAction *DefaultAction `json:"defaultAction,omitempty"`
+
+	// MutualAuthentication defines the mutual TLS authentication settings for HTTPS listeners of all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	MutualAuthentication []MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
+	// TargetGroup defines the target group settings for all Ingresses that belong to IngressClass with this NamespacedIngressClassParams.
+	// +optional
+	TargetGroup *TargetGroupParams `json:"targetGroup,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="GROUP-NAME",type="string",JSONPath=".spec.group.name",description="The Ingress Group name"
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.scheme",description="The AWS Load Balancer scheme"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// NamespacedIngressClassParams is the Schema for the NamespacedIngressClassParams API
+type NamespacedIngressClassParams struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NamespacedIngressClassParamsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedIngressClassParamsList contains a list of NamespacedIngressClassParams
+type NamespacedIngressClassParamsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedIngressClassParams `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedIngressClassParams{}, &NamespacedIngressClassParamsList{})
+}
@@ -21,16 +21,18 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-// +kubebuilder:validation:Enum=instance;ip
+// +kubebuilder:validation:Enum=instance;ip;alb
 // TargetType is the targetType of your ELBV2 TargetGroup.
 //
 // * with `instance` TargetType, nodes with nodePort for your service will be registered as targets
 // * with `ip` TargetType, Pods with containerPort for your service will be registered as targets
+// * with `alb` TargetType, the ALB of referenced Ingress or IngressGroup will be registered as target
 type TargetType string
 
 const (
 	TargetTypeInstance TargetType = "instance"
 	TargetTypeIP       TargetType = "ip"
+	TargetTypeALB      TargetType = "alb"
 )
 
 // +kubebuilder:validation:Enum=ipv4;ipv6
@@ -51,6 +53,22 @@ type ServiceReference struct {
 	Port intstr.IntOrString `json:"port"`
 }
 
+// ALBTargetReference defines reference to an Ingress or IngressGroup whose ALB will be registered as target.
+// Exactly one of ingressGroupName or ingressName must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.ingressGroupName) != has(self.ingressName)",message="exactly one of ingressGroupName and ingressName must be specified"
+type ALBTargetReference struct {
+	// IngressGroupName is the name of an explicit IngressGroup.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IngressGroupName *string `json:"ingressGroupName,omitempty"`
+
+	// IngressName is the name of an Ingress in the same namespace as TargetGroupBinding.
+	// If the Ingress belongs to an explicit IngressGroup, the ALB of that IngressGroup will be used.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IngressName *string `json:"ingressName,omitempty"`
+}
+
 // IPBlock defines source/destination IPBlock in networking rules.
 type IPBlock struct {
 	// CIDR is the network CIDR.
@@ -132,8 +150,15 @@ type TargetGroupBindingSpec struct {
 	TargetType *TargetType `json:"targetType,omitempty"`
 
 	// serviceRef is a reference to a Kubernetes Service and ServicePort.
+	// It's required unless targetType is alb.
+	// +optional
 	ServiceRef ServiceReference `json:"serviceRef"`
 
+	// albTargetRef is a reference to an Ingress or IngressGroup whose ALB will be registered as target.
+	// It's required when targetType is alb.
+	// +optional
+	ALBTargetRef *ALBTargetReference `json:"albTargetRef,omitempty"`
+
 	// networking defines the networking rules to allow ELBV2 LoadBalancer to access targets in TargetGroup.
 	// +optional
 	Networking *TargetGroupBindingNetworking `json:"networking,omitempty"`
@@ -149,6 +174,16 @@ type TargetGroupBindingSpec struct {
 	// VpcID is the VPC of the TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
 	VpcID string `json:"vpcID,omitempty"`
+
+	// iamRoleArnToAssume is the ARN of IAM role to assume for registering targets into a TargetGroup in another AWS account.
+	// The networking rules are still managed with the controller's own credentials.
+	// +kubebuilder:validation:Pattern="^arn:[^:]+:iam::[0-9]{12}:role/.+"
+	// +optional
+	IAMRoleARNToAssume string `json:"iamRoleArnToAssume,omitempty"`
+
+	// assumeRoleExternalId is the external ID to pass when assuming iamRoleArnToAssume.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
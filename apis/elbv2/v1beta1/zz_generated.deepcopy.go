@@ -26,56 +26,1288 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALBTargetReference) DeepCopyInto(out *ALBTargetReference) {
+	*out = *in
+	if in.IngressGroupName != nil {
+		in, out := &in.IngressGroupName, &out.IngressGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IngressName != nil {
+		in, out := &in.IngressName, &out.IngressName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALBTargetReference.
+func (in *ALBTargetReference) DeepCopy() *ALBTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ALBTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogsConfig) DeepCopyInto(out *AccessLogsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogsConfig.
+func (in *AccessLogsConfig) DeepCopy() *AccessLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleGrant) DeepCopyInto(out *AssumeRoleGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleGrant.
+func (in *AssumeRoleGrant) DeepCopy() *AssumeRoleGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AssumeRoleGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleGrantList) DeepCopyInto(out *AssumeRoleGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AssumeRoleGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleGrantList.
+func (in *AssumeRoleGrantList) DeepCopy() *AssumeRoleGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AssumeRoleGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleGrantSpec) DeepCopyInto(out *AssumeRoleGrantSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleGrantSpec.
+func (in *AssumeRoleGrantSpec) DeepCopy() *AssumeRoleGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Attribute) DeepCopyInto(out *Attribute) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attribute.
-func (in *Attribute) DeepCopy() *Attribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Attribute.
+func (in *Attribute) DeepCopy() *Attribute {
+	if in == nil {
+		return nil
+	}
+	out := new(Attribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticateActionConfig) DeepCopyInto(out *AuthenticateActionConfig) {
+	*out = *in
+	if in.Cognito != nil {
+		in, out := &in.Cognito, &out.Cognito
+		*out = new(AuthenticateCognitoConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(AuthenticateOIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnUnauthenticatedRequest != nil {
+		in, out := &in.OnUnauthenticatedRequest, &out.OnUnauthenticatedRequest
+		*out = new(AuthenticateOnUnauthenticatedRequest)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionCookieName != nil {
+		in, out := &in.SessionCookieName, &out.SessionCookieName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionTimeout != nil {
+		in, out := &in.SessionTimeout, &out.SessionTimeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticateActionConfig.
+func (in *AuthenticateActionConfig) DeepCopy() *AuthenticateActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticateActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticateCognitoConfig) DeepCopyInto(out *AuthenticateCognitoConfig) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticateCognitoConfig.
+func (in *AuthenticateCognitoConfig) DeepCopy() *AuthenticateCognitoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticateCognitoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticateOIDCConfig) DeepCopyInto(out *AuthenticateOIDCConfig) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticateOIDCConfig.
+func (in *AuthenticateOIDCConfig) DeepCopy() *AuthenticateOIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticateOIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrant) DeepCopyInto(out *BackendGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrant.
+func (in *BackendGrant) DeepCopy() *BackendGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantFrom) DeepCopyInto(out *BackendGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantFrom.
+func (in *BackendGrantFrom) DeepCopy() *BackendGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantList) DeepCopyInto(out *BackendGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackendGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantList.
+func (in *BackendGrantList) DeepCopy() *BackendGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantSpec) DeepCopyInto(out *BackendGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]BackendGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]BackendGrantTo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantSpec.
+func (in *BackendGrantSpec) DeepCopy() *BackendGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantTo) DeepCopyInto(out *BackendGrantTo) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantTo.
+func (in *BackendGrantTo) DeepCopy() *BackendGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSelector) DeepCopyInto(out *CertificateSelector) {
+	*out = *in
+	if in.ARNs != nil {
+		in, out := &in.ARNs, &out.ARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSelector.
+func (in *CertificateSelector) DeepCopy() *CertificateSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAction) DeepCopyInto(out *DefaultAction) {
+	*out = *in
+	if in.FixedResponse != nil {
+		in, out := &in.FixedResponse, &out.FixedResponse
+		*out = new(FixedResponseActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(RedirectActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAction.
+func (in *DefaultAction) DeepCopy() *DefaultAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeniedInboundCIDRsRule) DeepCopyInto(out *DeniedInboundCIDRsRule) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptNamespaceSelector != nil {
+		in, out := &in.ExemptNamespaceSelector, &out.ExemptNamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeniedInboundCIDRsRule.
+func (in *DeniedInboundCIDRsRule) DeepCopy() *DeniedInboundCIDRsRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DeniedInboundCIDRsRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResponseActionConfig) DeepCopyInto(out *FixedResponseActionConfig) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageBody != nil {
+		in, out := &in.MessageBody, &out.MessageBody
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResponseActionConfig.
+func (in *FixedResponseActionConfig) DeepCopy() *FixedResponseActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResponseActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardActionConfig) DeepCopyInto(out *ForwardActionConfig) {
+	*out = *in
+	if in.TargetGroups != nil {
+		in, out := &in.TargetGroups, &out.TargetGroups
+		*out = make([]TargetGroupTuple, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetGroupStickinessConfig != nil {
+		in, out := &in.TargetGroupStickinessConfig, &out.TargetGroupStickinessConfig
+		*out = new(TargetGroupStickinessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardActionConfig.
+func (in *ForwardActionConfig) DeepCopy() *ForwardActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPBlock) DeepCopyInto(out *IPBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
+func (in *IPBlock) DeepCopy() *IPBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(IPBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
+func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParams) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressClassParams, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
+func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(IngressGroup)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.InboundCIDRs != nil {
+		in, out := &in.InboundCIDRs, &out.InboundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = new(SubnetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(IPAddressType)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ListenPorts != nil {
+		in, out := &in.ListenPorts, &out.ListenPorts
+		*out = make([]ListenPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLRedirectPort != nil {
+		in, out := &in.SSLRedirectPort, &out.SSLRedirectPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificateSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(DefaultAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutualAuthentication != nil {
+		in, out := &in.MutualAuthentication, &out.MutualAuthentication
+		*out = make([]MutualAuthenticationAttributes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetGroup != nil {
+		in, out := &in.TargetGroup, &out.TargetGroup
+		*out = new(TargetGroupParams)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(LoadBalancerDeletionPolicy)
+		**out = **in
+	}
+	if in.AdoptableLoadBalancers != nil {
+		in, out := &in.AdoptableLoadBalancers, &out.AdoptableLoadBalancers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TenantPolicy != nil {
+		in, out := &in.TenantPolicy, &out.TenantPolicy
+		*out = new(TenantPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
+func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
+func (in *IngressGroup) DeepCopy() *IngressGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternetFacingRule) DeepCopyInto(out *InternetFacingRule) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetFacingRule.
+func (in *InternetFacingRule) DeepCopy() *InternetFacingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(InternetFacingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastReconcile) DeepCopyInto(out *LastReconcile) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastReconcile.
+func (in *LastReconcile) DeepCopy() *LastReconcile {
+	if in == nil {
+		return nil
+	}
+	out := new(LastReconcile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenPort) DeepCopyInto(out *ListenPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenPort.
+func (in *ListenPort) DeepCopy() *ListenPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerAction) DeepCopyInto(out *ListenerAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerAction.
+func (in *ListenerAction) DeepCopy() *ListenerAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerActionList) DeepCopyInto(out *ListenerActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerActionList.
+func (in *ListenerActionList) DeepCopy() *ListenerActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerActionSpec) DeepCopyInto(out *ListenerActionSpec) {
+	*out = *in
+	if in.ForwardConfig != nil {
+		in, out := &in.ForwardConfig, &out.ForwardConfig
+		*out = new(ForwardActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedirectConfig != nil {
+		in, out := &in.RedirectConfig, &out.RedirectConfig
+		*out = new(RedirectActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FixedResponseConfig != nil {
+		in, out := &in.FixedResponseConfig, &out.FixedResponseConfig
+		*out = new(FixedResponseActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticateConfig != nil {
+		in, out := &in.AuthenticateConfig, &out.AuthenticateConfig
+		*out = new(AuthenticateActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerActionSpec.
+func (in *ListenerActionSpec) DeepCopy() *ListenerActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerState) DeepCopyInto(out *ListenerState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerState.
+func (in *ListenerState) DeepCopy() *ListenerState {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassParams) DeepCopyInto(out *LoadBalancerClassParams) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassParams.
+func (in *LoadBalancerClassParams) DeepCopy() *LoadBalancerClassParams {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClassParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerClassParams) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassParamsList) DeepCopyInto(out *LoadBalancerClassParamsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerClassParams, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassParamsList.
+func (in *LoadBalancerClassParamsList) DeepCopy() *LoadBalancerClassParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClassParamsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerClassParamsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassParamsSpec) DeepCopyInto(out *LoadBalancerClassParamsSpec) {
+	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(LoadBalancerClassSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enforced != nil {
+		in, out := &in.Enforced, &out.Enforced
+		*out = new(LoadBalancerClassSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdoptableLoadBalancers != nil {
+		in, out := &in.AdoptableLoadBalancers, &out.AdoptableLoadBalancers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassParamsSpec.
+func (in *LoadBalancerClassParamsSpec) DeepCopy() *LoadBalancerClassParamsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClassParamsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassSettings) DeepCopyInto(out *LoadBalancerClassSettings) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = new(SubnetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(IPAddressType)
+		**out = **in
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(LoadBalancerTargetType)
+		**out = **in
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(AccessLogsConfig)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(LoadBalancerDeletionPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassSettings.
+func (in *LoadBalancerClassSettings) DeepCopy() *LoadBalancerClassSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClassSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicy) DeepCopyInto(out *LoadBalancerPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicy.
+func (in *LoadBalancerPolicy) DeepCopy() *LoadBalancerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicyList) DeepCopyInto(out *LoadBalancerPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicyList.
+func (in *LoadBalancerPolicyList) DeepCopy() *LoadBalancerPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicyRules) DeepCopyInto(out *LoadBalancerPolicyRules) {
+	*out = *in
+	if in.InternetFacing != nil {
+		in, out := &in.InternetFacing, &out.InternetFacing
+		*out = new(InternetFacingRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumTLSVersion != nil {
+		in, out := &in.MinimumTLSVersion, &out.MinimumTLSVersion
+		*out = new(TLSVersion)
+		**out = **in
+	}
+	if in.RequireAccessLogs != nil {
+		in, out := &in.RequireAccessLogs, &out.RequireAccessLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeniedInboundCIDRs != nil {
+		in, out := &in.DeniedInboundCIDRs, &out.DeniedInboundCIDRs
+		*out = new(DeniedInboundCIDRsRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicyRules.
+func (in *LoadBalancerPolicyRules) DeepCopy() *LoadBalancerPolicyRules {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicyRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicySpec) DeepCopyInto(out *LoadBalancerPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Rules.DeepCopyInto(&out.Rules)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicySpec.
+func (in *LoadBalancerPolicySpec) DeepCopy() *LoadBalancerPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSource) DeepCopyInto(out *LoadBalancerSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSource.
+func (in *LoadBalancerSource) DeepCopy() *LoadBalancerSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerState) DeepCopyInto(out *LoadBalancerState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerState.
+func (in *LoadBalancerState) DeepCopy() *LoadBalancerState {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStateList) DeepCopyInto(out *LoadBalancerStateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStateList.
+func (in *LoadBalancerStateList) DeepCopy() *LoadBalancerStateList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerStateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStateMember) DeepCopyInto(out *LoadBalancerStateMember) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStateMember.
+func (in *LoadBalancerStateMember) DeepCopy() *LoadBalancerStateMember {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStateMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStateSpec) DeepCopyInto(out *LoadBalancerStateSpec) {
+	*out = *in
+	out.Source = in.Source
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStateSpec.
+func (in *LoadBalancerStateSpec) DeepCopy() *LoadBalancerStateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStateStatus) DeepCopyInto(out *LoadBalancerStateStatus) {
+	*out = *in
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerState, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroups != nil {
+		in, out := &in.TargetGroups, &out.TargetGroups
+		*out = make([]TargetGroupState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]LoadBalancerStateMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = new(LastReconcile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStateStatus.
+func (in *LoadBalancerStateStatus) DeepCopy() *LoadBalancerStateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Attribute)
+	out := new(LoadBalancerStateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPBlock) DeepCopyInto(out *IPBlock) {
+func (in *MutualAuthenticationAttributes) DeepCopyInto(out *MutualAuthenticationAttributes) {
 	*out = *in
+	if in.TrustStore != nil {
+		in, out := &in.TrustStore, &out.TrustStore
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreClientCertificateExpiry != nil {
+		in, out := &in.IgnoreClientCertificateExpiry, &out.IgnoreClientCertificateExpiry
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
-func (in *IPBlock) DeepCopy() *IPBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualAuthenticationAttributes.
+func (in *MutualAuthenticationAttributes) DeepCopy() *MutualAuthenticationAttributes {
 	if in == nil {
 		return nil
 	}
-	out := new(IPBlock)
+	out := new(MutualAuthenticationAttributes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+func (in *NamespacedIngressClassParams) DeepCopyInto(out *NamespacedIngressClassParams) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
-func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedIngressClassParams.
+func (in *NamespacedIngressClassParams) DeepCopy() *NamespacedIngressClassParams {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParams)
+	out := new(NamespacedIngressClassParams)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParams) DeepCopyObject() runtime.Object {
+func (in *NamespacedIngressClassParams) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -83,31 +1315,31 @@ func (in *IngressClassParams) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+func (in *NamespacedIngressClassParamsList) DeepCopyInto(out *NamespacedIngressClassParamsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressClassParams, len(*in))
+		*out = make([]NamespacedIngressClassParams, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
-func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedIngressClassParamsList.
+func (in *NamespacedIngressClassParamsList) DeepCopy() *NamespacedIngressClassParamsList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParamsList)
+	out := new(NamespacedIngressClassParamsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+func (in *NamespacedIngressClassParamsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -115,13 +1347,8 @@ func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+func (in *NamespacedIngressClassParamsSpec) DeepCopyInto(out *NamespacedIngressClassParamsSpec) {
 	*out = *in
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Group != nil {
 		in, out := &in.Group, &out.Group
 		*out = new(IngressGroup)
@@ -157,29 +1384,46 @@ func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
 		*out = make([]Attribute, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
-func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
-	if in == nil {
-		return nil
+	if in.ListenPorts != nil {
+		in, out := &in.ListenPorts, &out.ListenPorts
+		*out = make([]ListenPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLRedirectPort != nil {
+		in, out := &in.SSLRedirectPort, &out.SSLRedirectPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificateSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(DefaultAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutualAuthentication != nil {
+		in, out := &in.MutualAuthentication, &out.MutualAuthentication
+		*out = make([]MutualAuthenticationAttributes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetGroup != nil {
+		in, out := &in.TargetGroup, &out.TargetGroup
+		*out = new(TargetGroupParams)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(IngressClassParamsSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
-func (in *IngressGroup) DeepCopy() *IngressGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedIngressClassParamsSpec.
+func (in *NamespacedIngressClassParamsSpec) DeepCopy() *NamespacedIngressClassParamsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressGroup)
+	out := new(NamespacedIngressClassParamsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -263,6 +1507,46 @@ func (in *NetworkingPort) DeepCopy() *NetworkingPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectActionConfig) DeepCopyInto(out *RedirectActionConfig) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectActionConfig.
+func (in *RedirectActionConfig) DeepCopy() *RedirectActionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectActionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -345,6 +1629,21 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupAttributeParam) DeepCopyInto(out *TargetGroupAttributeParam) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupAttributeParam.
+func (in *TargetGroupAttributeParam) DeepCopy() *TargetGroupAttributeParam {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupAttributeParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetGroupBinding) DeepCopyInto(out *TargetGroupBinding) {
 	*out = *in
@@ -426,6 +1725,22 @@ func (in *TargetGroupBindingNetworking) DeepCopy() *TargetGroupBindingNetworking
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingReference) DeepCopyInto(out *TargetGroupBindingReference) {
+	*out = *in
+	out.ServiceRef = in.ServiceRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingReference.
+func (in *TargetGroupBindingReference) DeepCopy() *TargetGroupBindingReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBindingReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
 	*out = *in
@@ -435,6 +1750,11 @@ func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
 		**out = **in
 	}
 	out.ServiceRef = in.ServiceRef
+	if in.ALBTargetRef != nil {
+		in, out := &in.ALBTargetRef, &out.ALBTargetRef
+		*out = new(ALBTargetReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Networking != nil {
 		in, out := &in.Networking, &out.Networking
 		*out = new(TargetGroupBindingNetworking)
@@ -481,3 +1801,240 @@ func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupInt64Param) DeepCopyInto(out *TargetGroupInt64Param) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupInt64Param.
+func (in *TargetGroupInt64Param) DeepCopy() *TargetGroupInt64Param {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupInt64Param)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupParams) DeepCopyInto(out *TargetGroupParams) {
+	*out = *in
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.BackendProtocol != nil {
+		in, out := &in.BackendProtocol, &out.BackendProtocol
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.BackendProtocolVersion != nil {
+		in, out := &in.BackendProtocolVersion, &out.BackendProtocolVersion
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.HealthCheckPort != nil {
+		in, out := &in.HealthCheckPort, &out.HealthCheckPort
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.HealthCheckProtocol != nil {
+		in, out := &in.HealthCheckProtocol, &out.HealthCheckProtocol
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.HealthCheckPath != nil {
+		in, out := &in.HealthCheckPath, &out.HealthCheckPath
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.SuccessCodes != nil {
+		in, out := &in.SuccessCodes, &out.SuccessCodes
+		*out = new(TargetGroupStringParam)
+		**out = **in
+	}
+	if in.HealthCheckIntervalSeconds != nil {
+		in, out := &in.HealthCheckIntervalSeconds, &out.HealthCheckIntervalSeconds
+		*out = new(TargetGroupInt64Param)
+		**out = **in
+	}
+	if in.HealthCheckTimeoutSeconds != nil {
+		in, out := &in.HealthCheckTimeoutSeconds, &out.HealthCheckTimeoutSeconds
+		*out = new(TargetGroupInt64Param)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(TargetGroupInt64Param)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(TargetGroupInt64Param)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]TargetGroupAttributeParam, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupParams.
+func (in *TargetGroupParams) DeepCopy() *TargetGroupParams {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupState) DeepCopyInto(out *TargetGroupState) {
+	*out = *in
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(TargetGroupBindingReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupState.
+func (in *TargetGroupState) DeepCopy() *TargetGroupState {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupStickinessConfig) DeepCopyInto(out *TargetGroupStickinessConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStickinessConfig.
+func (in *TargetGroupStickinessConfig) DeepCopy() *TargetGroupStickinessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupStickinessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupStringParam) DeepCopyInto(out *TargetGroupStringParam) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStringParam.
+func (in *TargetGroupStringParam) DeepCopy() *TargetGroupStringParam {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupStringParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupTuple) DeepCopyInto(out *TargetGroupTuple) {
+	*out = *in
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceNamespace != nil {
+		in, out := &in.ServiceNamespace, &out.ServiceNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePort != nil {
+		in, out := &in.ServicePort, &out.ServicePort
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupTuple.
+func (in *TargetGroupTuple) DeepCopy() *TargetGroupTuple {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupTuple)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantOverride) DeepCopyInto(out *TenantOverride) {
+	*out = *in
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantOverride.
+func (in *TenantOverride) DeepCopy() *TenantOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantPolicy) DeepCopyInto(out *TenantPolicy) {
+	*out = *in
+	if in.IngressClassNames != nil {
+		in, out := &in.IngressClassNames, &out.IngressClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOverrides != nil {
+		in, out := &in.AllowedOverrides, &out.AllowedOverrides
+		*out = make([]TenantOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantPolicy.
+func (in *TenantPolicy) DeepCopy() *TenantPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,106 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForIngressEvent constructs new enqueueRequestsForIngressEvent.
+func NewEnqueueRequestsForIngressEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+	return &enqueueRequestsForIngressEvent{
+		k8sClient:        k8sClient,
+		annotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+		logger:           logger,
+	}
+}
+
+type enqueueRequestsForIngressEvent struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+	logger           logr.Logger
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *enqueueRequestsForIngressEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	ingNew := e.Object.(*networking.Ingress)
+	h.enqueueImpactedTargetGroupBindings(queue, ingNew)
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *enqueueRequestsForIngressEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	ingOld := e.ObjectOld.(*networking.Ingress)
+	ingNew := e.ObjectNew.(*networking.Ingress)
+
+	// the ALB of an Ingress may be provisioned or replaced, which is reflected in Ingress status.
+	// the IngressGroup of an Ingress may be changed via annotations.
+	if equality.Semantic.DeepEqual(ingOld.Status, ingNew.Status) &&
+		equality.Semantic.DeepEqual(ingOld.Annotations, ingNew.Annotations) {
+		return
+	}
+	h.enqueueImpactedTargetGroupBindings(queue, ingOld)
+	h.enqueueImpactedTargetGroupBindings(queue, ingNew)
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *enqueueRequestsForIngressEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	ingOld := e.Object.(*networking.Ingress)
+	h.enqueueImpactedTargetGroupBindings(queue, ingOld)
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile AutoScaling, or a WebHook.
+func (h *enqueueRequestsForIngressEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// nothing to do here
+}
+
+// enqueueImpactedTargetGroupBindings will enqueue all alb TargetType TargetGroupBindings that reference the Ingress or its IngressGroup.
+func (h *enqueueRequestsForIngressEvent) enqueueImpactedTargetGroupBindings(queue workqueue.RateLimitingInterface, ing *networking.Ingress) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := h.k8sClient.List(context.Background(), tgbList,
+		client.InNamespace(ing.Namespace),
+		client.MatchingFields{targetgroupbinding.IndexKeyALBTargetRefIngressName: ing.Name}); err != nil {
+		h.logger.Error(err, "failed to fetch targetGroupBindings")
+		return
+	}
+
+	groupName := ""
+	if exists := h.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); exists && groupName != "" {
+		groupTGBList := &elbv2api.TargetGroupBindingList{}
+		if err := h.k8sClient.List(context.Background(), groupTGBList,
+			client.MatchingFields{targetgroupbinding.IndexKeyALBTargetRefIngressGroupName: groupName}); err != nil {
+			h.logger.Error(err, "failed to fetch targetGroupBindings")
+			return
+		}
+		tgbList.Items = append(tgbList.Items, groupTGBList.Items...)
+	}
+
+	ingKey := k8s.NamespacedName(ing)
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.TargetType == nil || (*tgb.Spec.TargetType) != elbv2api.TargetTypeALB {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue targetGroupBinding for ingress event",
+			"ingress", ingKey,
+			"targetGroupBinding", k8s.NamespacedName(&tgb),
+		)
+		queue.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: tgb.Namespace,
+				Name:      tgb.Name,
+			},
+		})
+	}
+}
@@ -25,14 +25,18 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	discv1 "k8s.io/api/discovery/v1"
+	networking "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
@@ -50,13 +54,14 @@ const (
 // NewTargetGroupBindingReconciler constructs new targetGroupBindingReconciler
 func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, finalizerManager k8s.FinalizerManager,
 	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig,
-	logger logr.Logger) *targetGroupBindingReconciler {
+	shardCoordinator shard.Coordinator, logger logr.Logger) *targetGroupBindingReconciler {
 
 	return &targetGroupBindingReconciler{
 		k8sClient:          k8sClient,
 		eventRecorder:      eventRecorder,
 		finalizerManager:   finalizerManager,
 		tgbResourceManager: tgbResourceManager,
+		shardCoordinator:   shardCoordinator,
 		logger:             logger,
 
 		maxConcurrentReconciles:    config.TargetGroupBindingMaxConcurrentReconciles,
@@ -71,7 +76,9 @@ type targetGroupBindingReconciler struct {
 	eventRecorder      record.EventRecorder
 	finalizerManager   k8s.FinalizerManager
 	tgbResourceManager targetgroupbinding.ResourceManager
-	logger             logr.Logger
+	// shardCoordinator is nil unless sharding of reconciliation is enabled.
+	shardCoordinator shard.Coordinator
+	logger           logr.Logger
 
 	maxConcurrentReconciles    int
 	maxExponentialBackoffDelay time.Duration
@@ -80,6 +87,7 @@ type targetGroupBindingReconciler struct {
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings/status,verbs=update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=assumerolegrants,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
@@ -87,6 +95,7 @@ type targetGroupBindingReconciler struct {
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="networking.k8s.io",resources=ingresses,verbs=get;list;watch
 
 func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	r.logger.V(1).Info("Reconcile request", "name", req.Name)
@@ -94,6 +103,13 @@ func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.R
 }
 
 func (r *targetGroupBindingReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	if r.shardCoordinator != nil {
+		release, owned := r.shardCoordinator.Claim(req.NamespacedName.String())
+		if !owned {
+			return nil
+		}
+		defer release()
+	}
 	tgb := &elbv2api.TargetGroupBinding{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, tgb); err != nil {
 		return client.IgnoreNotFound(err)
@@ -159,34 +175,54 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 		r.logger.WithName("eventHandlers").WithName("service"))
 	nodeEventsHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
 		r.logger.WithName("eventHandlers").WithName("node"))
+	ingEventsHandler := eventhandlers.NewEnqueueRequestsForIngressEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("ingress"))
 
+	blder := ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.TargetGroupBinding{}).
+		Named(controllerName).
+		Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler)
 	// Use the config flag to decide whether to use and watch an Endpoints event handler or an EndpointSlices event handler
 	if r.enableEndpointSlices {
 		epSliceEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointSlicesEvent(r.k8sClient,
 			r.logger.WithName("eventHandlers").WithName("endpointslices"))
-		return ctrl.NewControllerManagedBy(mgr).
-			For(&elbv2api.TargetGroupBinding{}).
-			Named(controllerName).
-			Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler).
-			Watches(&source.Kind{Type: &discv1.EndpointSlice{}}, epSliceEventsHandler).
-			Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
-			WithOptions(controller.Options{
-				MaxConcurrentReconciles: r.maxConcurrentReconciles,
-				RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
-			Complete(r)
+		blder = blder.Watches(&source.Kind{Type: &discv1.EndpointSlice{}}, epSliceEventsHandler)
 	} else {
 		epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient,
 			r.logger.WithName("eventHandlers").WithName("endpoints"))
-		return ctrl.NewControllerManagedBy(mgr).
-			For(&elbv2api.TargetGroupBinding{}).
-			Named(controllerName).
-			Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler).
-			Watches(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler).
-			Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
-			WithOptions(controller.Options{
-				MaxConcurrentReconciles: r.maxConcurrentReconciles,
-				RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
-			Complete(r)
+		blder = blder.Watches(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler)
+	}
+	blder = blder.
+		Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
+		Watches(&source.Kind{Type: &networking.Ingress{}}, ingEventsHandler)
+	if r.shardCoordinator != nil {
+		tgbEventChan := make(chan event.GenericEvent)
+		blder = blder.Watches(&source.Channel{Source: tgbEventChan}, &handler.EnqueueRequestForObject{})
+		r.shardCoordinator.AddShardsAcquiredHandler(func(ctx context.Context) {
+			r.resyncTargetGroupBindings(ctx, tgbEventChan)
+		})
+	}
+	return blder.
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.maxConcurrentReconciles,
+			RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
+		Complete(r)
+}
+
+// resyncTargetGroupBindings triggers reconcile of all TargetGroupBindings,
+// so that the TargetGroupBindings of shards acquired by this replica are reconciled.
+func (r *targetGroupBindingReconciler) resyncTargetGroupBindings(ctx context.Context, tgbEventChan chan<- event.GenericEvent) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := r.k8sClient.List(ctx, tgbList); err != nil {
+		r.logger.Error(err, "failed to resync targetGroupBindings of acquired shards")
+		return
+	}
+	for i := range tgbList.Items {
+		select {
+		case tgbEventChan <- event.GenericEvent{Object: &tgbList.Items[i]}:
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
@@ -195,5 +231,13 @@ func (r *targetGroupBindingReconciler) setupIndexes(ctx context.Context, fieldIn
 		targetgroupbinding.IndexKeyServiceRefName, targetgroupbinding.IndexFuncServiceRefName); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &elbv2api.TargetGroupBinding{},
+		targetgroupbinding.IndexKeyALBTargetRefIngressName, targetgroupbinding.IndexFuncALBTargetRefIngressName); err != nil {
+		return err
+	}
+	if err := fieldIndexer.IndexField(ctx, &elbv2api.TargetGroupBinding{},
+		targetgroupbinding.IndexKeyALBTargetRefIngressGroupName, targetgroupbinding.IndexFuncALBTargetRefIngressGroupName); err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,53 @@
+package ingress
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+)
+
+const (
+	// resourceID of the LoadBalancer within IngressGroup stack.
+	resourceIDLoadBalancer = "LoadBalancer"
+)
+
+// NewIngressALBFinder constructs new ingressALBFinder.
+func NewIngressALBFinder(elbv2TaggingManager elbv2deploy.TaggingManager, clusterName string) *ingressALBFinder {
+	return &ingressALBFinder{
+		elbv2TaggingManager: elbv2TaggingManager,
+		trackingProvider:    tracking.NewDefaultProvider(IngressTagPrefix, clusterName),
+	}
+}
+
+var _ targetgroupbinding.IngressALBFinder = &ingressALBFinder{}
+
+// ingressALBFinder finds the ALB of IngressGroups by the tags it's provisioned with.
+type ingressALBFinder struct {
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	trackingProvider    tracking.Provider
+}
+
+func (f *ingressALBFinder) FindALB(ctx context.Context, stackID core.StackID) (*elbv2sdk.LoadBalancer, error) {
+	stack := core.NewDefaultStack(stackID)
+	tagFilter := tracking.TagFilter{}
+	for key, value := range f.trackingProvider.StackTags(stack) {
+		tagFilter[key] = []string{value}
+	}
+	tagFilter[f.trackingProvider.ResourceIDTagKey()] = []string{resourceIDLoadBalancer}
+
+	lbs, err := f.elbv2TaggingManager.ListLoadBalancers(ctx, tagFilter)
+	if err != nil {
+		return nil, err
+	}
+	for _, lb := range lbs {
+		if awssdk.StringValue(lb.LoadBalancer.Type) == elbv2sdk.LoadBalancerTypeEnumApplication {
+			return lb.LoadBalancer, nil
+		}
+	}
+	return nil, nil
+}
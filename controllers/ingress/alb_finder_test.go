@@ -0,0 +1,95 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_ingressALBFinder_FindALB(t *testing.T) {
+	lbs := []*elbv2sdk.LoadBalancer{
+		{
+			LoadBalancerArn: awssdk.String("lb-1"),
+			VpcId:           awssdk.String("vpc-1"),
+			Type:            awssdk.String("application"),
+		},
+		{
+			LoadBalancerArn: awssdk.String("lb-2"),
+			VpcId:           awssdk.String("vpc-1"),
+			Type:            awssdk.String("network"),
+		},
+		{
+			LoadBalancerArn: awssdk.String("lb-3"),
+			VpcId:           awssdk.String("vpc-2"),
+			Type:            awssdk.String("application"),
+		},
+	}
+	stackTags := func(stackName string) []*elbv2sdk.Tag {
+		return []*elbv2sdk.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster-name")},
+			{Key: awssdk.String("ingress.k8s.aws/stack"), Value: awssdk.String(stackName)},
+			{Key: awssdk.String("ingress.k8s.aws/resource"), Value: awssdk.String("LoadBalancer")},
+		}
+	}
+	tests := []struct {
+		name    string
+		lb1Tags []*elbv2sdk.Tag
+		lb2Tags []*elbv2sdk.Tag
+		stackID core.StackID
+		want    *elbv2sdk.LoadBalancer
+	}{
+		{
+			name:    "ALB found",
+			lb1Tags: stackTags("awesome-group"),
+			lb2Tags: stackTags("other-group"),
+			stackID: core.StackID{Name: "awesome-group"},
+			want:    lbs[0],
+		},
+		{
+			name:    "only NLB tagged with the stack",
+			lb1Tags: stackTags("other-group"),
+			lb2Tags: stackTags("awesome-group"),
+			stackID: core.StackID{Name: "awesome-group"},
+			want:    nil,
+		},
+		{
+			name:    "ALB not found",
+			lb1Tags: stackTags("other-group"),
+			lb2Tags: stackTags("other-group"),
+			stackID: core.StackID{Name: "awesome-group"},
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), gomock.Any()).Return(lbs, nil)
+			elbv2Client.EXPECT().DescribeTagsWithContext(gomock.Any(), &elbv2sdk.DescribeTagsInput{
+				ResourceArns: awssdk.StringSlice([]string{"lb-1", "lb-2"}),
+			}).Return(&elbv2sdk.DescribeTagsOutput{
+				TagDescriptions: []*elbv2sdk.TagDescription{
+					{ResourceArn: awssdk.String("lb-1"), Tags: tt.lb1Tags},
+					{ResourceArn: awssdk.String("lb-2"), Tags: tt.lb2Tags},
+				},
+			}, nil)
+
+			taggingManager := elbv2deploy.NewDefaultTaggingManager(elbv2Client, "vpc-1", config.NewFeatureGates(), nil, log.Log)
+			f := NewIngressALBFinder(taggingManager, "cluster-name")
+			got, err := f.FindALB(context.Background(), tt.stackID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
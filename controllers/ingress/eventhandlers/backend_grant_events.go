@@ -0,0 +1,109 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForBackendGrantEvent constructs new enqueueRequestsForBackendGrantEvent.
+// listenerActionEventChan is optional, ListenerActions referencing Services in BackendGrant's namespace won't be enqueued if it's nil.
+func NewEnqueueRequestsForBackendGrantEvent(ingEventChan chan<- event.GenericEvent, listenerActionEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForBackendGrantEvent {
+	return &enqueueRequestsForBackendGrantEvent{
+		ingEventChan:            ingEventChan,
+		listenerActionEventChan: listenerActionEventChan,
+		k8sClient:               k8sClient,
+		eventRecorder:           eventRecorder,
+		logger:                  logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForBackendGrantEvent)(nil)
+
+type enqueueRequestsForBackendGrantEvent struct {
+	ingEventChan            chan<- event.GenericEvent
+	listenerActionEventChan chan<- event.GenericEvent
+	k8sClient               client.Client
+	eventRecorder           record.EventRecorder
+	logger                  logr.Logger
+}
+
+func (h *enqueueRequestsForBackendGrantEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	grantNew := e.Object.(*elbv2api.BackendGrant)
+	h.enqueueImpactedObjects(grantNew)
+}
+
+func (h *enqueueRequestsForBackendGrantEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	grantOld := e.ObjectOld.(*elbv2api.BackendGrant)
+	grantNew := e.ObjectNew.(*elbv2api.BackendGrant)
+
+	// we only care below update event:
+	//	1. BackendGrant spec updates
+	//	2. BackendGrant deletion
+	if equality.Semantic.DeepEqual(grantOld.Spec, grantNew.Spec) &&
+		equality.Semantic.DeepEqual(grantOld.DeletionTimestamp.IsZero(), grantNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedObjects(grantNew)
+}
+
+func (h *enqueueRequestsForBackendGrantEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	grantOld := e.Object.(*elbv2api.BackendGrant)
+	h.enqueueImpactedObjects(grantOld)
+}
+
+func (h *enqueueRequestsForBackendGrantEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for backendGrants.
+}
+
+// enqueueImpactedObjects enqueues Ingresses and ListenerActions that reference Services in BackendGrant's namespace.
+func (h *enqueueRequestsForBackendGrantEvent) enqueueImpactedObjects(grant *elbv2api.BackendGrant) {
+	grantKey := k8s.NamespacedName(grant)
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), ingList,
+		client.MatchingFields{ingress.IndexKeyCrossNamespaceServiceRefNamespace: grant.GetNamespace()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for backendGrant event",
+			"backendGrant", grantKey,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
+		}
+	}
+
+	if h.listenerActionEventChan == nil {
+		return
+	}
+	listenerActionList := &elbv2api.ListenerActionList{}
+	if err := h.k8sClient.List(context.Background(), listenerActionList,
+		client.MatchingFields{ingress.IndexKeyCrossNamespaceServiceRefNamespace: grant.GetNamespace()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerActions")
+		return
+	}
+	for index := range listenerActionList.Items {
+		listenerAction := &listenerActionList.Items[index]
+
+		h.logger.V(1).Info("enqueue listenerAction for backendGrant event",
+			"backendGrant", grantKey,
+			"listenerAction", k8s.NamespacedName(listenerAction))
+		h.listenerActionEventChan <- event.GenericEvent{
+			Object: listenerAction,
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/go-logr/logr"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
@@ -52,6 +54,8 @@ func (h *enqueueRequestsForIngressClassParamsEvent) Update(e event.UpdateEvent,
 	}
 
 	h.enqueueImpactedIngressClasses(ingClassParamsNew)
+	// IngressClasses dropped from tenantPolicy are impacted as well.
+	h.enqueueTenantPolicyIngressClasses(ingClassParamsOld)
 }
 
 func (h *enqueueRequestsForIngressClassParamsEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
@@ -80,4 +84,29 @@ func (h *enqueueRequestsForIngressClassParamsEvent) enqueueImpactedIngressClasse
 			Object: ingClass,
 		}
 	}
+	h.enqueueTenantPolicyIngressClasses(ingClassParams)
+}
+
+// enqueueTenantPolicyIngressClasses enqueues IngressClasses covered by tenantPolicy of IngressClassParams,
+// which use it as the base settings for their NamespacedIngressClassParams.
+func (h *enqueueRequestsForIngressClassParamsEvent) enqueueTenantPolicyIngressClasses(ingClassParams *elbv2api.IngressClassParams) {
+	if ingClassParams.Spec.TenantPolicy == nil {
+		return
+	}
+	for _, ingClassName := range ingClassParams.Spec.TenantPolicy.IngressClassNames {
+		ingClass := &networking.IngressClass{}
+		if err := h.k8sClient.Get(context.Background(), types.NamespacedName{Name: ingClassName}, ingClass); err != nil {
+			if !apierrors.IsNotFound(err) {
+				h.logger.Error(err, "failed to fetch ingressClass", "ingressClass", ingClassName)
+			}
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue ingressClass for ingressClassParams tenantPolicy event",
+			"ingressClassParams", ingClassParams.GetName(),
+			"ingressClass", ingClass.GetName())
+		h.ingClassEventChan <- event.GenericEvent{
+			Object: ingClass,
+		}
+	}
 }
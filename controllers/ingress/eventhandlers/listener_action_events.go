@@ -0,0 +1,88 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForListenerActionEvent constructs new enqueueRequestsForListenerActionEvent.
+func NewEnqueueRequestsForListenerActionEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForListenerActionEvent {
+	return &enqueueRequestsForListenerActionEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForListenerActionEvent)(nil)
+
+type enqueueRequestsForListenerActionEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForListenerActionEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	listenerActionNew := e.Object.(*elbv2api.ListenerAction)
+	h.enqueueImpactedIngresses(listenerActionNew)
+}
+
+func (h *enqueueRequestsForListenerActionEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	listenerActionOld := e.ObjectOld.(*elbv2api.ListenerAction)
+	listenerActionNew := e.ObjectNew.(*elbv2api.ListenerAction)
+
+	// we only care below update event:
+	//	1. ListenerAction spec updates
+	//	2. ListenerAction deletion
+	if equality.Semantic.DeepEqual(listenerActionOld.Spec, listenerActionNew.Spec) &&
+		equality.Semantic.DeepEqual(listenerActionOld.DeletionTimestamp.IsZero(), listenerActionNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngresses(listenerActionNew)
+}
+
+func (h *enqueueRequestsForListenerActionEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	listenerActionOld := e.Object.(*elbv2api.ListenerAction)
+	h.enqueueImpactedIngresses(listenerActionOld)
+}
+
+func (h *enqueueRequestsForListenerActionEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	listenerAction := e.Object.(*elbv2api.ListenerAction)
+	h.enqueueImpactedIngresses(listenerAction)
+}
+
+func (h *enqueueRequestsForListenerActionEvent) enqueueImpactedIngresses(listenerAction *elbv2api.ListenerAction) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), ingList,
+		client.InNamespace(listenerAction.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeyListenerActionRefName: listenerAction.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+
+	listenerActionKey := k8s.NamespacedName(listenerAction)
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for listenerAction event",
+			"listenerAction", listenerActionKey,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
+		}
+	}
+}
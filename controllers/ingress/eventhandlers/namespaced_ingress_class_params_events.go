@@ -0,0 +1,88 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForNamespacedIngressClassParamsEvent constructs new enqueueRequestsForNamespacedIngressClassParamsEvent.
+func NewEnqueueRequestsForNamespacedIngressClassParamsEvent(ingClassEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForNamespacedIngressClassParamsEvent {
+	return &enqueueRequestsForNamespacedIngressClassParamsEvent{
+		ingClassEventChan: ingClassEventChan,
+		k8sClient:         k8sClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForNamespacedIngressClassParamsEvent)(nil)
+
+type enqueueRequestsForNamespacedIngressClassParamsEvent struct {
+	ingClassEventChan chan<- event.GenericEvent
+	k8sClient         client.Client
+	eventRecorder     record.EventRecorder
+	logger            logr.Logger
+}
+
+func (h *enqueueRequestsForNamespacedIngressClassParamsEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	nsParamsNew := e.Object.(*elbv2api.NamespacedIngressClassParams)
+	h.enqueueImpactedIngressClasses(nsParamsNew)
+}
+
+func (h *enqueueRequestsForNamespacedIngressClassParamsEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	nsParamsOld := e.ObjectOld.(*elbv2api.NamespacedIngressClassParams)
+	nsParamsNew := e.ObjectNew.(*elbv2api.NamespacedIngressClassParams)
+
+	// we only care below update event:
+	//	1. NamespacedIngressClassParams spec updates
+	//	2. NamespacedIngressClassParams deletion
+	if equality.Semantic.DeepEqual(nsParamsOld.Spec, nsParamsNew.Spec) &&
+		equality.Semantic.DeepEqual(nsParamsOld.DeletionTimestamp.IsZero(), nsParamsNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngressClasses(nsParamsNew)
+}
+
+func (h *enqueueRequestsForNamespacedIngressClassParamsEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	nsParamsOld := e.Object.(*elbv2api.NamespacedIngressClassParams)
+	h.enqueueImpactedIngressClasses(nsParamsOld)
+}
+
+func (h *enqueueRequestsForNamespacedIngressClassParamsEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for NamespacedIngressClassParams.
+}
+
+func (h *enqueueRequestsForNamespacedIngressClassParamsEvent) enqueueImpactedIngressClasses(nsParams *elbv2api.NamespacedIngressClassParams) {
+	ingClassList := &networking.IngressClassList{}
+	if err := h.k8sClient.List(context.Background(), ingClassList); err != nil {
+		h.logger.Error(err, "failed to fetch ingressClasses")
+		return
+	}
+	nsParamsKey := k8s.NamespacedName(nsParams)
+	for index := range ingClassList.Items {
+		ingClass := &ingClassList.Items[index]
+		if !ingress.IsNamespacedIngressClassParamsRef(ingClass, nsParamsKey) {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue ingressClass for namespacedIngressClassParams event",
+			"namespacedIngressClassParams", nsParamsKey,
+			"ingressClass", ingClass.GetName())
+		h.ingClassEventChan <- event.GenericEvent{
+			Object: ingClass,
+		}
+	}
+}
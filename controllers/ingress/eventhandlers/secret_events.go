@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,25 +17,29 @@ import (
 )
 
 // NewEnqueueRequestsForSecretEvent constructs new enqueueRequestsForSecretEvent.
+// listenerActionEventChan is optional, ListenerActions referencing the Secret won't be enqueued if it's nil.
 func NewEnqueueRequestsForSecretEvent(ingEventChan chan<- event.GenericEvent, svcEventChan chan<- event.GenericEvent,
-	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForSecretEvent {
+	listenerActionEventChan chan<- event.GenericEvent, k8sClient client.Client, eventRecorder record.EventRecorder,
+	logger logr.Logger) *enqueueRequestsForSecretEvent {
 	return &enqueueRequestsForSecretEvent{
-		ingEventChan:  ingEventChan,
-		svcEventChan:  svcEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:            ingEventChan,
+		svcEventChan:            svcEventChan,
+		listenerActionEventChan: listenerActionEventChan,
+		k8sClient:               k8sClient,
+		eventRecorder:           eventRecorder,
+		logger:                  logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForSecretEvent)(nil)
 
 type enqueueRequestsForSecretEvent struct {
-	ingEventChan  chan<- event.GenericEvent
-	svcEventChan  chan<- event.GenericEvent
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan            chan<- event.GenericEvent
+	svcEventChan            chan<- event.GenericEvent
+	listenerActionEventChan chan<- event.GenericEvent
+	k8sClient               client.Client
+	eventRecorder           record.EventRecorder
+	logger                  logr.Logger
 }
 
 func (h *enqueueRequestsForSecretEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
@@ -105,4 +110,31 @@ func (h *enqueueRequestsForSecretEvent) enqueueImpactedObjects(secret *corev1.Se
 			Object: svc,
 		}
 	}
+
+	h.enqueueImpactedListenerActions(secret)
+}
+
+// enqueueImpactedListenerActions enqueues ListenerActions referencing the Secret, so that Ingresses referencing them are reconciled.
+func (h *enqueueRequestsForSecretEvent) enqueueImpactedListenerActions(secret *corev1.Secret) {
+	if h.listenerActionEventChan == nil {
+		return
+	}
+	listenerActionList := &elbv2api.ListenerActionList{}
+	if err := h.k8sClient.List(context.Background(), listenerActionList,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeySecretRefName: secret.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerActions")
+		return
+	}
+	secretKey := k8s.NamespacedName(secret)
+	for index := range listenerActionList.Items {
+		listenerAction := &listenerActionList.Items[index]
+
+		h.logger.V(1).Info("enqueue listenerAction for secret event",
+			"secret", secretKey,
+			"listenerAction", k8s.NamespacedName(listenerAction))
+		h.listenerActionEventChan <- event.GenericEvent{
+			Object: listenerAction,
+		}
+	}
 }
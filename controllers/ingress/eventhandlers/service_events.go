@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,28 +17,32 @@ import (
 )
 
 // NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
-func NewEnqueueRequestsForServiceEvent(ingEventChan chan<- event.GenericEvent,
+// listenerActionEventChan is optional, ListenerActions referencing the Service won't be enqueued if it's nil.
+func NewEnqueueRequestsForServiceEvent(ingEventChan chan<- event.GenericEvent, listenerActionEventChan chan<- event.GenericEvent,
 	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
-		ingEventChan:  ingEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:            ingEventChan,
+		listenerActionEventChan: listenerActionEventChan,
+		k8sClient:               k8sClient,
+		eventRecorder:           eventRecorder,
+		logger:                  logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 
 type enqueueRequestsForServiceEvent struct {
-	ingEventChan  chan<- event.GenericEvent
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan            chan<- event.GenericEvent
+	listenerActionEventChan chan<- event.GenericEvent
+	k8sClient               client.Client
+	eventRecorder           record.EventRecorder
+	logger                  logr.Logger
 }
 
 func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
 	svcNew := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svcNew)
+	h.enqueueImpactedListenerActions(svcNew)
 }
 
 func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
@@ -55,16 +60,19 @@ func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, _ workqueue
 	}
 
 	h.enqueueImpactedIngresses(svcNew)
+	h.enqueueImpactedListenerActions(svcNew)
 }
 
 func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
 	svcOld := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svcOld)
+	h.enqueueImpactedListenerActions(svcOld)
 }
 
 func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
 	svc := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svc)
+	h.enqueueImpactedListenerActions(svc)
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Service) {
@@ -77,6 +85,14 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 	}
 
 	svcKey := k8s.NamespacedName(svc)
+	crossNamespaceIngList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), crossNamespaceIngList,
+		client.MatchingFields{ingress.IndexKeyCrossNamespaceServiceRefKey: svcKey.String()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	ingList.Items = append(ingList.Items, crossNamespaceIngList.Items...)
+
 	for index := range ingList.Items {
 		ing := &ingList.Items[index]
 
@@ -88,3 +104,36 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 		}
 	}
 }
+
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedListenerActions(svc *corev1.Service) {
+	if h.listenerActionEventChan == nil {
+		return
+	}
+	listenerActionList := &elbv2api.ListenerActionList{}
+	if err := h.k8sClient.List(context.Background(), listenerActionList,
+		client.InNamespace(svc.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeyServiceRefName: svc.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerActions")
+		return
+	}
+
+	svcKey := k8s.NamespacedName(svc)
+	crossNamespaceListenerActionList := &elbv2api.ListenerActionList{}
+	if err := h.k8sClient.List(context.Background(), crossNamespaceListenerActionList,
+		client.MatchingFields{ingress.IndexKeyCrossNamespaceServiceRefKey: svcKey.String()}); err != nil {
+		h.logger.Error(err, "failed to fetch listenerActions")
+		return
+	}
+	listenerActionList.Items = append(listenerActionList.Items, crossNamespaceListenerActionList.Items...)
+
+	for index := range listenerActionList.Items {
+		listenerAction := &listenerActionList.Items[index]
+
+		h.logger.V(1).Info("enqueue listenerAction for service event",
+			"service", svcKey,
+			"listenerAction", k8s.NamespacedName(listenerAction))
+		h.listenerActionEventChan <- event.GenericEvent{
+			Object: listenerAction,
+		}
+	}
+}
@@ -3,6 +3,7 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -20,12 +21,15 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/policy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -34,51 +38,59 @@ import (
 )
 
 const (
-	ingressTagPrefix = "ingress.k8s.aws"
+	// IngressTagPrefix is the prefix of tags on AWS resources provisioned for Ingress groups.
+	IngressTagPrefix = "ingress.k8s.aws"
 	controllerName   = "ingress"
 
 	// the groupVersion of used Ingress & IngressClass resource.
 	ingressResourcesGroupVersion = "networking.k8s.io/v1"
 	ingressClassKind             = "IngressClass"
+	// the kind of ListenerAction resource in elbv2 groupVersion.
+	listenerActionKind = "ListenerAction"
+	// the kind of BackendGrant resource in elbv2 groupVersion.
+	backendGrantKind = "BackendGrant"
+	// the NamespacedIngressClassParams kind
+	namespacedIngressClassParamsKind = "NamespacedIngressClassParams"
 )
 
 // NewGroupReconciler constructs new GroupReconciler
-func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
-	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
-	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
-	elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
-	sgResolver networkingpkg.SecurityGroupResolver, logger logr.Logger) *groupReconciler {
+func NewGroupReconciler(cloudScopeProvider deploy.CloudScopeProvider, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, controllerConfig config.ControllerConfig, driftMonitor drift.Monitor,
+	shardCoordinator shard.Coordinator, logger logr.Logger) *groupReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
-	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction)
+	enhancedBackendBuilder := newEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig)
 	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
-	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName)
-	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
-		cloud.EC2(), cloud.ELBV2(), cloud.ACM(),
-		annotationParser, subnetsResolver,
-		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
-		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider, sgResolver,
-		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager,
-		controllerConfig, ingressTagPrefix, logger)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
 	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
 	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
+	policyEvaluator := policy.NewDefaultLoadBalancerPolicyEvaluator(k8sClient, logger)
+	var lbStateReporter deploy.LoadBalancerStateReporter
+	if controllerConfig.EnableLoadBalancerState {
+		lbStateReporter = deploy.NewDefaultLoadBalancerStateReporter(k8sClient, logger)
+	}
 
 	return &groupReconciler{
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		referenceIndexer:  referenceIndexer,
-		modelBuilder:      modelBuilder,
-		stackMarshaller:   stackMarshaller,
-		stackDeployer:     stackDeployer,
-		backendSGProvider: backendSGProvider,
+		k8sClient:              k8sClient,
+		eventRecorder:          eventRecorder,
+		referenceIndexer:       referenceIndexer,
+		annotationParser:       annotationParser,
+		authConfigBuilder:      authConfigBuilder,
+		enhancedBackendBuilder: enhancedBackendBuilder,
+		stackMarshaller:        stackMarshaller,
+		policyEvaluator:        policyEvaluator,
+		lbStateReporter:        lbStateReporter,
+		driftMonitor:           driftMonitor,
+		shardCoordinator:       shardCoordinator,
+		cloudScopeProvider:     cloudScopeProvider,
+		groupScopes:            make(map[ingress.AssumeRole]*groupScope),
+		controllerConfig:       controllerConfig,
 
+		classLoader:           classLoader,
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
 		logger:                logger,
@@ -87,17 +99,66 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 	}
 }
 
+// NewAdmissionModelBuilder constructs the ModelBuilder to build IngressGroups at admission time.
+// It never creates or deletes AWS resources.
+func NewAdmissionModelBuilder(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	subnetsResolver networkingpkg.SubnetsResolver, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	sgResolver networkingpkg.SecurityGroupResolver, logger logr.Logger) ingress.ModelBuilder {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
+	enhancedBackendBuilder := newEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig)
+	backendSGProvider := networkingpkg.NewDryRunBackendSGProvider(controllerConfig.BackendSecurityGroup)
+	return newModelBuilder(cloud, k8sClient, eventRecorder, annotationParser, authConfigBuilder, enhancedBackendBuilder,
+		subnetsResolver, elbv2TaggingManager, controllerConfig, backendSGProvider, sgResolver, logger)
+}
+
+func newEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder ingress.AuthConfigBuilder,
+	controllerConfig config.ControllerConfig) ingress.EnhancedBackendBuilder {
+	backendGrantChecker := ingress.NewDefaultBackendGrantChecker(k8sClient)
+	return ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, backendGrantChecker, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction)
+}
+
+func newModelBuilder(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	annotationParser annotations.Parser, authConfigBuilder ingress.AuthConfigBuilder, enhancedBackendBuilder ingress.EnhancedBackendBuilder,
+	subnetsResolver networkingpkg.SubnetsResolver, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver, logger logr.Logger) ingress.ModelBuilder {
+	trackingProvider := tracking.NewDefaultProvider(IngressTagPrefix, controllerConfig.ClusterName)
+	return ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+		cloud.EC2(), cloud.ELBV2(), cloud.ACM(),
+		annotationParser, subnetsResolver,
+		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider, sgResolver,
+		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+}
+
 // GroupReconciler reconciles a IngressGroup
 type groupReconciler struct {
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	referenceIndexer  ingress.ReferenceIndexer
-	modelBuilder      ingress.ModelBuilder
-	stackMarshaller   deploy.StackMarshaller
-	stackDeployer     deploy.StackDeployer
-	backendSGProvider networkingpkg.BackendSGProvider
-	secretsManager    k8s.SecretsManager
+	k8sClient              client.Client
+	eventRecorder          record.EventRecorder
+	referenceIndexer       ingress.ReferenceIndexer
+	annotationParser       annotations.Parser
+	authConfigBuilder      ingress.AuthConfigBuilder
+	enhancedBackendBuilder ingress.EnhancedBackendBuilder
+	stackMarshaller        deploy.StackMarshaller
+	secretsManager         k8s.SecretsManager
+	policyEvaluator        policy.LoadBalancerPolicyEvaluator
+	// lbStateReporter is nil unless reporting of LoadBalancerState is enabled.
+	lbStateReporter deploy.LoadBalancerStateReporter
+	// driftMonitor is nil unless drift detection is enabled.
+	driftMonitor drift.Monitor
+	// driftEventChan triggers reconcile of IngressGroups with drifted AWS resources.
+	driftEventChan chan<- event.GenericEvent
+	// shardCoordinator is nil unless sharding of reconciliation is enabled.
+	shardCoordinator shard.Coordinator
+	// cloudScopeProvider provides the AWS clients for the IAM role that IngressGroups assume.
+	cloudScopeProvider deploy.CloudScopeProvider
+	// groupScopes caches the groupScope per IAM role to assume.
+	groupScopes      map[ingress.AssumeRole]*groupScope
+	groupScopesMutex sync.Mutex
+	controllerConfig config.ControllerConfig
 
+	classLoader           ingress.ClassLoader
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
 	logger                logr.Logger
@@ -105,7 +166,24 @@ type groupReconciler struct {
 	maxConcurrentReconciles int
 }
 
+// groupScope contains the components that build and deploy IngressGroups with the AWS clients of a CloudScope.
+type groupScope struct {
+	modelBuilder      ingress.ModelBuilder
+	stackDeployer     deploy.StackDeployer
+	backendSGProvider networkingpkg.BackendSGProvider
+	// memberQuarantiner is nil unless quarantine of faulty Ingresses is enabled.
+	memberQuarantiner ingress.MemberQuarantiner
+	// crossAccount is whether AWS resources are provisioned with an assumed IAM role.
+	crossAccount bool
+}
+
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=namespacedingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=listeneractions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=backendgrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerstates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerstates/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -120,6 +198,13 @@ func (r *groupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
 	ingGroupID := ingress.DecodeGroupIDFromReconcileRequest(req)
+	if r.shardCoordinator != nil {
+		release, owned := r.shardCoordinator.Claim(ingGroupID.String())
+		if !owned {
+			return nil
+		}
+		defer release()
+	}
 	ingGroup, err := r.groupLoader.Load(ctx, ingGroupID)
 	if err != nil {
 		return err
@@ -129,7 +214,13 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
+	scope, err := r.resolveGroupScope(ctx, ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAssumeRole, fmt.Sprintf("Failed assume IAM role due to %v", err))
+		r.reportIngressGroupFailure(ctx, ingGroup, k8s.IngressEventReasonFailedAssumeRole, err)
+		return err
+	}
+	_, lb, quarantinedMembers, err := r.buildAndDeployModel(ctx, ingGroup, scope)
 	if err != nil {
 		return err
 	}
@@ -145,45 +236,260 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 		}
 	}
 
+	r.recordIngressGroupQuarantine(quarantinedMembers)
+
 	if len(ingGroup.InactiveMembers) > 0 {
 		if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroupID, ingGroup.InactiveMembers); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
 		}
+		if scope.memberQuarantiner != nil {
+			scope.memberQuarantiner.Forget(k8s.ToSliceOfNamespacedNames(ingGroup.InactiveMembers))
+		}
 	}
 
-	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	r.recordIngressGroupEvent(ctx, excludeQuarantinedMembers(ingGroup, quarantinedMembers), corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
 }
 
-func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, secrets, backendSGRequired, err := r.modelBuilder.Build(ctx, ingGroup)
+func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group, scope *groupScope) (core.Stack, *elbv2model.LoadBalancer, []ingress.QuarantinedMember, error) {
+	result, err := r.buildModel(ctx, ingGroup, scope)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
+		r.reportIngressGroupFailure(ctx, ingGroup, k8s.IngressEventReasonFailedBuildModel, err)
+		return nil, nil, nil, err
 	}
+	stack := result.Stack
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
+		r.reportIngressGroupFailure(ctx, ingGroup, k8s.IngressEventReasonFailedBuildModel, err)
+		return nil, nil, nil, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+	if err := policy.CheckLoadBalancerPolicies(ctx, r.policyEvaluator, ingGroup.MemberNamespaces(), stack); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonPolicyViolation, fmt.Sprintf("Failed check LoadBalancerPolicy due to %v", err))
+		r.reportIngressGroupFailure(ctx, ingGroup, k8s.IngressEventReasonPolicyViolation, err)
+		return nil, nil, nil, err
+	}
+
+	if r.driftMonitor != nil {
+		r.driftMonitor.Untrack(controllerName, stack.StackID())
+	}
+	if err := scope.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
-		return nil, nil, err
+		r.reportIngressGroupFailure(ctx, ingGroup, k8s.IngressEventReasonFailedDeployModel, err)
+		return nil, nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
-	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
+	if err := r.reportIngressGroupState(ctx, result); err != nil {
+		return nil, nil, nil, err
+	}
+	if !scope.crossAccount {
+		r.trackIngressGroupDrift(result)
+	}
+	if scope.memberQuarantiner != nil {
+		scope.memberQuarantiner.MarkDeployed(result)
+	}
+	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), result.Secrets)
 	var inactiveResources []types.NamespacedName
 	inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.InactiveMembers)...)
-	if !backendSGRequired {
+	if !result.BackendSGRequired {
 		inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.Members)...)
 	}
-	if err := r.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
-		return nil, nil, err
+	if err := scope.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
+		return nil, nil, nil, err
+	}
+	return stack, result.LoadBalancer, result.QuarantinedMembers, nil
+}
+
+// buildModel builds model for IngressGroup, with faulty members quarantined if enabled.
+func (r *groupReconciler) buildModel(ctx context.Context, ingGroup ingress.Group, scope *groupScope) (ingress.QuarantineBuildResult, error) {
+	if scope.memberQuarantiner != nil {
+		return scope.memberQuarantiner.Build(ctx, ingGroup)
+	}
+	stack, lb, secrets, backendSGRequired, err := scope.modelBuilder.Build(ctx, ingGroup)
+	if err != nil {
+		return ingress.QuarantineBuildResult{}, err
+	}
+	return ingress.QuarantineBuildResult{
+		Stack:             stack,
+		LoadBalancer:      lb,
+		Secrets:           secrets,
+		BackendSGRequired: backendSGRequired,
+		EffectiveGroup:    ingGroup,
+	}, nil
+}
+
+// resolveGroupScope resolves the groupScope for the IAM role that IngressGroup assumes.
+func (r *groupReconciler) resolveGroupScope(ctx context.Context, ingGroup ingress.Group) (*groupScope, error) {
+	assumeRole, err := ingress.ResolveGroupAssumeRole(ctx, r.classLoader, ingGroup)
+	if err != nil {
+		return nil, err
+	}
+	r.groupScopesMutex.Lock()
+	defer r.groupScopesMutex.Unlock()
+	if scope, ok := r.groupScopes[assumeRole]; ok {
+		return scope, nil
+	}
+	cloudScope, err := r.cloudScopeProvider.ScopeForRole(assumeRole.RoleARN, assumeRole.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	scope := r.buildGroupScope(cloudScope)
+	r.groupScopes[assumeRole] = scope
+	return scope, nil
+}
+
+// buildGroupScope builds the groupScope that builds and deploys IngressGroups with the AWS clients of cloudScope.
+func (r *groupReconciler) buildGroupScope(cloudScope deploy.CloudScope) *groupScope {
+	logger := r.logger
+	if len(cloudScope.RoleARN) != 0 {
+		logger = logger.WithValues("iamRole", cloudScope.RoleARN)
+	}
+	modelBuilder := newModelBuilder(cloudScope.Cloud, r.k8sClient, r.eventRecorder, r.annotationParser, r.authConfigBuilder, r.enhancedBackendBuilder,
+		cloudScope.SubnetsResolver, cloudScope.ELBV2TaggingManager, r.controllerConfig, cloudScope.BackendSGProvider, cloudScope.SGResolver, logger)
+	stackDeployer := deploy.NewDefaultStackDeployer(cloudScope.Cloud, r.k8sClient, cloudScope.SGManager, cloudScope.SGReconciler, cloudScope.ELBV2TaggingManager,
+		r.controllerConfig, IngressTagPrefix, logger)
+	var memberQuarantiner ingress.MemberQuarantiner
+	if r.controllerConfig.IngressConfig.EnableQuarantine {
+		memberQuarantiner = ingress.NewDefaultMemberQuarantiner(modelBuilder, logger)
+	}
+	return &groupScope{
+		modelBuilder:      modelBuilder,
+		stackDeployer:     stackDeployer,
+		backendSGProvider: cloudScope.BackendSGProvider,
+		memberQuarantiner: memberQuarantiner,
+		crossAccount:      len(cloudScope.RoleARN) != 0,
+	}
+}
+
+// reportIngressGroupState reports the reconciled state of load balancer for IngressGroup, or deletes it once the load balancer is deleted.
+func (r *groupReconciler) reportIngressGroupState(ctx context.Context, result ingress.QuarantineBuildResult) error {
+	if r.lbStateReporter == nil {
+		return nil
+	}
+	source := buildLoadBalancerSource(result.EffectiveGroup.ID)
+	if result.LoadBalancer == nil {
+		return r.lbStateReporter.Delete(ctx, source)
+	}
+	ingGroup := excludeQuarantinedMembers(result.EffectiveGroup, result.QuarantinedMembers)
+	members := append(buildLoadBalancerStateMembers(ingGroup), buildQuarantinedLoadBalancerStateMembers(result.QuarantinedMembers)...)
+	return r.lbStateReporter.ReportReconciled(ctx, source, members, result.Stack)
+}
+
+// trackIngressGroupDrift tracks the deployed stack of IngressGroup for drift detection, drift is reported on its active members.
+func (r *groupReconciler) trackIngressGroupDrift(result ingress.QuarantineBuildResult) {
+	if r.driftMonitor == nil || result.LoadBalancer == nil {
+		return
+	}
+	ingGroup := excludeQuarantinedMembers(result.EffectiveGroup, result.QuarantinedMembers)
+	objects := make([]client.Object, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		objects = append(objects, member.Ing)
+	}
+	r.driftMonitor.Track(controllerName, result.Stack, drift.Target{
+		Objects:       objects,
+		EventRecorder: r.eventRecorder,
+		EventReason:   k8s.IngressEventReasonDriftDetected,
+		EventChan:     r.driftEventChan,
+	})
+}
+
+// reportIngressGroupFailure reports the failed reconcile of IngressGroup.
+// failures to report are only logged, so that the reconcile error is returned.
+func (r *groupReconciler) reportIngressGroupFailure(ctx context.Context, ingGroup ingress.Group, reason string, reconcileErr error) {
+	if r.lbStateReporter == nil {
+		return
+	}
+	source := buildLoadBalancerSource(ingGroup.ID)
+	if err := r.lbStateReporter.ReportFailed(ctx, source, buildLoadBalancerStateMembers(ingGroup), reason, reconcileErr); err != nil {
+		r.logger.Error(err, "failed to report loadBalancerState", "ingressGroup", ingGroup.ID)
+	}
+}
+
+func buildLoadBalancerSource(groupID ingress.GroupID) elbv2api.LoadBalancerSource {
+	return elbv2api.LoadBalancerSource{
+		Kind:      elbv2api.LoadBalancerSourceKindIngressGroup,
+		Namespace: groupID.Namespace,
+		Name:      groupID.Name,
+	}
+}
+
+func buildLoadBalancerStateMembers(ingGroup ingress.Group) []elbv2api.LoadBalancerStateMember {
+	members := make([]elbv2api.LoadBalancerStateMember, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		members = append(members, elbv2api.LoadBalancerStateMember{
+			Namespace:          member.Ing.Namespace,
+			Name:               member.Ing.Name,
+			ObservedGeneration: member.Ing.Generation,
+		})
+	}
+	return members
+}
+
+// buildQuarantinedLoadBalancerStateMembers builds the members with Quarantined condition for quarantined members.
+// the condition has a stable reason and message, the error that failed model building is only recorded in Warning Events,
+// so that the status doesn't change while the same error recurs.
+func buildQuarantinedLoadBalancerStateMembers(quarantinedMembers []ingress.QuarantinedMember) []elbv2api.LoadBalancerStateMember {
+	members := make([]elbv2api.LoadBalancerStateMember, 0, len(quarantinedMembers))
+	for _, member := range quarantinedMembers {
+		message := "Excluded from load balancer, see Warning Events of the Ingress for details"
+		if member.LastDeployedPreserved {
+			message = "Last deployed rules kept, see Warning Events of the Ingress for details"
+		}
+		members = append(members, elbv2api.LoadBalancerStateMember{
+			Namespace:          member.Ing.Namespace,
+			Name:               member.Ing.Name,
+			ObservedGeneration: member.Ing.Generation,
+			Conditions: []metav1.Condition{
+				{
+					Type:               elbv2api.LoadBalancerStateMemberConditionQuarantined,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: member.Ing.Generation,
+					Reason:             k8s.IngressEventReasonFailedBuildModel,
+					Message:            message,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+		})
+	}
+	return members
+}
+
+// recordIngressGroupQuarantine records the quarantine of members via Warning Events with the full reason.
+// the quarantine is also reported as a condition of members in LoadBalancerState, which only has a stable reason.
+func (r *groupReconciler) recordIngressGroupQuarantine(quarantinedMembers []ingress.QuarantinedMember) {
+	for _, member := range quarantinedMembers {
+		message := fmt.Sprintf("Quarantined from IngressGroup and excluded from load balancer due to %v", member.Reason)
+		if member.LastDeployedPreserved {
+			message = fmt.Sprintf("Quarantined from IngressGroup with last deployed rules kept due to %v", member.Reason)
+		}
+		r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonQuarantined, message)
+	}
+}
+
+// excludeQuarantinedMembers returns the IngressGroup without quarantined members.
+func excludeQuarantinedMembers(ingGroup ingress.Group, quarantinedMembers []ingress.QuarantinedMember) ingress.Group {
+	if len(quarantinedMembers) == 0 {
+		return ingGroup
+	}
+	quarantinedIngKeys := make(map[types.NamespacedName]bool, len(quarantinedMembers))
+	for _, member := range quarantinedMembers {
+		quarantinedIngKeys[k8s.NamespacedName(member.Ing)] = true
+	}
+	var members []ingress.ClassifiedIngress
+	for _, member := range ingGroup.Members {
+		if !quarantinedIngKeys[k8s.NamespacedName(member.Ing)] {
+			members = append(members, member)
+		}
+	}
+	return ingress.Group{
+		ID:              ingGroup.ID,
+		Members:         members,
+		InactiveMembers: ingGroup.InactiveMembers,
 	}
-	return stack, lb, nil
 }
 
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
@@ -232,16 +538,23 @@ func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager
 		return err
 	}
 	ingressClassResourceAvailable := isResourceKindAvailable(resList, ingressClassKind)
-	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer(), ingressClassResourceAvailable); err != nil {
+	elbv2ResList, err := clientSet.ServerResourcesForGroupVersion(elbv2api.GroupVersion.String())
+	if err != nil {
+		return err
+	}
+	listenerActionResourceAvailable := isResourceKindAvailable(elbv2ResList, listenerActionKind)
+	backendGrantResourceAvailable := isResourceKindAvailable(elbv2ResList, backendGrantKind)
+	namespacedIngressClassParamsResourceAvailable := ingressClassResourceAvailable && isResourceKindAvailable(elbv2ResList, namespacedIngressClassParamsKind)
+	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer(), ingressClassResourceAvailable, listenerActionResourceAvailable); err != nil {
 		return err
 	}
-	if err := r.setupWatches(ctx, c, ingressClassResourceAvailable, clientSet); err != nil {
+	if err := r.setupWatches(ctx, c, ingressClassResourceAvailable, listenerActionResourceAvailable, backendGrantResourceAvailable, namespacedIngressClassParamsResourceAvailable, clientSet); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer, ingressClassResourceAvailable bool) error {
+func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer, ingressClassResourceAvailable bool, listenerActionResourceAvailable bool) error {
 	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyServiceRefName,
 		func(obj client.Object) []string {
 			return r.referenceIndexer.BuildServiceRefIndexes(context.Background(), obj.(*networking.Ingress))
@@ -256,6 +569,20 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyCrossNamespaceServiceRefKey,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildCrossNamespaceServiceRefKeyIndexes(context.Background(), obj)
+		},
+	); err != nil {
+		return err
+	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyCrossNamespaceServiceRefNamespace,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildCrossNamespaceServiceRefNamespaceIndexes(context.Background(), obj)
+		},
+	); err != nil {
+		return err
+	}
 	if err := fieldIndexer.IndexField(ctx, &corev1.Service{}, ingress.IndexKeySecretRefName,
 		func(obj client.Object) []string {
 			return r.referenceIndexer.BuildSecretRefIndexes(context.Background(), obj.(*corev1.Service))
@@ -279,22 +606,76 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 			return err
 		}
 	}
+	if listenerActionResourceAvailable {
+		if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyListenerActionRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildListenerActionRefIndexes(ctx, obj.(*networking.Ingress))
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerAction{}, ingress.IndexKeyServiceRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildListenerActionServiceRefIndexes(ctx, obj.(*elbv2api.ListenerAction))
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerAction{}, ingress.IndexKeySecretRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildListenerActionSecretRefIndexes(ctx, obj.(*elbv2api.ListenerAction))
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerAction{}, ingress.IndexKeyCrossNamespaceServiceRefKey,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildCrossNamespaceServiceRefKeyIndexes(ctx, obj)
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.ListenerAction{}, ingress.IndexKeyCrossNamespaceServiceRefNamespace,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildCrossNamespaceServiceRefNamespaceIndexes(ctx, obj)
+			},
+		); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controller, ingressClassResourceAvailable bool, clientSet *kubernetes.Clientset) error {
+func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controller, ingressClassResourceAvailable bool, listenerActionResourceAvailable bool, backendGrantResourceAvailable bool, namespacedIngressClassParamsResourceAvailable bool, clientSet *kubernetes.Clientset) error {
 	ingEventChan := make(chan event.GenericEvent)
 	svcEventChan := make(chan event.GenericEvent)
 	secretEventsChan := make(chan event.GenericEvent)
+	var listenerActionEventChan chan event.GenericEvent
+	if listenerActionResourceAvailable {
+		listenerActionEventChan = make(chan event.GenericEvent)
+	}
 	ingEventHandler := eventhandlers.NewEnqueueRequestsForIngressEvent(r.groupLoader, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("ingress"))
-	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, listenerActionEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("service"))
-	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
+	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, listenerActionEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
 	if err := c.Watch(&source.Channel{Source: ingEventChan}, ingEventHandler); err != nil {
 		return err
 	}
+	r.driftEventChan = ingEventChan
+	if r.shardCoordinator != nil {
+		r.shardCoordinator.AddShardsAcquiredHandler(func(ctx context.Context) {
+			r.resyncIngresses(ctx, ingEventChan)
+		})
+		if r.driftMonitor != nil {
+			r.shardCoordinator.AddShardsLostHandler(func(_ context.Context) {
+				r.driftMonitor.UntrackIf(controllerName, func(stackID core.StackID) bool {
+					return !r.shardCoordinator.Owns(stackID.String())
+				})
+			})
+		}
+	}
 	if err := c.Watch(&source.Channel{Source: svcEventChan}, svcEventHandler); err != nil {
 		return err
 	}
@@ -319,14 +700,54 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 		if err := c.Watch(&source.Kind{Type: &elbv2api.IngressClassParams{}}, ingClassParamsEventHandler); err != nil {
 			return err
 		}
+		if namespacedIngressClassParamsResourceAvailable {
+			nsIngClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForNamespacedIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
+				r.logger.WithName("eventHandlers").WithName("namespacedIngressClassParams"))
+			if err := c.Watch(&source.Kind{Type: &elbv2api.NamespacedIngressClassParams{}}, nsIngClassParamsEventHandler); err != nil {
+				return err
+			}
+		}
 		if err := c.Watch(&source.Kind{Type: &networking.IngressClass{}}, ingClassEventHandler); err != nil {
 			return err
 		}
 	}
+	if listenerActionResourceAvailable {
+		listenerActionEventHandler := eventhandlers.NewEnqueueRequestsForListenerActionEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+			r.logger.WithName("eventHandlers").WithName("listenerAction"))
+		if err := c.Watch(&source.Channel{Source: listenerActionEventChan}, listenerActionEventHandler); err != nil {
+			return err
+		}
+		if err := c.Watch(&source.Kind{Type: &elbv2api.ListenerAction{}}, listenerActionEventHandler); err != nil {
+			return err
+		}
+	}
+	if backendGrantResourceAvailable {
+		backendGrantEventHandler := eventhandlers.NewEnqueueRequestsForBackendGrantEvent(ingEventChan, listenerActionEventChan, r.k8sClient, r.eventRecorder,
+			r.logger.WithName("eventHandlers").WithName("backendGrant"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.BackendGrant{}}, backendGrantEventHandler); err != nil {
+			return err
+		}
+	}
 	r.secretsManager = k8s.NewSecretsManager(clientSet, secretEventsChan, ctrl.Log.WithName("secrets-manager"))
 	return nil
 }
 
+// resyncIngresses triggers reconcile of all Ingresses, so that the IngressGroups of shards acquired by this replica are reconciled.
+func (r *groupReconciler) resyncIngresses(ctx context.Context, ingEventChan chan<- event.GenericEvent) {
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList); err != nil {
+		r.logger.Error(err, "failed to resync ingresses of acquired shards")
+		return
+	}
+	for i := range ingList.Items {
+		select {
+		case ingEventChan <- event.GenericEvent{Object: &ingList.Items[i]}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // isResourceKindAvailable checks whether specific kind is available.
 func isResourceKindAvailable(resList *metav1.APIResourceList, kind string) bool {
 	for _, res := range resList.APIResources {
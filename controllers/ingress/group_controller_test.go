@@ -0,0 +1,126 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// stubCloud is a Cloud without AWS clients, that only supports constructing components on top of it.
+type stubCloud struct {
+	aws.Cloud
+}
+
+func (c *stubCloud) EC2() services.EC2                 { return nil }
+func (c *stubCloud) ELBV2() services.ELBV2             { return nil }
+func (c *stubCloud) ACM() services.ACM                 { return nil }
+func (c *stubCloud) WAFv2() services.WAFv2             { return nil }
+func (c *stubCloud) WAFRegional() services.WAFRegional { return nil }
+func (c *stubCloud) Shield() services.Shield           { return nil }
+func (c *stubCloud) VpcID() string                     { return "vpc-xxx" }
+
+// countingCloudScopeProvider provides the controller's own CloudScope, and counts the calls.
+type countingCloudScopeProvider struct {
+	scope deploy.CloudScope
+	calls int
+}
+
+func (p *countingCloudScopeProvider) ScopeForRole(_ string, _ string) (deploy.CloudScope, error) {
+	p.calls++
+	return p.scope, nil
+}
+
+// stubQuarantineModelBuilder fails model building due to the first member with the faulty annotation.
+type stubQuarantineModelBuilder struct{}
+
+func (b *stubQuarantineModelBuilder) Build(_ context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error) {
+	for _, member := range ingGroup.Members {
+		if member.Ing.Annotations["alb.ingress.kubernetes.io/faulty"] == "true" {
+			return nil, nil, nil, false, ingress.NewMemberBuildError(k8s.NamespacedName(member.Ing), errors.New("faulty annotation"))
+		}
+	}
+	return core.NewDefaultStack(core.StackID(ingGroup.ID)), nil, nil, false, nil
+}
+
+func Test_groupReconciler_resolveGroupScope_keepsLastDeployedMembersAcrossReconciles(t *testing.T) {
+	newIngress := func(name string, host string, faulty bool) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        name,
+				Annotations: map[string]string{},
+			},
+			Spec: networking.IngressSpec{
+				Rules: []networking.IngressRule{{Host: host}},
+			},
+		}
+		if faulty {
+			ing.Annotations["alb.ingress.kubernetes.io/faulty"] = "true"
+		}
+		ing.Status.LoadBalancer.Ingress = []networking.IngressLoadBalancerIngress{{Hostname: "lb.example.com"}}
+		return ing
+	}
+	groupID := ingress.NewGroupIDForExplicitGroup("awesome-group")
+	cloudScopeProvider := &countingCloudScopeProvider{scope: deploy.CloudScope{Cloud: &stubCloud{}}}
+	r := &groupReconciler{
+		cloudScopeProvider: cloudScopeProvider,
+		groupScopes:        make(map[ingress.AssumeRole]*groupScope),
+		controllerConfig: config.ControllerConfig{
+			FeatureGates:  config.NewFeatureGates(),
+			IngressConfig: config.IngressConfig{EnableQuarantine: true},
+		},
+		logger: log.Log,
+	}
+	ctx := context.Background()
+
+	// first reconcile deploys both members.
+	firstGroup := ingress.Group{
+		ID: groupID,
+		Members: []ingress.ClassifiedIngress{
+			{Ing: newIngress("ing-1", "v1.example.com", false)},
+			{Ing: newIngress("ing-2", "other.example.com", false)},
+		},
+	}
+	scope, err := r.resolveGroupScope(ctx, firstGroup)
+	assert.NoError(t, err)
+	scope.memberQuarantiner = ingress.NewDefaultMemberQuarantiner(&stubQuarantineModelBuilder{}, log.Log)
+	result, err := r.buildModel(ctx, firstGroup, scope)
+	assert.NoError(t, err)
+	assert.Empty(t, result.QuarantinedMembers)
+	scope.memberQuarantiner.MarkDeployed(result)
+
+	// second reconcile quarantines ing-1, whose rules of the first reconcile are kept.
+	secondGroup := ingress.Group{
+		ID: groupID,
+		Members: []ingress.ClassifiedIngress{
+			{Ing: newIngress("ing-1", "v2.example.com", true)},
+			{Ing: newIngress("ing-2", "other.example.com", false)},
+		},
+	}
+	scope, err = r.resolveGroupScope(ctx, secondGroup)
+	assert.NoError(t, err)
+	result, err = r.buildModel(ctx, secondGroup, scope)
+	assert.NoError(t, err)
+	if assert.Len(t, result.QuarantinedMembers, 1) {
+		assert.Equal(t, "ing-1", result.QuarantinedMembers[0].Ing.Name)
+		assert.True(t, result.QuarantinedMembers[0].LastDeployedPreserved)
+	}
+	if assert.Len(t, result.EffectiveGroup.Members, 2) {
+		assert.Equal(t, "v1.example.com", result.EffectiveGroup.Members[0].Ing.Spec.Rules[0].Host)
+	}
+	assert.Equal(t, 1, cloudScopeProvider.calls)
+}
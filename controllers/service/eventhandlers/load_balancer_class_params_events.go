@@ -0,0 +1,92 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForLoadBalancerClassParamsEvent constructs new enqueueRequestsForLoadBalancerClassParamsEvent.
+func NewEnqueueRequestsForLoadBalancerClassParamsEvent(k8sClient client.Client,
+	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForLoadBalancerClassParamsEvent {
+	return &enqueueRequestsForLoadBalancerClassParamsEvent{
+		k8sClient:    k8sClient,
+		serviceUtils: serviceUtils,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForLoadBalancerClassParamsEvent)(nil)
+
+type enqueueRequestsForLoadBalancerClassParamsEvent struct {
+	k8sClient    client.Client
+	serviceUtils svcpkg.ServiceUtils
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	paramsNew := e.Object.(*elbv2api.LoadBalancerClassParams)
+	h.enqueueImpactedServices(queue, paramsNew.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	paramsOld := e.ObjectOld.(*elbv2api.LoadBalancerClassParams)
+	paramsNew := e.ObjectNew.(*elbv2api.LoadBalancerClassParams)
+
+	// we only care below update event:
+	//	1. LoadBalancerClassParams spec updates
+	//	2. LoadBalancerClassParams deletion
+	if equality.Semantic.DeepEqual(paramsOld.Spec, paramsNew.Spec) &&
+		equality.Semantic.DeepEqual(paramsOld.DeletionTimestamp.IsZero(), paramsNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedServices(queue, paramsOld.Spec.LoadBalancerClass, paramsNew.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	paramsOld := e.Object.(*elbv2api.LoadBalancerClassParams)
+	h.enqueueImpactedServices(queue, paramsOld.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// we don't have any generic event for LoadBalancerClassParams.
+}
+
+// enqueueImpactedServices will enqueue all Services with any of the loadBalancerClasses.
+func (h *enqueueRequestsForLoadBalancerClassParamsEvent) enqueueImpactedServices(queue workqueue.RateLimitingInterface, lbClasses ...string) {
+	lbClassSet := sets.NewString(lbClasses...)
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(context.Background(), svcList); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+		if svc.Spec.LoadBalancerClass == nil || !lbClassSet.Has(*svc.Spec.LoadBalancerClass) {
+			continue
+		}
+		if !h.serviceUtils.IsServicePendingFinalization(svc) && !h.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		h.logger.V(1).Info("enqueue service for loadBalancerClassParams event",
+			"service", svc.Name, "loadBalancerClass", *svc.Spec.LoadBalancerClass)
+		queue.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: svc.Namespace,
+				Name:      svc.Name,
+			},
+		})
+	}
+}
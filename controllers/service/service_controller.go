@@ -3,12 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
@@ -16,58 +24,96 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/policy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
-	serviceFinalizer        = "service.k8s.aws/resources"
-	serviceTagPrefix        = "service.k8s.aws"
+	// ServiceFinalizer is the finalizer on Services whose AWS resources are provisioned by the controller.
+	ServiceFinalizer = "service.k8s.aws/resources"
+	// ServiceTagPrefix is the prefix of tags on AWS resources provisioned for Services.
+	ServiceTagPrefix        = "service.k8s.aws"
 	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
+
+	// serviceConditionTypeVPCEndpointService is the Service condition that carries the status of VPC endpoint service.
+	serviceConditionTypeVPCEndpointService = "service.k8s.aws/VPCEndpointService"
+	// serviceConditionReasonVPCEndpointServiceAvailable is the reason of VPCEndpointService condition once the endpoint service is provisioned.
+	serviceConditionReasonVPCEndpointServiceAvailable = "Available"
 )
 
-func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
-	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
-	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
-	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
-	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger) *serviceReconciler {
+func NewServiceReconciler(cloudScopeProvider deploy.CloudScopeProvider, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, controllerConfig config.ControllerConfig, driftMonitor drift.Monitor,
+	shardCoordinator shard.Coordinator, logger logr.Logger) *serviceReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
-	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
-	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
-	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
-		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
-		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, logger)
+	serviceUtils := service.NewServiceUtils(annotationParser, ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass,
+		controllerConfig.ServiceConfig.ALBLoadBalancerClass, controllerConfig.FeatureGates)
+	policyEvaluator := policy.NewDefaultLoadBalancerPolicyEvaluator(k8sClient, logger)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, serviceTagPrefix, logger)
+	var lbStateReporter deploy.LoadBalancerStateReporter
+	if controllerConfig.EnableLoadBalancerState {
+		lbStateReporter = deploy.NewDefaultLoadBalancerStateReporter(k8sClient, logger)
+	}
 	return &serviceReconciler{
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		finalizerManager:  finalizerManager,
-		annotationParser:  annotationParser,
-		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
-		serviceUtils:      serviceUtils,
-		backendSGProvider: backendSGProvider,
-
-		modelBuilder:    modelBuilder,
-		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
-		logger:          logger,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		finalizerManager:    finalizerManager,
+		annotationParser:    annotationParser,
+		loadBalancerClass:   controllerConfig.ServiceConfig.LoadBalancerClass,
+		serviceUtils:        serviceUtils,
+		policyEvaluator:     policyEvaluator,
+		lbClassParamsLoader: service.NewDefaultLoadBalancerClassParamsLoader(k8sClient),
+
+		stackMarshaller:    stackMarshaller,
+		lbStateReporter:    lbStateReporter,
+		driftMonitor:       driftMonitor,
+		shardCoordinator:   shardCoordinator,
+		cloudScopeProvider: cloudScopeProvider,
+		serviceScopes:      make(map[assumeRoleKey]*serviceScope),
+		controllerConfig:   controllerConfig,
+		logger:             logger,
 
 		maxConcurrentReconciles: controllerConfig.ServiceMaxConcurrentReconciles,
 	}
 }
 
+// NewAdmissionModelBuilder constructs the ModelBuilder to build Services at admission time.
+// It never creates or deletes AWS resources.
+func NewAdmissionModelBuilder(cloud aws.Cloud, k8sClient client.Client, subnetsResolver networking.SubnetsResolver,
+	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	sgResolver networking.SecurityGroupResolver, logger logr.Logger) service.ModelBuilder {
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	serviceUtils := service.NewServiceUtils(annotationParser, ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass,
+		controllerConfig.ServiceConfig.ALBLoadBalancerClass, controllerConfig.FeatureGates)
+	backendSGProvider := networking.NewDryRunBackendSGProvider(controllerConfig.BackendSecurityGroup)
+	return newModelBuilder(cloud, k8sClient, annotationParser, subnetsResolver, vpcInfoProvider, elbv2TaggingManager, controllerConfig,
+		serviceUtils, backendSGProvider, sgResolver, logger)
+}
+
+func newModelBuilder(cloud aws.Cloud, k8sClient client.Client, annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
+	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	serviceUtils service.ServiceUtils, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger) service.ModelBuilder {
+	trackingProvider := tracking.NewDefaultProvider(ServiceTagPrefix, controllerConfig.ClusterName)
+	return service.NewDefaultModelBuilder(k8sClient, annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
+		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, logger)
+}
+
 type serviceReconciler struct {
 	k8sClient         client.Client
 	eventRecorder     record.EventRecorder
@@ -75,57 +121,154 @@ type serviceReconciler struct {
 	annotationParser  annotations.Parser
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
-	backendSGProvider networking.BackendSGProvider
+	policyEvaluator   policy.LoadBalancerPolicyEvaluator
+	// lbClassParamsLoader loads the LoadBalancerClassParams that names the IAM role Services assume.
+	lbClassParamsLoader service.LoadBalancerClassParamsLoader
 
-	modelBuilder    service.ModelBuilder
 	stackMarshaller deploy.StackMarshaller
-	stackDeployer   deploy.StackDeployer
-	logger          logr.Logger
+	// lbStateReporter is nil unless reporting of LoadBalancerState is enabled.
+	lbStateReporter deploy.LoadBalancerStateReporter
+	// driftMonitor is nil unless drift detection is enabled.
+	driftMonitor drift.Monitor
+	// driftEventChan triggers reconcile of Services with drifted AWS resources.
+	driftEventChan chan<- event.GenericEvent
+	// shardCoordinator is nil unless sharding of reconciliation is enabled.
+	shardCoordinator shard.Coordinator
+	// cloudScopeProvider provides the AWS clients for the IAM role that Services assume.
+	cloudScopeProvider deploy.CloudScopeProvider
+	// serviceScopes caches the serviceScope per IAM role to assume.
+	serviceScopes      map[assumeRoleKey]*serviceScope
+	serviceScopesMutex sync.Mutex
+	controllerConfig   config.ControllerConfig
+	logger             logr.Logger
 
 	maxConcurrentReconciles int
 }
 
+// assumeRoleKey identifies the IAM role to assume, it's empty for the controller's own credentials.
+type assumeRoleKey struct {
+	roleARN    string
+	externalID string
+}
+
+// serviceScope contains the components that build and deploy Services with the AWS clients of a CloudScope.
+type serviceScope struct {
+	modelBuilder      service.ModelBuilder
+	stackDeployer     deploy.StackDeployer
+	backendSGProvider networking.BackendSGProvider
+	// crossAccount is whether AWS resources are provisioned with an assumed IAM role.
+	crossAccount bool
+}
+
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerstates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerstates/status,verbs=update;patch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
 }
 
 func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	if r.shardCoordinator != nil {
+		release, owned := r.shardCoordinator.Claim(req.NamespacedName.String())
+		if !owned {
+			return nil
+		}
+		defer release()
+	}
 	svc := &corev1.Service{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
 		return client.IgnoreNotFound(err)
 	}
-	stack, lb, backendSGRequired, err := r.buildModel(ctx, svc)
+	scope, err := r.resolveServiceScope(ctx, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAssumeRole, fmt.Sprintf("Failed assume IAM role due to %v", err))
+		r.reportServiceFailure(ctx, svc, k8s.ServiceEventReasonFailedAssumeRole, err)
+		return err
+	}
+	stack, lb, backendSGRequired, err := r.buildModel(ctx, svc, scope)
 	if err != nil {
 		return err
 	}
 	if lb == nil {
-		return r.cleanupLoadBalancerResources(ctx, svc, stack)
+		return r.cleanupLoadBalancerResources(ctx, svc, stack, scope)
+	}
+	return r.reconcileLoadBalancerResources(ctx, svc, stack, lb, backendSGRequired, scope)
+}
+
+// resolveServiceScope resolves the serviceScope for the IAM role named by LoadBalancerClassParams of Service.
+func (r *serviceReconciler) resolveServiceScope(ctx context.Context, svc *corev1.Service) (*serviceScope, error) {
+	lbClassParams, err := r.lbClassParamsLoader.Load(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	var key assumeRoleKey
+	if lbClassParams != nil {
+		key = assumeRoleKey{
+			roleARN:    lbClassParams.Spec.IAMRoleARNToAssume,
+			externalID: lbClassParams.Spec.AssumeRoleExternalID,
+		}
 	}
-	return r.reconcileLoadBalancerResources(ctx, svc, stack, lb, backendSGRequired)
+	r.serviceScopesMutex.Lock()
+	defer r.serviceScopesMutex.Unlock()
+	if scope, ok := r.serviceScopes[key]; ok {
+		return scope, nil
+	}
+	cloudScope, err := r.cloudScopeProvider.ScopeForRole(key.roleARN, key.externalID)
+	if err != nil {
+		return nil, err
+	}
+	scope := r.buildServiceScope(cloudScope)
+	r.serviceScopes[key] = scope
+	return scope, nil
 }
 
-func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
-	stack, lb, backendSGRequired, err := r.modelBuilder.Build(ctx, svc)
+// buildServiceScope builds the serviceScope that builds and deploys Services with the AWS clients of cloudScope.
+func (r *serviceReconciler) buildServiceScope(cloudScope deploy.CloudScope) *serviceScope {
+	logger := r.logger
+	if len(cloudScope.RoleARN) != 0 {
+		logger = logger.WithValues("iamRole", cloudScope.RoleARN)
+	}
+	modelBuilder := newModelBuilder(cloudScope.Cloud, r.k8sClient, r.annotationParser, cloudScope.SubnetsResolver, cloudScope.VPCInfoProvider,
+		cloudScope.ELBV2TaggingManager, r.controllerConfig, r.serviceUtils, cloudScope.BackendSGProvider, cloudScope.SGResolver, logger)
+	stackDeployer := deploy.NewDefaultStackDeployer(cloudScope.Cloud, r.k8sClient, cloudScope.SGManager, cloudScope.SGReconciler,
+		cloudScope.ELBV2TaggingManager, r.controllerConfig, ServiceTagPrefix, logger)
+	return &serviceScope{
+		modelBuilder:      modelBuilder,
+		stackDeployer:     stackDeployer,
+		backendSGProvider: cloudScope.BackendSGProvider,
+		crossAccount:      len(cloudScope.RoleARN) != 0,
+	}
+}
+
+func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service, scope *serviceScope) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack, lb, backendSGRequired, err := scope.modelBuilder.Build(ctx, svc)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.reportServiceFailure(ctx, svc, k8s.ServiceEventReasonFailedBuildModel, err)
 		return nil, nil, false, err
 	}
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.reportServiceFailure(ctx, svc, k8s.ServiceEventReasonFailedBuildModel, err)
 		return nil, nil, false, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 	return stack, lb, backendSGRequired, nil
 }
 
-func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service, stack core.Stack, scope *serviceScope) error {
+	if r.driftMonitor != nil {
+		r.driftMonitor.Untrack(controllerName, stack.StackID())
+	}
+	if err := scope.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		r.reportServiceFailure(ctx, svc, k8s.ServiceEventReasonFailedDeployModel, err)
 		return err
 	}
 	r.logger.Info("successfully deployed model", "service", k8s.NamespacedName(svc))
@@ -134,27 +277,55 @@ func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service
 }
 
 func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack,
-	lb *elbv2model.LoadBalancer, backendSGRequired bool) error {
-	if err := r.finalizerManager.AddFinalizers(ctx, svc, serviceFinalizer); err != nil {
+	lb *elbv2model.LoadBalancer, backendSGRequired bool, scope *serviceScope) error {
+	if err := policy.CheckLoadBalancerPolicies(ctx, r.policyEvaluator, []string{svc.Namespace}, stack); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonPolicyViolation, fmt.Sprintf("Failed check LoadBalancerPolicy due to %v", err))
+		r.reportServiceFailure(ctx, svc, k8s.ServiceEventReasonPolicyViolation, err)
+		return err
+	}
+	if err := r.finalizerManager.AddFinalizers(ctx, svc, ServiceFinalizer); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	err := r.deployModel(ctx, svc, stack)
+	err := r.deployModel(ctx, svc, stack, scope)
 	if err != nil {
 		return err
 	}
+	if r.lbStateReporter != nil {
+		if err := r.lbStateReporter.ReportReconciled(ctx, buildLoadBalancerSource(svc), buildLoadBalancerStateMembers(svc), stack); err != nil {
+			return err
+		}
+	}
+	if r.driftMonitor != nil && !scope.crossAccount {
+		r.driftMonitor.Track(controllerName, stack, drift.Target{
+			Objects:       []client.Object{svc},
+			EventRecorder: r.eventRecorder,
+			EventReason:   k8s.ServiceEventReasonDriftDetected,
+			EventChan:     r.driftEventChan,
+		})
+	}
 	lbDNS, err := lb.DNSName().Resolve(ctx)
 	if err != nil {
 		return err
 	}
+	if err := r.recordElasticIPAddresses(ctx, svc, stack); err != nil {
+		return err
+	}
+	if err := r.recordPrivateIPv4Addresses(ctx, svc, lb); err != nil {
+		return err
+	}
+	vpcesStatus, err := r.resolveVPCEndpointServiceStatus(stack)
+	if err != nil {
+		return err
+	}
 
 	if !backendSGRequired {
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+		if err := scope.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
 			return err
 		}
 	}
 
-	if err = r.updateServiceStatus(ctx, lbDNS, svc); err != nil {
+	if err = r.updateServiceStatus(ctx, lbDNS, vpcesStatus, svc); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
@@ -162,20 +333,25 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	return nil
 }
 
-func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
-	if k8s.HasFinalizer(svc, serviceFinalizer) {
-		err := r.deployModel(ctx, svc, stack)
+func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack, scope *serviceScope) error {
+	if k8s.HasFinalizer(svc, ServiceFinalizer) {
+		err := r.deployModel(ctx, svc, stack, scope)
 		if err != nil {
 			return err
 		}
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+		if err := scope.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
 			return err
 		}
+		if r.lbStateReporter != nil {
+			if err := r.lbStateReporter.Delete(ctx, buildLoadBalancerSource(svc)); err != nil {
+				return err
+			}
+		}
 		if err = r.cleanupServiceStatus(ctx, svc); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
-		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer); err != nil {
+		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, ServiceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
 		}
@@ -183,16 +359,140 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 	return nil
 }
 
-func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
-	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
-		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
-		svc.Status.LoadBalancer.Ingress[0].Hostname != lbDNS {
-		svcOld := svc.DeepCopy()
-		svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
-			{
-				Hostname: lbDNS,
-			},
+// reportServiceFailure reports the failed reconcile of Service.
+// failures to report are only logged, so that the reconcile error is returned.
+func (r *serviceReconciler) reportServiceFailure(ctx context.Context, svc *corev1.Service, reason string, reconcileErr error) {
+	if r.lbStateReporter == nil {
+		return
+	}
+	if err := r.lbStateReporter.ReportFailed(ctx, buildLoadBalancerSource(svc), buildLoadBalancerStateMembers(svc), reason, reconcileErr); err != nil {
+		r.logger.Error(err, "failed to report loadBalancerState", "service", k8s.NamespacedName(svc))
+	}
+}
+
+func buildLoadBalancerSource(svc *corev1.Service) elbv2api.LoadBalancerSource {
+	return elbv2api.LoadBalancerSource{
+		Kind:      elbv2api.LoadBalancerSourceKindService,
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+	}
+}
+
+func buildLoadBalancerStateMembers(svc *corev1.Service) []elbv2api.LoadBalancerStateMember {
+	return []elbv2api.LoadBalancerStateMember{
+		{
+			Namespace:          svc.Namespace,
+			Name:               svc.Name,
+			ObservedGeneration: svc.Generation,
+		},
+	}
+}
+
+// recordPrivateIPv4Addresses records the private IPv4 addresses automatically picked for load balancer on Service,
+// so that the same addresses are used when the load balancer is recreated.
+func (r *serviceReconciler) recordPrivateIPv4Addresses(ctx context.Context, svc *corev1.Service, lb *elbv2model.LoadBalancer) error {
+	autoPrivateIPv4Addresses := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixAutoPrivateIpv4Addresses, &autoPrivateIPv4Addresses, svc.Annotations); err != nil {
+		return err
+	}
+	if !autoPrivateIPv4Addresses {
+		return nil
+	}
+	var entries []string
+	for _, mapping := range lb.Spec.SubnetMappings {
+		if mapping.PrivateIPv4Address == nil {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("%v=%v", mapping.SubnetID, awssdk.StringValue(mapping.PrivateIPv4Address)))
+	}
+	sort.Strings(entries)
+	recordedIPv4Addresses := strings.Join(entries, ",")
+
+	annotationKey := fmt.Sprintf("%v/%v", serviceAnnotationPrefix, annotations.SvcLBSuffixRecordedPrivateIpv4Addresses)
+	if svc.Annotations[annotationKey] == recordedIPv4Addresses {
+		return nil
+	}
+	svcOld := svc.DeepCopy()
+	if svc.Annotations == nil {
+		svc.Annotations = make(map[string]string)
+	}
+	svc.Annotations[annotationKey] = recordedIPv4Addresses
+	if err := r.k8sClient.Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to record private IPv4 addresses: %v", k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
+// recordElasticIPAddresses records the public IPv4 addresses of controller-managed ElasticIPs within stack on Service.
+// they're not published in Service status, since kube-proxy would route in-cluster traffic to these IPs directly to endpoints,
+// bypassing the TLS termination, proxy protocol and client IP preservation of load balancer.
+func (r *serviceReconciler) recordElasticIPAddresses(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	var resEIPs []*ec2model.ElasticIP
+	if err := stack.ListResources(&resEIPs); err != nil {
+		return err
+	}
+	eipAddresses := make([]string, 0, len(resEIPs))
+	for _, resEIP := range resEIPs {
+		eipAddress, err := resEIP.PublicIP().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		eipAddresses = append(eipAddresses, eipAddress)
+	}
+	sort.Strings(eipAddresses)
+	recordedEIPAddresses := strings.Join(eipAddresses, ",")
+
+	annotationKey := fmt.Sprintf("%v/%v", serviceAnnotationPrefix, annotations.SvcLBSuffixManagedEIPAddresses)
+	existingEIPAddresses, exists := svc.Annotations[annotationKey]
+	svcOld := svc.DeepCopy()
+	if len(eipAddresses) == 0 {
+		if !exists {
+			return nil
+		}
+		delete(svc.Annotations, annotationKey)
+	} else {
+		if exists && existingEIPAddresses == recordedEIPAddresses {
+			return nil
+		}
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
 		}
+		svc.Annotations[annotationKey] = recordedEIPAddresses
+	}
+	if err := r.k8sClient.Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to record elastic IP addresses: %v", k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
+// resolveVPCEndpointServiceStatus returns the status of VPC endpoint service within stack, or nil if there is none.
+func (r *serviceReconciler) resolveVPCEndpointServiceStatus(stack core.Stack) (*ec2model.VPCEndpointServiceStatus, error) {
+	var resVPCESs []*ec2model.VPCEndpointService
+	if err := stack.ListResources(&resVPCESs); err != nil {
+		return nil, err
+	}
+	if len(resVPCESs) == 0 {
+		return nil, nil
+	}
+	if resVPCESs[0].Status == nil {
+		return nil, errors.Errorf("VPCEndpointService is not fulfilled yet: %v", resVPCESs[0].ID())
+	}
+	return resVPCESs[0].Status, nil
+}
+
+func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, vpcesStatus *ec2model.VPCEndpointServiceStatus,
+	svc *corev1.Service) error {
+	desiredLBIngress := []corev1.LoadBalancerIngress{
+		{
+			Hostname: lbDNS,
+		},
+	}
+	desiredConditions := buildVPCEndpointServiceConditions(svc.Status.Conditions, vpcesStatus)
+	if !equality.Semantic.DeepEqual(svc.Status.LoadBalancer.Ingress, desiredLBIngress) ||
+		!equality.Semantic.DeepEqual(svc.Status.Conditions, desiredConditions) {
+		svcOld := svc.DeepCopy()
+		svc.Status.LoadBalancer.Ingress = desiredLBIngress
+		svc.Status.Conditions = desiredConditions
 		if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
 			return errors.Wrapf(err, "failed to update service status: %v", k8s.NamespacedName(svc))
 		}
@@ -203,12 +503,38 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
 	svcOld := svc.DeepCopy()
 	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
+	svc.Status.Conditions = buildVPCEndpointServiceConditions(svc.Status.Conditions, nil)
 	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
 		return errors.Wrapf(err, "failed to cleanup service status: %v", k8s.NamespacedName(svc))
 	}
 	return nil
 }
 
+// buildVPCEndpointServiceConditions computes the Service conditions with VPCEndpointService condition reflecting vpcesStatus.
+// the VPCEndpointService condition is removed when vpcesStatus is nil.
+func buildVPCEndpointServiceConditions(conditions []metav1.Condition, vpcesStatus *ec2model.VPCEndpointServiceStatus) []metav1.Condition {
+	desiredConditions := make([]metav1.Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		desiredConditions = append(desiredConditions, *condition.DeepCopy())
+	}
+	if vpcesStatus == nil {
+		meta.RemoveStatusCondition(&desiredConditions, serviceConditionTypeVPCEndpointService)
+		return desiredConditions
+	}
+	message := fmt.Sprintf("serviceName=%v", vpcesStatus.ServiceName)
+	if verification := vpcesStatus.PrivateDNSNameVerification; verification != nil {
+		message = fmt.Sprintf("%v, privateDNSNameVerification=%v %v %v (%v)", message,
+			verification.Type, verification.Name, verification.Value, verification.State)
+	}
+	meta.SetStatusCondition(&desiredConditions, metav1.Condition{
+		Type:    serviceConditionTypeVPCEndpointService,
+		Status:  metav1.ConditionTrue,
+		Reason:  serviceConditionReasonVPCEndpointServiceAvailable,
+		Message: message,
+	})
+	return desiredConditions
+}
+
 func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	c, err := controller.New(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
@@ -229,5 +555,45 @@ func (r *serviceReconciler) setupWatches(_ context.Context, c controller.Control
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
+	if r.driftMonitor != nil || r.shardCoordinator != nil {
+		svcEventChan := make(chan event.GenericEvent)
+		if err := c.Watch(&source.Channel{Source: svcEventChan}, svcEventHandler); err != nil {
+			return err
+		}
+		r.driftEventChan = svcEventChan
+		if r.shardCoordinator != nil {
+			r.shardCoordinator.AddShardsAcquiredHandler(func(ctx context.Context) {
+				r.resyncServices(ctx, svcEventChan)
+			})
+			if r.driftMonitor != nil {
+				r.shardCoordinator.AddShardsLostHandler(func(_ context.Context) {
+					r.driftMonitor.UntrackIf(controllerName, func(stackID core.StackID) bool {
+						return !r.shardCoordinator.Owns(stackID.String())
+					})
+				})
+			}
+		}
+	}
+	lbClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerClassParamsEvent(r.k8sClient,
+		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("loadBalancerClassParams"))
+	if err := c.Watch(&source.Kind{Type: &elbv2api.LoadBalancerClassParams{}}, lbClassParamsEventHandler); err != nil {
+		return err
+	}
 	return nil
 }
+
+// resyncServices triggers reconcile of all Services, so that the Services of shards acquired by this replica are reconciled.
+func (r *serviceReconciler) resyncServices(ctx context.Context, svcEventChan chan<- event.GenericEvent) {
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList); err != nil {
+		r.logger.Error(err, "failed to resync services of acquired shards")
+		return
+	}
+	for i := range svcList.Items {
+		select {
+		case svcEventChan <- event.GenericEvent{Object: &svcList.Items[i]}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -34,10 +34,15 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	ec2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gc"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
@@ -81,6 +86,7 @@ func main() {
 		setupLog.Error(err, "unable to initialize AWS cloud")
 		os.Exit(1)
 	}
+	cloudProvider := aws.NewDefaultCloudProvider(cloud)
 	restCFG, err := config.BuildRestConfig(controllerCFG.RuntimeConfig)
 	if err != nil {
 		setupLog.Error(err, "unable to build REST config")
@@ -106,43 +112,105 @@ func main() {
 	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
 	vpcInfoProvider := networking.NewDefaultVPCInfoProvider(cloud.EC2(), ctrl.Log.WithName("vpc-info-provider"))
 	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
-	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(),
-		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider,
+	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerCFG.FeatureGates, cloud.RGT(), ctrl.Log)
+	assumeRoleGrantChecker := targetgroupbinding.NewDefaultAssumeRoleGrantChecker(mgr.GetClient())
+	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(), cloudProvider, assumeRoleGrantChecker,
+		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider, ingress.NewIngressALBFinder(elbv2TaggingManager, controllerCFG.ClusterName),
 		cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.FeatureGates.Enabled(config.EndpointsFailOpen), controllerCFG.EnableEndpointSlices, controllerCFG.DisableRestrictedSGRules,
 		controllerCFG.ServiceTargetENISGTags, mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
 	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
 		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
 	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
-	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerCFG.FeatureGates, cloud.RGT(), ctrl.Log)
-	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
-		finalizerManager, sgManager, sgReconciler, subnetResolver, elbv2TaggingManager,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("ingress"))
-	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
-		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("service"))
+	cloudScopeProvider := deploy.NewDefaultCloudScopeProvider(deploy.CloudScope{
+		Cloud:               cloud,
+		SGManager:           sgManager,
+		SGReconciler:        sgReconciler,
+		SubnetsResolver:     subnetResolver,
+		VPCInfoProvider:     vpcInfoProvider,
+		ELBV2TaggingManager: elbv2TaggingManager,
+		BackendSGProvider:   backendSGProvider,
+		SGResolver:          sgResolver,
+	}, cloudProvider, mgr.GetClient(), controllerCFG, ctrl.Log.WithName("cloud-scope-provider"))
+	// controllerMgr runs controllers on the leader, or on all replicas if the reconciliation is sharded.
+	var controllerMgr ctrl.Manager = mgr
+	var shardCoordinator shard.Coordinator
+	if controllerCFG.ShardingConfig.ShardCount > 0 {
+		identity, err := os.Hostname()
+		if err != nil {
+			setupLog.Error(err, "unable to determine shard identity")
+			os.Exit(1)
+		}
+		defaultShardCoordinator := shard.NewDefaultCoordinator(mgr.GetClient(), mgr.GetAPIReader(), controllerCFG.ShardingConfig,
+			controllerCFG.RuntimeConfig.LeaderElectionID, identity, ctrl.Log.WithName("shard-coordinator"))
+		if err := mgr.Add(defaultShardCoordinator); err != nil {
+			setupLog.Error(err, "unable to add shard coordinator")
+			os.Exit(1)
+		}
+		controllerMgr = shard.WithoutLeaderElection(mgr)
+		shardCoordinator = defaultShardCoordinator
+	}
+	var driftMonitor drift.Monitor
+	if controllerCFG.DriftDetectionConfig.Interval > 0 {
+		stackDriftDetector := deploy.NewDefaultStackDriftDetector(cloud, sgManager, controllerCFG)
+		defaultDriftMonitor, err := drift.NewDefaultMonitor(stackDriftDetector, controllerCFG.DriftDetectionConfig, metrics.Registry,
+			ctrl.Log.WithName("drift-monitor"))
+		if err != nil {
+			setupLog.Error(err, "unable to create drift monitor")
+			os.Exit(1)
+		}
+		if err := controllerMgr.Add(defaultDriftMonitor); err != nil {
+			setupLog.Error(err, "unable to add drift monitor")
+			os.Exit(1)
+		}
+		driftMonitor = defaultDriftMonitor
+	}
+	ingGroupReconciler := ingress.NewGroupReconciler(cloudScopeProvider, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+		finalizerManager, controllerCFG, driftMonitor, shardCoordinator, ctrl.Log.WithName("controllers").WithName("ingress"))
+	svcReconciler := service.NewServiceReconciler(cloudScopeProvider, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
+		finalizerManager, controllerCFG, driftMonitor, shardCoordinator, ctrl.Log.WithName("controllers").WithName("service"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
 		finalizerManager, tgbResManager,
-		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+		controllerCFG, shardCoordinator, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
 
 	ctx := ctrl.SetupSignalHandler()
-	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
+	if err = ingGroupReconciler.SetupWithManager(ctx, controllerMgr, clientSet); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Ingress")
 		os.Exit(1)
 	}
 
 	// Setup service reconciler only if AllowServiceType is set to true.
 	if controllerCFG.FeatureGates.Enabled(config.EnableServiceController) {
-		if err = svcReconciler.SetupWithManager(ctx, mgr); err != nil {
+		if err = svcReconciler.SetupWithManager(ctx, controllerMgr); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "Service")
 			os.Exit(1)
 		}
 	}
 
-	if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
+	if err := tgbReconciler.SetupWithManager(ctx, controllerMgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
 		os.Exit(1)
 	}
 
+	// Setup orphaned resource garbage collector only if the interval is set.
+	if controllerCFG.OrphanedResourceGCConfig.Interval > 0 {
+		gcLogger := ctrl.Log.WithName("orphaned-resource-gc")
+		ec2TaggingManager := ec2deploy.NewDefaultTaggingManager(cloud.EC2(), sgManager, cloud.VpcID(), gcLogger)
+		ingStackDeployer := deploy.NewDefaultStackDeployer(cloud, mgr.GetClient(), sgManager, sgReconciler, elbv2TaggingManager,
+			controllerCFG, ingress.IngressTagPrefix, gcLogger)
+		svcStackDeployer := deploy.NewDefaultStackDeployer(cloud, mgr.GetClient(), sgManager, sgReconciler, elbv2TaggingManager,
+			controllerCFG, service.ServiceTagPrefix, gcLogger)
+		orphanedResourceCollector, err := gc.NewDefaultOrphanedResourceCollector(mgr.GetAPIReader(), elbv2TaggingManager, ec2TaggingManager,
+			ingStackDeployer, svcStackDeployer, controllerCFG.OrphanedResourceGCConfig, controllerCFG.ClusterName, metrics.Registry, gcLogger)
+		if err != nil {
+			setupLog.Error(err, "unable to create orphaned resource garbage collector")
+			os.Exit(1)
+		}
+		if err := mgr.Add(orphanedResourceCollector); err != nil {
+			setupLog.Error(err, "unable to add orphaned resource garbage collector")
+			os.Exit(1)
+		}
+	}
+
 	// Add liveness probe
 	err = mgr.AddHealthzCheck("health-ping", healthz.Ping)
 	setupLog.Info("adding health check for controller")
@@ -163,10 +231,16 @@ func main() {
 		mgr.GetClient(), ctrl.Log.WithName("pod-readiness-gate-injector"))
 	corewebhook.NewPodMutator(podReadinessGateInjector).SetupWithManager(mgr)
 	corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass, ctrl.Log).SetupWithManager(mgr)
+	svcAdmissionModelBuilder := service.NewAdmissionModelBuilder(cloud, mgr.GetClient(), subnetResolver, vpcInfoProvider, elbv2TaggingManager,
+		controllerCFG, sgResolver, ctrl.Log.WithName("webhooks").WithName("service"))
+	corewebhook.NewServiceValidator(mgr.GetClient(), svcAdmissionModelBuilder, ctrl.Log).SetupWithManager(mgr)
 	elbv2webhook.NewIngressClassParamsValidator().SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), cloud.VpcID(), ctrl.Log).SetupWithManager(mgr)
-	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewNamespacedIngressClassParamsValidator(mgr.GetClient(), ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), cloudProvider, assumeRoleGrantChecker, ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), cloudProvider, assumeRoleGrantChecker, cloud.VpcID(), ctrl.Log).SetupWithManager(mgr)
+	ingAdmissionModelBuilder := ingress.NewAdmissionModelBuilder(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"), subnetResolver,
+		elbv2TaggingManager, controllerCFG, sgResolver, ctrl.Log.WithName("webhooks").WithName("ingress"))
+	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ingAdmissionModelBuilder, ctrl.Log).SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
 	go func() {
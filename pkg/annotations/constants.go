@@ -48,6 +48,10 @@ const (
 	IngressSuffixManageSecurityGroupRules     = "manage-backend-security-group-rules"
 	IngressSuffixMutualAuthentication         = "mutual-authentication"
 	IngressSuffixSecurityGroupPrefixLists     = "security-group-prefix-lists"
+	IngressSuffixLoadBalancerARN              = "load-balancer-arn"
+	IngressSuffixUnmanagedListeners           = "unmanaged-listeners"
+	IngressSuffixClaimedListenerPorts         = "claimed-listener-ports"
+	IngressSuffixDeletionPolicy               = "deletion-policy"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -79,7 +83,13 @@ const (
 	SvcLBSuffixTargetGroupAttributes                     = "aws-load-balancer-target-group-attributes"
 	SvcLBSuffixSubnets                                   = "aws-load-balancer-subnets"
 	SvcLBSuffixEIPAllocations                            = "aws-load-balancer-eip-allocations"
+	SvcLBSuffixManagedEIPs                               = "aws-load-balancer-managed-eips"
+	SvcLBSuffixEIPPublicIPv4Pool                         = "aws-load-balancer-eip-public-ipv4-pool"
+	SvcLBSuffixEIPRetain                                 = "aws-load-balancer-eip-retain"
+	SvcLBSuffixManagedEIPAddresses                       = "aws-load-balancer-managed-eip-addresses"
 	SvcLBSuffixPrivateIpv4Addresses                      = "aws-load-balancer-private-ipv4-addresses"
+	SvcLBSuffixAutoPrivateIpv4Addresses                  = "aws-load-balancer-auto-private-ipv4-addresses"
+	SvcLBSuffixRecordedPrivateIpv4Addresses              = "aws-load-balancer-recorded-private-ipv4-addresses"
 	SvcLBSuffixIpv6Addresses                             = "aws-load-balancer-ipv6-addresses"
 	SvcLBSuffixALPNPolicy                                = "aws-load-balancer-alpn-policy"
 	SvcLBSuffixTargetNodeLabels                          = "aws-load-balancer-target-node-labels"
@@ -87,5 +97,17 @@ const (
 	SvcLBSuffixLoadBalancerSecurityGroups                = "aws-load-balancer-security-groups"
 	SvcLBSuffixManageSGRules                             = "aws-load-balancer-manage-backend-security-group-rules"
 	SvcLBSuffixEnforceSGInboundRulesOnPrivateLinkTraffic = "aws-load-balancer-inbound-sg-rules-on-private-link-traffic"
+	SvcLBSuffixALBTargetIngressGroup                     = "aws-load-balancer-alb-target-ingress-group"
+	SvcLBSuffixALBTargetIngress                          = "aws-load-balancer-alb-target-ingress"
+	SvcLBSuffixVPCEndpointService                        = "aws-load-balancer-vpc-endpoint-service"
+	SvcLBSuffixVPCEndpointServiceAllowedPrincipals       = "aws-load-balancer-vpc-endpoint-service-allowed-principals"
+	SvcLBSuffixVPCEndpointServiceAcceptanceRequired      = "aws-load-balancer-vpc-endpoint-service-acceptance-required"
+	SvcLBSuffixVPCEndpointServicePrivateDNSName          = "aws-load-balancer-vpc-endpoint-service-private-dns-name"
+	SvcLBSuffixVPCEndpointServiceIPAddressTypes          = "aws-load-balancer-vpc-endpoint-service-ip-address-types"
+	SvcLBSuffixVPCEndpointServiceAllowRecreation        = "aws-load-balancer-vpc-endpoint-service-allow-recreation"
+	SvcLBSuffixLoadBalancerARN                           = "aws-load-balancer-arn"
+	SvcLBSuffixUnmanagedListeners                        = "aws-load-balancer-unmanaged-listeners"
+	SvcLBSuffixClaimedListenerPorts                      = "aws-load-balancer-claimed-listener-ports"
+	SvcLBSuffixDeletionPolicy                            = "aws-load-balancer-deletion-policy"
   SvcLBSuffixSecurityGroupPrefixLists                  = "aws-load-balancer-security-group-prefix-lists"
 )
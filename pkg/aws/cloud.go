@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -46,6 +48,10 @@ type Cloud interface {
 
 	// VpcID for the LoadBalancer resources.
 	VpcID() string
+
+	// AssumeRole returns the Cloud whose AWS clients use the credentials of assumed IAM role, with the same Region and VpcID.
+	// externalID is optional, and is passed to STS when assuming the role if specified.
+	AssumeRole(roleARN string, externalID string) (Cloud, error)
 }
 
 // NewCloud constructs new Cloud implementation.
@@ -98,38 +104,64 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	sess := session.Must(session.NewSessionWithOptions(opts))
 	injectUserAgent(&sess.Handlers)
 
-	if cfg.ThrottleConfig != nil {
-		throttler := throttle.NewThrottler(cfg.ThrottleConfig)
-		throttler.InjectHandlers(&sess.Handlers)
-	}
+	var metricsCollector metrics.Collector
 	if metricsRegisterer != nil {
-		metricsCollector, err := metrics.NewCollector(metricsRegisterer)
+		defaultMetricsCollector, err := metrics.NewCollector(metricsRegisterer)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to initialize sdk metrics collector")
 		}
-		metricsCollector.InjectHandlers(&sess.Handlers)
+		metricsCollector = defaultMetricsCollector
 	}
 
-	ec2Service := services.NewEC2(sess)
-
+	cloud := newDefaultCloud(cfg, sess, metricsCollector, "")
 	if len(cfg.VpcID) == 0 {
-		vpcID, err := inferVPCID(metadata, ec2Service)
+		vpcID, err := inferVPCID(metadata, cloud.ec2)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to introspect vpcID from EC2Metadata or Node name, specify --aws-vpc-id instead if EC2Metadata is unavailable")
 		}
-		cfg.VpcID = vpcID
+		cloud.cfg.VpcID = vpcID
+	}
+	return cloud, nil
+}
+
+// newDefaultCloud constructs the Cloud whose AWS clients make API calls with sess.
+// API calls are throttled per Cloud, and their metrics are labelled with account.
+func newDefaultCloud(cfg CloudConfig, sess *session.Session, metricsCollector metrics.Collector, account string) *defaultCloud {
+	clientSess := sess.Copy()
+	var accountMetricsCollector metrics.Collector
+	if metricsCollector != nil {
+		accountMetricsCollector = metricsCollector.ForAccount(account)
+	}
+	if cfg.ThrottleConfig != nil {
+		// the adaptive throttler caps each operation at its configured rate, so it replaces the static throttler.
+		if cfg.AdaptiveThrottle {
+			var rateObserver throttle.RateObserver
+			if accountMetricsCollector != nil {
+				rateObserver = accountMetricsCollector
+			}
+			adaptiveThrottler := throttle.NewAdaptiveThrottler(cfg.ThrottleConfig, rateObserver)
+			adaptiveThrottler.InjectHandlers(&clientSess.Handlers)
+		} else {
+			throttler := throttle.NewThrottler(cfg.ThrottleConfig)
+			throttler.InjectHandlers(&clientSess.Handlers)
+		}
+	}
+	if accountMetricsCollector != nil {
+		accountMetricsCollector.InjectHandlers(&clientSess.Handlers)
 	}
 
 	return &defaultCloud{
-		cfg:         cfg,
-		ec2:         ec2Service,
-		elbv2:       services.NewELBV2(sess),
-		acm:         services.NewACM(sess),
-		wafv2:       services.NewWAFv2(sess),
-		wafRegional: services.NewWAFRegional(sess, cfg.Region),
-		shield:      services.NewShield(sess),
-		rgt:         services.NewRGT(sess),
-	}, nil
+		cfg:              cfg,
+		sess:             sess,
+		metricsCollector: metricsCollector,
+		ec2:              services.NewEC2(clientSess),
+		elbv2:            services.NewELBV2(clientSess),
+		acm:              services.NewACM(clientSess),
+		wafv2:            services.NewWAFv2(clientSess),
+		wafRegional:      services.NewWAFRegional(clientSess, cfg.Region),
+		shield:           services.NewShield(clientSess),
+		rgt:              services.NewRGT(clientSess),
+	}
 }
 
 func inferVPCID(metadata services.EC2Metadata, ec2Service services.EC2) (string, error) {
@@ -172,6 +204,9 @@ var _ Cloud = &defaultCloud{}
 
 type defaultCloud struct {
 	cfg CloudConfig
+	// sess is the session with controller's own credentials, without throttling or metrics.
+	sess             *session.Session
+	metricsCollector metrics.Collector
 
 	ec2   services.EC2
 	elbv2 services.ELBV2
@@ -218,3 +253,17 @@ func (c *defaultCloud) Region() string {
 func (c *defaultCloud) VpcID() string {
 	return c.cfg.VpcID
 }
+
+func (c *defaultCloud) AssumeRole(roleARN string, externalID string) (Cloud, error) {
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid IAM role ARN: %v", roleARN)
+	}
+	creds := stscreds.NewCredentials(c.sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		if len(externalID) != 0 {
+			p.ExternalID = aws.String(externalID)
+		}
+	})
+	roleSess := c.sess.Copy(aws.NewConfig().WithCredentials(creds))
+	return newDefaultCloud(c.cfg, roleSess, c.metricsCollector, parsedARN.AccountID), nil
+}
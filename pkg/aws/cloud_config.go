@@ -8,15 +8,16 @@ import (
 )
 
 const (
-	flagAWSRegion        = "aws-region"
-	flagAWSAPIEndpoints  = "aws-api-endpoints"
-	flagAWSAPIThrottle   = "aws-api-throttle"
-	flagAWSVpcID         = "aws-vpc-id"
-	flagAWSVpcCacheTTL   = "aws-vpc-cache-ttl"
-	flagAWSMaxRetries    = "aws-max-retries"
-	defaultVpcID         = ""
-	defaultRegion        = ""
-	defaultAPIMaxRetries = 10
+	flagAWSRegion              = "aws-region"
+	flagAWSAPIEndpoints        = "aws-api-endpoints"
+	flagAWSAPIThrottle         = "aws-api-throttle"
+	flagAWSAPIAdaptiveThrottle = "aws-api-adaptive-throttle"
+	flagAWSVpcID               = "aws-vpc-id"
+	flagAWSVpcCacheTTL         = "aws-vpc-cache-ttl"
+	flagAWSMaxRetries          = "aws-max-retries"
+	defaultVpcID               = ""
+	defaultRegion              = ""
+	defaultAPIMaxRetries       = 10
 )
 
 type CloudConfig struct {
@@ -26,6 +27,9 @@ type CloudConfig struct {
 	// Throttle settings for AWS APIs
 	ThrottleConfig *throttle.ServiceOperationsThrottleConfig
 
+	// Whether to lower the rates of AWS APIs when they are throttled by AWS, with throttle settings as ceiling
+	AdaptiveThrottle bool
+
 	// VpcID for the LoadBalancer resources.
 	VpcID string
 
@@ -42,6 +46,7 @@ type CloudConfig struct {
 func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&cfg.Region, flagAWSRegion, defaultRegion, "AWS Region for the kubernetes cluster")
 	fs.Var(cfg.ThrottleConfig, flagAWSAPIThrottle, "throttle settings for AWS APIs, format: serviceID1:operationRegex1=rate:burst,serviceID2:operationRegex2=rate:burst")
+	fs.BoolVar(&cfg.AdaptiveThrottle, flagAWSAPIAdaptiveThrottle, false, "Lower the rates of AWS APIs when they are throttled by AWS and slowly recover them, up to the rates of throttle settings")
 	fs.StringVar(&cfg.VpcID, flagAWSVpcID, defaultVpcID, "AWS VpcID for the LoadBalancer resources")
 	fs.IntVar(&cfg.MaxRetries, flagAWSMaxRetries, defaultAPIMaxRetries, "Maximum retries for AWS APIs")
 	fs.StringToStringVar(&cfg.AWSEndpoints, flagAWSAPIEndpoints, nil, "Custom AWS endpoint configuration, format: serviceID1=URL1,serviceID2=URL2")
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"sync"
+)
+
+// CloudProvider provides the Cloud to make AWS API calls with.
+type CloudProvider interface {
+	// CloudForRole returns the Cloud whose AWS clients use the credentials of assumed IAM role.
+	// The controller's own Cloud is returned if roleARN is empty.
+	CloudForRole(roleARN string, externalID string) (Cloud, error)
+}
+
+// NewDefaultCloudProvider constructs new defaultCloudProvider.
+func NewDefaultCloudProvider(cloud Cloud) *defaultCloudProvider {
+	return &defaultCloudProvider{
+		cloud:      cloud,
+		roleClouds: make(map[roleCloudKey]Cloud),
+	}
+}
+
+var _ CloudProvider = &defaultCloudProvider{}
+
+// roleCloudKey identifies the Cloud of an assumed IAM role.
+type roleCloudKey struct {
+	roleARN    string
+	externalID string
+}
+
+// defaultCloudProvider is the default implementation for CloudProvider.
+// Clouds of assumed IAM roles are constructed on first use and cached, so that API calls with the same credentials share
+// the AWS clients and their throttling.
+type defaultCloudProvider struct {
+	cloud Cloud
+
+	roleClouds      map[roleCloudKey]Cloud
+	roleCloudsMutex sync.Mutex
+}
+
+func (p *defaultCloudProvider) CloudForRole(roleARN string, externalID string) (Cloud, error) {
+	if len(roleARN) == 0 {
+		return p.cloud, nil
+	}
+	key := roleCloudKey{roleARN: roleARN, externalID: externalID}
+	p.roleCloudsMutex.Lock()
+	defer p.roleCloudsMutex.Unlock()
+	if cloud, ok := p.roleClouds[key]; ok {
+		return cloud, nil
+	}
+	cloud, err := p.cloud.AssumeRole(roleARN, externalID)
+	if err != nil {
+		return nil, err
+	}
+	p.roleClouds[key] = cloud
+	return cloud, nil
+}
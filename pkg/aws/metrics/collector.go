@@ -13,8 +13,24 @@ const (
 	sdkHandlerCollectAPIRequestMetric = "collectAPIRequestMetric"
 )
 
+// Collector collects metrics of AWS SDK API calls.
+type Collector interface {
+	// InjectHandlers injects the handlers that collect metrics into SDK handlers.
+	InjectHandlers(handlers *request.Handlers)
+
+	// ForAccount returns a Collector that labels the metrics with specified AWS account.
+	ForAccount(account string) Collector
+
+	// ObserveThrottleRate observes the current rate of requests per second allowed for service's operation.
+	ObserveThrottleRate(service string, operation string, rate float64)
+}
+
+var _ Collector = &collector{}
+
 type collector struct {
 	instruments *instruments
+	// account is the AWS account that API calls are made in, it's empty for the controller's own account.
+	account string
 }
 
 func NewCollector(registerer prometheus.Registerer) (*collector, error) {
@@ -27,6 +43,13 @@ func NewCollector(registerer prometheus.Registerer) (*collector, error) {
 	}, nil
 }
 
+func (c *collector) ForAccount(account string) Collector {
+	return &collector{
+		instruments: c.instruments,
+		account:     account,
+	}
+}
+
 func (c *collector) InjectHandlers(handlers *request.Handlers) {
 	handlers.CompleteAttempt.PushFrontNamed(request.NamedHandler{
 		Name: sdkHandlerCollectAPIRequestMetric,
@@ -38,6 +61,14 @@ func (c *collector) InjectHandlers(handlers *request.Handlers) {
 	})
 }
 
+func (c *collector) ObserveThrottleRate(service string, operation string, rate float64) {
+	c.instruments.apiThrottleRate.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+		labelAccount:   c.account,
+	}).Set(rate)
+}
+
 func (c *collector) collectAPIRequestMetric(r *request.Request) {
 	service := r.ClientInfo.ServiceID
 	operation := r.Operation.Name
@@ -50,10 +81,12 @@ func (c *collector) collectAPIRequestMetric(r *request.Request) {
 		labelOperation:  operation,
 		labelStatusCode: statusCode,
 		labelErrorCode:  errorCode,
+		labelAccount:    c.account,
 	}).Inc()
 	c.instruments.apiRequestDurationSecond.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelAccount:   c.account,
 	}).Observe(duration.Seconds())
 }
 
@@ -69,14 +102,17 @@ func (c *collector) collectAPICallMetric(r *request.Request) {
 		labelOperation:  operation,
 		labelStatusCode: statusCode,
 		labelErrorCode:  errorCode,
+		labelAccount:    c.account,
 	}).Inc()
 	c.instruments.apiCallDurationSeconds.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelAccount:   c.account,
 	}).Observe(duration.Seconds())
 	c.instruments.apiCallRetries.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelAccount:   c.account,
 	}).Observe(float64(r.RetryCount))
 }
 
@@ -3,12 +3,75 @@ package metrics
 import (
 	"errors"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"testing"
 )
 
+func Test_collector_ForAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		accounts []string
+		want     map[string]float64
+	}{
+		{
+			name:     "controller's own account",
+			accounts: []string{""},
+			want:     map[string]float64{"": 1},
+		},
+		{
+			name:     "API calls are labelled per account",
+			accounts: []string{"", "123456789012", "123456789012"},
+			want:     map[string]float64{"": 1, "123456789012": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCollector(prometheus.NewRegistry())
+			assert.NoError(t, err)
+			for _, account := range tt.accounts {
+				r := &request.Request{
+					ClientInfo:   metadata.ClientInfo{ServiceID: "Elastic Load Balancing v2"},
+					Operation:    &request.Operation{Name: "DescribeLoadBalancers"},
+					HTTPResponse: &http.Response{StatusCode: 200},
+				}
+				c.ForAccount(account).(*collector).collectAPICallMetric(r)
+			}
+			for account, want := range tt.want {
+				got := testutil.ToFloat64(c.instruments.apiCallsTotal.With(prometheus.Labels{
+					labelService:    "Elastic Load Balancing v2",
+					labelOperation:  "DescribeLoadBalancers",
+					labelStatusCode: "200",
+					labelErrorCode:  "",
+					labelAccount:    account,
+				}))
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func Test_collector_ObserveThrottleRate(t *testing.T) {
+	c, err := NewCollector(prometheus.NewRegistry())
+	assert.NoError(t, err)
+	c.ObserveThrottleRate("Elastic Load Balancing v2", "RegisterTargets", 4)
+	c.ObserveThrottleRate("Elastic Load Balancing v2", "RegisterTargets", 2)
+	c.ForAccount("123456789012").ObserveThrottleRate("Elastic Load Balancing v2", "RegisterTargets", 1)
+
+	for account, want := range map[string]float64{"": 2, "123456789012": 1} {
+		got := testutil.ToFloat64(c.instruments.apiThrottleRate.With(prometheus.Labels{
+			labelService:   "Elastic Load Balancing v2",
+			labelOperation: "RegisterTargets",
+			labelAccount:   account,
+		}))
+		assert.Equal(t, want, got)
+	}
+}
+
 func Test_statusCodeForRequest(t *testing.T) {
 	type args struct {
 		r *request.Request
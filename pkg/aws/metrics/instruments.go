@@ -13,6 +13,8 @@ const (
 
 	metricAPIRequestsTotal          = "api_requests_total"
 	metricAPIRequestDurationSeconds = "api_request_duration_seconds"
+
+	metricAPIThrottleRate = "api_throttle_rate"
 )
 
 const (
@@ -20,6 +22,7 @@ const (
 	labelOperation  = "operation"
 	labelStatusCode = "status_code"
 	labelErrorCode  = "error_code"
+	labelAccount    = "account"
 )
 
 type instruments struct {
@@ -28,6 +31,7 @@ type instruments struct {
 	apiCallRetries           *prometheus.HistogramVec
 	apiRequestsTotal         *prometheus.CounterVec
 	apiRequestDurationSecond *prometheus.HistogramVec
+	apiThrottleRate          *prometheus.GaugeVec
 }
 
 // newInstruments allocates and register new metrics to registerer
@@ -36,29 +40,35 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallsTotal,
 		Help:      "Total number of SDK API calls from the customer's code to AWS services",
-	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode})
+	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode, labelAccount})
 	apiCallDurationSeconds := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallDurationSeconds,
 		Help:      "Perceived latency from when your code makes an SDK call, includes retries",
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelAccount})
 	apiCallRetries := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallRetries,
 		Help:      "Number of times the SDK retried requests to AWS services for SDK API calls",
 		Buckets:   []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelAccount})
 
 	apiRequestsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPIRequestsTotal,
 		Help:      "Total number of HTTP requests that the SDK made",
-	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode})
+	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode, labelAccount})
 	apiRequestDurationSecond := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPIRequestDurationSeconds,
 		Help:      "Latency of an individual HTTP request to the service endpoint",
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelAccount})
+
+	apiThrottleRate := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPIThrottleRate,
+		Help:      "Current rate of requests per second allowed by adaptive throttling for SDK API calls",
+	}, []string{labelService, labelOperation, labelAccount})
 
 	if err := registerer.Register(apiCallsTotal); err != nil {
 		return nil, err
@@ -75,11 +85,15 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 	if err := registerer.Register(apiRequestDurationSecond); err != nil {
 		return nil, err
 	}
+	if err := registerer.Register(apiThrottleRate); err != nil {
+		return nil, err
+	}
 	return &instruments{
 		apiCallsTotal:            apiCallsTotal,
 		apiCallDurationSeconds:   apiCallDurationSeconds,
 		apiCallRetries:           apiCallRetries,
 		apiRequestsTotal:         apiRequestsTotal,
 		apiRequestDurationSecond: apiRequestDurationSecond,
+		apiThrottleRate:          apiThrottleRate,
 	}, nil
 }
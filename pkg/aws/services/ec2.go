@@ -21,6 +21,15 @@ type EC2 interface {
 
 	// wrapper to DescribeSubnetsPagesWithContext API, which aggregates paged results into list.
 	DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error)
+
+	// wrapper to DescribeVpcEndpointConnectionsPagesWithContext API, which aggregates paged results into list.
+	DescribeVpcEndpointConnectionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointConnectionsInput) ([]*ec2.VpcEndpointConnection, error)
+
+	// wrapper to DescribeVpcEndpointServiceConfigurationsPagesWithContext API, which aggregates paged results into list.
+	DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]*ec2.ServiceConfiguration, error)
+
+	// wrapper to DescribeVpcEndpointServicePermissionsPagesWithContext API, which aggregates paged results into list.
+	DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]*ec2.AllowedPrincipal, error)
 }
 
 // NewEC2 constructs new EC2 implementation.
@@ -79,3 +88,36 @@ func (c *defaultEC2) DescribeSubnetsAsList(ctx context.Context, input *ec2.Descr
 	}
 	return result, nil
 }
+
+func (c *defaultEC2) DescribeVpcEndpointConnectionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointConnectionsInput) ([]*ec2.VpcEndpointConnection, error) {
+	var result []*ec2.VpcEndpointConnection
+	if err := c.DescribeVpcEndpointConnectionsPagesWithContext(ctx, input, func(output *ec2.DescribeVpcEndpointConnectionsOutput, _ bool) bool {
+		result = append(result, output.VpcEndpointConnections...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]*ec2.ServiceConfiguration, error) {
+	var result []*ec2.ServiceConfiguration
+	if err := c.DescribeVpcEndpointServiceConfigurationsPagesWithContext(ctx, input, func(output *ec2.DescribeVpcEndpointServiceConfigurationsOutput, _ bool) bool {
+		result = append(result, output.ServiceConfigurations...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]*ec2.AllowedPrincipal, error) {
+	var result []*ec2.AllowedPrincipal
+	if err := c.DescribeVpcEndpointServicePermissionsPagesWithContext(ctx, input, func(output *ec2.DescribeVpcEndpointServicePermissionsOutput, _ bool) bool {
+		result = append(result, output.AllowedPrincipals...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
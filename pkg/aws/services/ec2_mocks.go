@@ -21592,6 +21592,21 @@ func (mr *MockEC2MockRecorder) DescribeVpcEndpointConnections(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointConnections", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointConnections), arg0)
 }
 
+// DescribeVpcEndpointConnectionsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointConnectionsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointConnectionsInput) ([]*ec2.VpcEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointConnectionsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*ec2.VpcEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointConnectionsAsList indicates an expected call of DescribeVpcEndpointConnectionsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointConnectionsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointConnectionsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointConnectionsAsList), arg0, arg1)
+}
+
 // DescribeVpcEndpointConnectionsPages mocks base method.
 func (m *MockEC2) DescribeVpcEndpointConnectionsPages(arg0 *ec2.DescribeVpcEndpointConnectionsInput, arg1 func(*ec2.DescribeVpcEndpointConnectionsOutput, bool) bool) error {
 	m.ctrl.T.Helper()
@@ -21675,6 +21690,21 @@ func (mr *MockEC2MockRecorder) DescribeVpcEndpointServiceConfigurations(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServiceConfigurations", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServiceConfigurations), arg0)
 }
 
+// DescribeVpcEndpointServiceConfigurationsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointServiceConfigurationsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]*ec2.ServiceConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServiceConfigurationsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*ec2.ServiceConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServiceConfigurationsAsList indicates an expected call of DescribeVpcEndpointServiceConfigurationsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointServiceConfigurationsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServiceConfigurationsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServiceConfigurationsAsList), arg0, arg1)
+}
+
 // DescribeVpcEndpointServiceConfigurationsPages mocks base method.
 func (m *MockEC2) DescribeVpcEndpointServiceConfigurationsPages(arg0 *ec2.DescribeVpcEndpointServiceConfigurationsInput, arg1 func(*ec2.DescribeVpcEndpointServiceConfigurationsOutput, bool) bool) error {
 	m.ctrl.T.Helper()
@@ -21758,6 +21788,21 @@ func (mr *MockEC2MockRecorder) DescribeVpcEndpointServicePermissions(arg0 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServicePermissions", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServicePermissions), arg0)
 }
 
+// DescribeVpcEndpointServicePermissionsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointServicePermissionsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServicePermissionsInput) ([]*ec2.AllowedPrincipal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServicePermissionsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*ec2.AllowedPrincipal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServicePermissionsAsList indicates an expected call of DescribeVpcEndpointServicePermissionsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointServicePermissionsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServicePermissionsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServicePermissionsAsList), arg0, arg1)
+}
+
 // DescribeVpcEndpointServicePermissionsPages mocks base method.
 func (m *MockEC2) DescribeVpcEndpointServicePermissionsPages(arg0 *ec2.DescribeVpcEndpointServicePermissionsInput, arg1 func(*ec2.DescribeVpcEndpointServicePermissionsOutput, bool) bool) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,200 @@
+package throttle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"golang.org/x/time/rate"
+)
+
+const (
+	sdkHandlerAdaptiveRequestThrottle = "adaptiveRequestThrottle"
+	sdkHandlerAdaptiveRequestFeedback = "adaptiveRequestFeedback"
+
+	// adaptiveDecreaseFactor is the factor to multiply the rate of an operation with when it's throttled.
+	adaptiveDecreaseFactor = 0.5
+	// adaptiveIncreaseRatio is the ratio of configured rate to add to the rate of an operation when it succeeds.
+	adaptiveIncreaseRatio = 0.05
+	// adaptiveMinRateRatio is the ratio of configured rate that the rate of an operation won't be lowered below.
+	adaptiveMinRateRatio = 0.05
+	// adaptiveAdjustInterval is the minimum interval between adjustments to the rate of an operation,
+	// so that concurrent requests throttled together only lower the rate once.
+	adaptiveAdjustInterval = 1 * time.Second
+)
+
+// RateObserver observes the current rates of adaptively throttled operations.
+type RateObserver interface {
+	// ObserveThrottleRate observes the current rate of requests per second allowed for service's operation.
+	ObserveThrottleRate(serviceID string, operation string, rate float64)
+}
+
+type operationKey struct {
+	serviceID string
+	operation string
+}
+
+// adaptiveLimiter is the rate limiter of an operation whose rate is adjusted by AIMD(additive increase/multiplicative decrease).
+type adaptiveLimiter struct {
+	// ceiling and ceilingBurst are the configured rate and burst.
+	ceiling      rate.Limit
+	ceilingBurst int
+	limiter      *rate.Limiter
+
+	mutex        sync.Mutex
+	lastAdjusted time.Time
+}
+
+// decrease lowers the rate multiplicatively, returns whether the rate is adjusted.
+func (l *adaptiveLimiter) decrease(now time.Time) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if now.Sub(l.lastAdjusted) < adaptiveAdjustInterval {
+		return false
+	}
+	minRate := l.ceiling * adaptiveMinRateRatio
+	r := l.limiter.Limit() * adaptiveDecreaseFactor
+	if r < minRate {
+		r = minRate
+	}
+	return l.adjust(now, r)
+}
+
+// increase raises the rate additively up to the configured rate, returns whether the rate is adjusted.
+func (l *adaptiveLimiter) increase(now time.Time) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.limiter.Limit() >= l.ceiling || now.Sub(l.lastAdjusted) < adaptiveAdjustInterval {
+		return false
+	}
+	r := l.limiter.Limit() + l.ceiling*adaptiveIncreaseRatio
+	if r > l.ceiling {
+		r = l.ceiling
+	}
+	return l.adjust(now, r)
+}
+
+// adjust sets the rate and scales the burst in proportion to it.
+func (l *adaptiveLimiter) adjust(now time.Time, r rate.Limit) bool {
+	l.lastAdjusted = now
+	if r == l.limiter.Limit() {
+		return false
+	}
+	burst := int(float64(l.ceilingBurst) * float64(r/l.ceiling))
+	if burst < 1 {
+		burst = 1
+	}
+	l.limiter.SetLimitAt(now, r)
+	l.limiter.SetBurstAt(now, burst)
+	return true
+}
+
+// adaptiveThrottler throttles each operation with a rate that is lowered when AWS throttles the operation and slowly recovered
+// when it succeeds again. Only operations with configured throttle are throttled, and the configured rate is the ceiling.
+type adaptiveThrottler struct {
+	config       *ServiceOperationsThrottleConfig
+	rateObserver RateObserver
+	clock        func() time.Time
+
+	// limiters contains the adaptiveLimiter of each operation, or nil if the operation has no configured throttle.
+	limiters      map[operationKey]*adaptiveLimiter
+	limitersMutex sync.Mutex
+}
+
+// NewAdaptiveThrottler constructs new adaptive request throttler instance.
+// rateObserver is optional, current rates are observed by it when set.
+func NewAdaptiveThrottler(config *ServiceOperationsThrottleConfig, rateObserver RateObserver) *adaptiveThrottler {
+	return &adaptiveThrottler{
+		config:       config,
+		rateObserver: rateObserver,
+		clock:        time.Now,
+		limiters:     make(map[operationKey]*adaptiveLimiter),
+	}
+}
+
+func (t *adaptiveThrottler) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveRequestThrottle,
+		Fn:   t.beforeSign,
+	})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveRequestFeedback,
+		Fn:   t.afterAttempt,
+	})
+}
+
+// beforeSign is added to the Sign chain; called before each request attempt
+// the request fails without being sent if its context is done before the limiter permits it.
+func (t *adaptiveThrottler) beforeSign(r *request.Request) {
+	if limiter := t.limiterForRequest(r); limiter != nil {
+		if err := limiter.limiter.Wait(r.Context()); err != nil {
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for adaptive throttle", err)
+		}
+	}
+}
+
+// afterAttempt is added to the CompleteAttempt chain; called after each request attempt
+func (t *adaptiveThrottler) afterAttempt(r *request.Request) {
+	limiter := t.limiterForRequest(r)
+	if limiter == nil {
+		return
+	}
+	now := t.clock()
+	var adjusted bool
+	if r.Error == nil {
+		adjusted = limiter.increase(now)
+	} else if request.IsErrorThrottle(r.Error) {
+		adjusted = limiter.decrease(now)
+	}
+	if adjusted {
+		t.observeRate(r, limiter)
+	}
+}
+
+// limiterForRequest returns the adaptiveLimiter for the operation of request, it's created on first use.
+func (t *adaptiveThrottler) limiterForRequest(r *request.Request) *adaptiveLimiter {
+	if r.Operation == nil {
+		return nil
+	}
+	key := operationKey{serviceID: r.ClientInfo.ServiceID, operation: r.Operation.Name}
+	t.limitersMutex.Lock()
+	defer t.limitersMutex.Unlock()
+	if limiter, ok := t.limiters[key]; ok {
+		return limiter
+	}
+	limiter := t.buildLimiter(key)
+	t.limiters[key] = limiter
+	if limiter != nil {
+		t.observeRate(r, limiter)
+	}
+	return limiter
+}
+
+// buildLimiter builds the adaptiveLimiter for operation, with the lowest configured rate that applies to the operation as ceiling.
+func (t *adaptiveThrottler) buildLimiter(key operationKey) *adaptiveLimiter {
+	var matchedConfig *throttleConfig
+	for i, throttleConfig := range t.config.value[key.serviceID] {
+		if !throttleConfig.operationPtn.MatchString(key.operation) {
+			continue
+		}
+		if matchedConfig == nil || throttleConfig.r < matchedConfig.r {
+			matchedConfig = &t.config.value[key.serviceID][i]
+		}
+	}
+	if matchedConfig == nil {
+		return nil
+	}
+	return &adaptiveLimiter{
+		ceiling:      matchedConfig.r,
+		ceilingBurst: matchedConfig.burst,
+		limiter:      rate.NewLimiter(matchedConfig.r, matchedConfig.burst),
+	}
+}
+
+func (t *adaptiveThrottler) observeRate(r *request.Request, limiter *adaptiveLimiter) {
+	if t.rateObserver == nil {
+		return
+	}
+	t.rateObserver.ObserveThrottleRate(r.ClientInfo.ServiceID, r.Operation.Name, float64(limiter.limiter.Limit()))
+}
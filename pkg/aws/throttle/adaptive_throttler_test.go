@@ -0,0 +1,300 @@
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+// recordingRateObserver records the observed rates per operation.
+type recordingRateObserver struct {
+	rates map[string][]float64
+}
+
+func (o *recordingRateObserver) ObserveThrottleRate(serviceID string, operation string, rate float64) {
+	o.rates[serviceID+"/"+operation] = append(o.rates[serviceID+"/"+operation], rate)
+}
+
+func Test_adaptiveThrottler_buildLimiter(t *testing.T) {
+	config := &ServiceOperationsThrottleConfig{
+		value: map[string][]throttleConfig{
+			elbv2.ServiceID: {
+				{
+					operationPtn: regexp.MustCompile("^RegisterTargets|^DeregisterTargets"),
+					r:            rate.Limit(4),
+					burst:        20,
+				},
+				{
+					operationPtn: regexp.MustCompile("^Describe"),
+					r:            rate.Limit(10),
+					burst:        40,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		key         operationKey
+		wantLimiter bool
+		wantCeiling rate.Limit
+		wantBurst   int
+	}{
+		{
+			name:        "operation with configured throttle",
+			key:         operationKey{serviceID: elbv2.ServiceID, operation: "DescribeTargetHealth"},
+			wantLimiter: true,
+			wantCeiling: rate.Limit(10),
+			wantBurst:   40,
+		},
+		{
+			name:        "operation with configured throttle - lowest rate is ceiling",
+			key:         operationKey{serviceID: elbv2.ServiceID, operation: "RegisterTargets"},
+			wantLimiter: true,
+			wantCeiling: rate.Limit(4),
+			wantBurst:   20,
+		},
+		{
+			name:        "operation without configured throttle",
+			key:         operationKey{serviceID: elbv2.ServiceID, operation: "CreateLoadBalancer"},
+			wantLimiter: false,
+		},
+		{
+			name:        "service without configured throttle",
+			key:         operationKey{serviceID: "EC2", operation: "DescribeInstances"},
+			wantLimiter: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := NewAdaptiveThrottler(config, nil)
+			got := throttler.buildLimiter(tt.key)
+			if !tt.wantLimiter {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantCeiling, got.ceiling)
+			assert.Equal(t, tt.wantBurst, got.ceilingBurst)
+			assert.Equal(t, tt.wantCeiling, got.limiter.Limit())
+			assert.Equal(t, tt.wantBurst, got.limiter.Burst())
+		})
+	}
+}
+
+func Test_adaptiveThrottler_afterAttempt(t *testing.T) {
+	throttlingErr := awserr.New("Throttling", "Rate exceeded", nil)
+	requestLimitExceededErr := awserr.New("RequestLimitExceeded", "Request limit exceeded", nil)
+	validationErr := awserr.New("ValidationError", "invalid target", nil)
+
+	type attempt struct {
+		// after is the duration since previous attempt
+		after time.Duration
+		err   error
+	}
+	tests := []struct {
+		name         string
+		attempts     []attempt
+		wantRate     rate.Limit
+		wantBurst    int
+		wantObserved []float64
+	}{
+		{
+			name: "successful attempts at configured rate",
+			attempts: []attempt{
+				{after: 0},
+				{after: 2 * time.Second},
+			},
+			wantRate:     rate.Limit(10),
+			wantBurst:    40,
+			wantObserved: []float64{10},
+		},
+		{
+			name: "throttled attempt lowers rate",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: throttlingErr},
+			},
+			wantRate:     rate.Limit(5),
+			wantBurst:    20,
+			wantObserved: []float64{10, 5},
+		},
+		{
+			name: "concurrently throttled attempts lower rate once",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 100 * time.Millisecond, err: requestLimitExceededErr},
+				{after: 100 * time.Millisecond, err: throttlingErr},
+			},
+			wantRate:     rate.Limit(5),
+			wantBurst:    20,
+			wantObserved: []float64{10, 5},
+		},
+		{
+			name: "repeatedly throttled attempts lower rate down to minimum",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second, err: throttlingErr},
+			},
+			wantRate:     rate.Limit(0.5),
+			wantBurst:    2,
+			wantObserved: []float64{10, 5, 2.5, 1.25, 0.625, 0.5},
+		},
+		{
+			name: "successful attempts recover rate additively",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second},
+				{after: 100 * time.Millisecond},
+				{after: 2 * time.Second},
+			},
+			wantRate:     rate.Limit(6),
+			wantBurst:    24,
+			wantObserved: []float64{10, 5, 5.5, 6},
+		},
+		{
+			name: "successful attempts recover rate up to configured rate",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: throttlingErr},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+				{after: 2 * time.Second},
+			},
+			wantRate:     rate.Limit(10),
+			wantBurst:    40,
+			wantObserved: []float64{10, 5, 5.5, 6, 6.5, 7, 7.5, 8, 8.5, 9, 9.5, 10},
+		},
+		{
+			name: "attempts failed with other errors keep rate",
+			attempts: []attempt{
+				{after: 2 * time.Second, err: validationErr},
+			},
+			wantRate:     rate.Limit(10),
+			wantBurst:    40,
+			wantObserved: []float64{10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &ServiceOperationsThrottleConfig{
+				value: map[string][]throttleConfig{
+					elbv2.ServiceID: {
+						{
+							operationPtn: regexp.MustCompile(".*"),
+							r:            rate.Limit(10),
+							burst:        40,
+						},
+					},
+				},
+			}
+			rateObserver := &recordingRateObserver{rates: make(map[string][]float64)}
+			throttler := NewAdaptiveThrottler(config, rateObserver)
+			now := time.Now()
+			throttler.clock = func() time.Time {
+				return now
+			}
+			for _, attempt := range tt.attempts {
+				now = now.Add(attempt.after)
+				throttler.afterAttempt(&request.Request{
+					ClientInfo: metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+					Operation:  &request.Operation{Name: "DescribeTargetHealth"},
+					Error:      attempt.err,
+				})
+			}
+			limiter := throttler.limiters[operationKey{serviceID: elbv2.ServiceID, operation: "DescribeTargetHealth"}]
+			assert.InDelta(t, float64(tt.wantRate), float64(limiter.limiter.Limit()), 1e-9)
+			assert.Equal(t, tt.wantBurst, limiter.limiter.Burst())
+			assert.InDeltaSlice(t, tt.wantObserved, rateObserver.rates[elbv2.ServiceID+"/DescribeTargetHealth"], 1e-9)
+		})
+	}
+}
+
+func Test_adaptiveThrottler_afterAttempt_withoutConfiguredThrottle(t *testing.T) {
+	rateObserver := &recordingRateObserver{rates: make(map[string][]float64)}
+	throttler := NewAdaptiveThrottler(&ServiceOperationsThrottleConfig{}, rateObserver)
+	throttler.afterAttempt(&request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+		Operation:  &request.Operation{Name: "DescribeTargetHealth"},
+		Error:      awserr.New("Throttling", "Rate exceeded", nil),
+	})
+	assert.Nil(t, throttler.limiters[operationKey{serviceID: elbv2.ServiceID, operation: "DescribeTargetHealth"}])
+	assert.Empty(t, rateObserver.rates)
+}
+
+func Test_adaptiveThrottler_beforeSign(t *testing.T) {
+	config := &ServiceOperationsThrottleConfig{
+		value: map[string][]throttleConfig{
+			elbv2.ServiceID: {
+				{
+					operationPtn: regexp.MustCompile("^Describe"),
+					r:            rate.Limit(10),
+					burst:        40,
+				},
+			},
+		},
+	}
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		operation string
+		wantErr   bool
+	}{
+		{
+			name:      "throttled operation is permitted",
+			ctx:       context.Background(),
+			operation: "DescribeTargetHealth",
+		},
+		{
+			name:      "throttled operation with canceled context",
+			ctx:       canceledCtx,
+			operation: "DescribeTargetHealth",
+			wantErr:   true,
+		},
+		{
+			name:      "operation without configured throttle with canceled context",
+			ctx:       canceledCtx,
+			operation: "RegisterTargets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := NewAdaptiveThrottler(config, nil)
+			r := &request.Request{
+				HTTPRequest: &http.Request{},
+				ClientInfo:  metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+				Operation:   &request.Operation{Name: tt.operation},
+			}
+			r.SetContext(tt.ctx)
+			throttler.beforeSign(r)
+			if tt.wantErr {
+				var awsErr awserr.Error
+				if assert.ErrorAs(t, r.Error, &awsErr) {
+					assert.Equal(t, request.CanceledErrorCode, awsErr.Code())
+					assert.Equal(t, context.Canceled, awsErr.OrigErr())
+				}
+			} else {
+				assert.NoError(t, r.Error)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ const (
 	flagBackendSecurityGroup                         = "backend-security-group"
 	flagEnableEndpointSlices                         = "enable-endpoint-slices"
 	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
+	flagEnableLoadBalancerState                      = "enable-load-balancer-state"
 	defaultLogLevel                                  = "info"
 	defaultMaxConcurrentReconciles                   = 3
 	defaultMaxExponentialBackoffDelay                = time.Second * 1000
@@ -34,6 +35,7 @@ const (
 	defaultEnableBackendSG                           = true
 	defaultEnableEndpointSlices                      = false
 	defaultDisableRestrictedSGRules                  = false
+	defaultEnableLoadBalancerState                   = false
 )
 
 var (
@@ -65,6 +67,12 @@ type ControllerConfig struct {
 	AddonsConfig AddonsConfig
 	// Configurations for the Service controller
 	ServiceConfig ServiceConfig
+	// Configurations for the garbage collector of orphaned AWS resources
+	OrphanedResourceGCConfig OrphanedResourceGCConfig
+	// Configurations for the detection of drift between AWS resources and the deployed stacks
+	DriftDetectionConfig DriftDetectionConfig
+	// Configurations for the sharding of reconciliation across controller replicas
+	ShardingConfig ShardingConfig
 
 	// Default AWS Tags that will be applied to all AWS resources managed by this controller.
 	DefaultTags map[string]string
@@ -102,6 +110,9 @@ type ControllerConfig struct {
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// EnableLoadBalancerState specifies whether to report the reconciled AWS state of load balancers via LoadBalancerState objects
+	EnableLoadBalancerState bool
+
 	FeatureGates FeatureGates
 }
 
@@ -132,6 +143,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.BoolVar(&cfg.EnableLoadBalancerState, flagEnableLoadBalancerState, defaultEnableLoadBalancerState,
+		"Enable reporting of the reconciled AWS state of load balancers via LoadBalancerState objects")
 	fs.StringToStringVar(&cfg.ServiceTargetENISGTags, flagServiceTargetENISGTags, nil,
 		"AWS Tags, in addition to cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs")
 	cfg.FeatureGates.BindFlags(fs)
@@ -142,6 +155,9 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 	cfg.IngressConfig.BindFlags(fs)
 	cfg.AddonsConfig.BindFlags(fs)
 	cfg.ServiceConfig.BindFlags(fs)
+	cfg.OrphanedResourceGCConfig.BindFlags(fs)
+	cfg.DriftDetectionConfig.BindFlags(fs)
+	cfg.ShardingConfig.BindFlags(fs)
 }
 
 // Validate the controller configuration
@@ -165,6 +181,9 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateBackendSecurityGroupConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.ShardingConfig.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
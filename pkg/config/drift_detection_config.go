@@ -0,0 +1,30 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagDriftDetectionInterval    = "drift-detection-interval"
+	flagEnableDriftRemediation    = "enable-drift-remediation"
+	defaultDriftDetectionInterval = time.Duration(0)
+	defaultEnableDriftRemediation = false
+)
+
+// DriftDetectionConfig contains the configuration for the detection of drift between AWS resources and the deployed stacks.
+type DriftDetectionConfig struct {
+	// Interval between drift detections, the drift detection is disabled if it's zero.
+	Interval time.Duration
+	// EnableRemediation specifies whether drifted stacks are reconciled immediately, otherwise drift is only reported.
+	EnableRemediation bool
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *DriftDetectionConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&cfg.Interval, flagDriftDetectionInterval, defaultDriftDetectionInterval,
+		"Interval between detections of drift between AWS resources and the deployed stacks, set to 0 to disable the drift detection")
+	fs.BoolVar(&cfg.EnableRemediation, flagEnableDriftRemediation, defaultEnableDriftRemediation,
+		"Enable immediate reconcile of stacks with drifted AWS resources, otherwise drift is only reported")
+}
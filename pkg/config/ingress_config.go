@@ -10,12 +10,14 @@ const (
 	flagTolerateNonExistentBackendService    = "tolerate-non-existent-backend-service"
 	flagTolerateNonExistentBackendAction     = "tolerate-non-existent-backend-action"
 	flagAllowedCAArns                        = "allowed-certificate-authority-arns"
+	flagEnableIngressQuarantine              = "enable-ingress-quarantine"
 	defaultIngressClass                      = "alb"
 	defaultDisableIngressClassAnnotation     = false
 	defaultDisableIngressGroupNameAnnotation = false
 	defaultMaxIngressConcurrentReconciles    = 3
 	defaultTolerateNonExistentBackendService = true
 	defaultTolerateNonExistentBackendAction  = true
+	defaultEnableIngressQuarantine           = false
 )
 
 // IngressConfig contains the configurations for the Ingress controller
@@ -46,6 +48,10 @@ type IngressConfig struct {
 
 	// AllowedCertificateAuthoritiyARNs contains a list of all CAs to consider when discovering certificates for ingress resources
 	AllowedCertificateAuthorityARNs []string
+
+	// EnableQuarantine specifies whether to isolate Ingresses that fail model building from their IngressGroup,
+	// instead of failing the whole IngressGroup.
+	EnableQuarantine bool
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -63,4 +69,6 @@ func (cfg *IngressConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&cfg.TolerateNonExistentBackendAction, flagTolerateNonExistentBackendAction, defaultTolerateNonExistentBackendAction,
 		"Tolerate rules that specify a non-existent backend action")
 	fs.StringSliceVar(&cfg.AllowedCertificateAuthorityARNs, flagAllowedCAArns, []string{}, "Specify an optional list of CA ARNs to filter on in cert discovery")
+	fs.BoolVar(&cfg.EnableQuarantine, flagEnableIngressQuarantine, defaultEnableIngressQuarantine,
+		"Isolate Ingresses that fail model building from their IngressGroup instead of failing the whole IngressGroup")
 }
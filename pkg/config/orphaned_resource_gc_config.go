@@ -0,0 +1,36 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagOrphanedResourceGCInterval          = "orphaned-resource-gc-interval"
+	flagEnableOrphanedResourceDeletion      = "enable-orphaned-resource-deletion"
+	flagOrphanedResourceDeletionGracePeriod = "orphaned-resource-deletion-grace-period"
+	defaultOrphanedResourceGCInterval       = time.Duration(0)
+	defaultEnableOrphanedResourceDeletion   = false
+	defaultOrphanedResourceDeletionGrace    = time.Hour
+)
+
+// OrphanedResourceGCConfig contains the configuration for the garbage collector of orphaned AWS resources.
+type OrphanedResourceGCConfig struct {
+	// Interval between garbage collections, the garbage collector is disabled if it's zero.
+	Interval time.Duration
+	// EnableDeletion specifies whether orphaned AWS resources are deleted, otherwise they're only reported.
+	EnableDeletion bool
+	// DeletionGracePeriod is the duration that AWS resources must stay orphaned before they're deleted.
+	DeletionGracePeriod time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *OrphanedResourceGCConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&cfg.Interval, flagOrphanedResourceGCInterval, defaultOrphanedResourceGCInterval,
+		"Interval between garbage collections of orphaned AWS resources, set to 0 to disable the garbage collector")
+	fs.BoolVar(&cfg.EnableDeletion, flagEnableOrphanedResourceDeletion, defaultEnableOrphanedResourceDeletion,
+		"Enable deletion of orphaned AWS resources, otherwise they're only reported")
+	fs.DurationVar(&cfg.DeletionGracePeriod, flagOrphanedResourceDeletionGracePeriod, defaultOrphanedResourceDeletionGrace,
+		"Duration that AWS resources must stay orphaned before they're deleted")
+}
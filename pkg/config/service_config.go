@@ -3,18 +3,25 @@ package config
 import "github.com/spf13/pflag"
 
 const (
-	flagLoadBalancerClass    = "load-balancer-class"
-	defaultLoadBalancerClass = "service.k8s.aws/nlb"
+	flagLoadBalancerClass       = "load-balancer-class"
+	flagALBLoadBalancerClass    = "alb-load-balancer-class"
+	defaultLoadBalancerClass    = "service.k8s.aws/nlb"
+	defaultALBLoadBalancerClass = "service.k8s.aws/alb"
 )
 
 // ServiceConfig contains the configurations for the Service controller
 type ServiceConfig struct {
 	// LoadBalancerClass is the name of the load balancer class reconciled by this controller
 	LoadBalancerClass string
+
+	// ALBLoadBalancerClass is the name of the load balancer class reconciled by this controller with Application Load Balancers
+	ALBLoadBalancerClass string
 }
 
 // BindFlags binds the command line flags to the fields in the config object
 func (cfg *ServiceConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&cfg.LoadBalancerClass, flagLoadBalancerClass, defaultLoadBalancerClass,
 		"Name of the load balancer class reconciled by this controller")
+	fs.StringVar(&cfg.ALBLoadBalancerClass, flagALBLoadBalancerClass, defaultALBLoadBalancerClass,
+		"Name of the load balancer class reconciled by this controller with Application Load Balancers")
 }
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagShardCount             = "shard-count"
+	flagShardLeaseDuration     = "shard-lease-duration"
+	flagShardLeaseNamespace    = "shard-lease-namespace"
+	defaultShardCount          = 0
+	defaultShardLeaseDuration  = 15 * time.Second
+	defaultShardLeaseNamespace = ""
+	minShardLeaseDuration      = time.Second
+)
+
+// ShardingConfig contains the configuration for the sharding of reconciliation across controller replicas.
+type ShardingConfig struct {
+	// ShardCount is the number of shards that Ingress groups, Services and TargetGroupBindings are hashed into,
+	// the sharding is disabled if it's zero.
+	ShardCount int
+	// LeaseDuration is the duration that the Lease of a shard is held without renewal.
+	LeaseDuration time.Duration
+	// LeaseNamespace is the namespace of the Leases that coordinate the ownership of shards.
+	LeaseNamespace string
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *ShardingConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&cfg.ShardCount, flagShardCount, defaultShardCount,
+		"Number of shards that reconciliation is split into across controller replicas, set to 0 to disable the sharding")
+	fs.DurationVar(&cfg.LeaseDuration, flagShardLeaseDuration, defaultShardLeaseDuration,
+		"Duration that the Lease of a shard is held by a controller replica without renewal")
+	fs.StringVar(&cfg.LeaseNamespace, flagShardLeaseNamespace, defaultShardLeaseNamespace,
+		"Namespace of the Leases that coordinate the ownership of shards, required if the sharding is enabled")
+}
+
+// Validate the sharding configuration
+func (cfg *ShardingConfig) Validate() error {
+	if cfg.ShardCount < 0 {
+		return errors.Errorf("invalid value %v for %v flag", cfg.ShardCount, flagShardCount)
+	}
+	if cfg.ShardCount == 0 {
+		return nil
+	}
+	if cfg.LeaseDuration < minShardLeaseDuration {
+		return errors.Errorf("%v flag must be at least %v", flagShardLeaseDuration, minShardLeaseDuration)
+	}
+	if len(cfg.LeaseNamespace) == 0 {
+		return errors.Errorf("%v flag must be specified if the sharding is enabled", flagShardLeaseNamespace)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardingConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ShardingConfig
+		wantErr error
+	}{
+		{
+			name: "sharding is disabled",
+			cfg:  ShardingConfig{},
+		},
+		{
+			name: "sharding is enabled",
+			cfg: ShardingConfig{
+				ShardCount:     4,
+				LeaseDuration:  15 * time.Second,
+				LeaseNamespace: "kube-system",
+			},
+		},
+		{
+			name: "negative shard count",
+			cfg: ShardingConfig{
+				ShardCount: -1,
+			},
+			wantErr: errors.New("invalid value -1 for shard-count flag"),
+		},
+		{
+			name: "lease duration is too short",
+			cfg: ShardingConfig{
+				ShardCount:     4,
+				LeaseDuration:  500 * time.Millisecond,
+				LeaseNamespace: "kube-system",
+			},
+			wantErr: errors.New("shard-lease-duration flag must be at least 1s"),
+		},
+		{
+			name: "lease namespace is missing",
+			cfg: ShardingConfig{
+				ShardCount:    4,
+				LeaseDuration: 15 * time.Second,
+			},
+			wantErr: errors.New("shard-lease-namespace flag must be specified if the sharding is enabled"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
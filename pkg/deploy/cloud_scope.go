@@ -0,0 +1,108 @@
+package deploy
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CloudScope contains the AWS clients and the components built on them, that provision AWS resources with the same credentials.
+type CloudScope struct {
+	// RoleARN is the ARN of assumed IAM role, it's empty for the controller's own credentials.
+	RoleARN             string
+	Cloud               aws.Cloud
+	SGManager           networking.SecurityGroupManager
+	SGReconciler        networking.SecurityGroupReconciler
+	SubnetsResolver     networking.SubnetsResolver
+	VPCInfoProvider     networking.VPCInfoProvider
+	ELBV2TaggingManager elbv2.TaggingManager
+	BackendSGProvider   networking.BackendSGProvider
+	SGResolver          networking.SecurityGroupResolver
+}
+
+// CloudScopeProvider provides the CloudScope to provision AWS resources with.
+type CloudScopeProvider interface {
+	// ScopeForRole returns the CloudScope whose AWS clients use the credentials of assumed IAM role.
+	// The CloudScope with controller's own credentials is returned if roleARN is empty.
+	ScopeForRole(roleARN string, externalID string) (CloudScope, error)
+}
+
+// NewDefaultCloudScopeProvider constructs new defaultCloudScopeProvider.
+func NewDefaultCloudScopeProvider(defaultScope CloudScope, cloudProvider aws.CloudProvider, k8sClient client.Client,
+	controllerConfig config.ControllerConfig, logger logr.Logger) *defaultCloudScopeProvider {
+	return &defaultCloudScopeProvider{
+		defaultScope:     defaultScope,
+		cloudProvider:    cloudProvider,
+		k8sClient:        k8sClient,
+		controllerConfig: controllerConfig,
+		logger:           logger,
+		roleScopes:       make(map[roleScopeKey]CloudScope),
+	}
+}
+
+var _ CloudScopeProvider = &defaultCloudScopeProvider{}
+
+// roleScopeKey identifies the CloudScope of an assumed IAM role.
+type roleScopeKey struct {
+	roleARN    string
+	externalID string
+}
+
+// defaultCloudScopeProvider is the default implementation for CloudScopeProvider.
+// CloudScopes of assumed IAM roles are built on first use and cached, so their AWS clients and caches are reused.
+type defaultCloudScopeProvider struct {
+	defaultScope     CloudScope
+	cloudProvider    aws.CloudProvider
+	k8sClient        client.Client
+	controllerConfig config.ControllerConfig
+	logger           logr.Logger
+
+	roleScopes      map[roleScopeKey]CloudScope
+	roleScopesMutex sync.Mutex
+}
+
+func (p *defaultCloudScopeProvider) ScopeForRole(roleARN string, externalID string) (CloudScope, error) {
+	if len(roleARN) == 0 {
+		return p.defaultScope, nil
+	}
+	key := roleScopeKey{roleARN: roleARN, externalID: externalID}
+	p.roleScopesMutex.Lock()
+	defer p.roleScopesMutex.Unlock()
+	if scope, ok := p.roleScopes[key]; ok {
+		return scope, nil
+	}
+	cloud, err := p.cloudProvider.CloudForRole(roleARN, externalID)
+	if err != nil {
+		return CloudScope{}, errors.Wrapf(err, "failed to assume IAM role %v", roleARN)
+	}
+	scope := p.buildRoleScope(roleARN, cloud)
+	p.roleScopes[key] = scope
+	return scope, nil
+}
+
+// buildRoleScope builds the CloudScope of assumed IAM role with the same wiring as the controller's own CloudScope.
+// The configured backend security group belongs to the controller's own account, so an auto-generated one is used instead.
+func (p *defaultCloudScopeProvider) buildRoleScope(roleARN string, cloud aws.Cloud) CloudScope {
+	logger := p.logger.WithValues("iamRole", roleARN)
+	sgManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), logger.WithName("az-info-provider"))
+	return CloudScope{
+		RoleARN:         roleARN,
+		Cloud:           cloud,
+		SGManager:       sgManager,
+		SGReconciler:    networking.NewDefaultSecurityGroupReconciler(sgManager, logger),
+		SubnetsResolver: networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), p.controllerConfig.ClusterName, logger.WithName("subnets-resolver")),
+		VPCInfoProvider: networking.NewDefaultVPCInfoProvider(cloud.EC2(), logger.WithName("vpc-info-provider")),
+		ELBV2TaggingManager: elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), p.controllerConfig.FeatureGates,
+			cloud.RGT(), logger),
+		BackendSGProvider: networking.NewBackendSGProvider(p.controllerConfig.ClusterName, "", cloud.VpcID(), cloud.EC2(),
+			p.k8sClient, p.controllerConfig.DefaultTags, logger.WithName("backend-sg-provider")),
+		SGResolver: networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID()),
+	}
+}
@@ -0,0 +1,136 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeCloud is a Cloud that records the IAM roles assumed from it.
+type fakeCloud struct {
+	aws.Cloud
+	vpcID         string
+	assumedRoles  []string
+	assumeRoleErr error
+}
+
+func (c *fakeCloud) EC2() services.EC2 {
+	return nil
+}
+
+func (c *fakeCloud) ELBV2() services.ELBV2 {
+	return nil
+}
+
+func (c *fakeCloud) RGT() services.RGT {
+	return nil
+}
+
+func (c *fakeCloud) VpcID() string {
+	return c.vpcID
+}
+
+func (c *fakeCloud) AssumeRole(roleARN string, externalID string) (aws.Cloud, error) {
+	if c.assumeRoleErr != nil {
+		return nil, c.assumeRoleErr
+	}
+	c.assumedRoles = append(c.assumedRoles, roleARN+"#"+externalID)
+	return &fakeCloud{vpcID: c.vpcID}, nil
+}
+
+func Test_defaultCloudScopeProvider_ScopeForRole(t *testing.T) {
+	type scopeForRoleCall struct {
+		roleARN    string
+		externalID string
+		wantRole   string
+		wantErr    error
+	}
+	tests := []struct {
+		name             string
+		assumeRoleErr    error
+		calls            []scopeForRoleCall
+		wantAssumedRoles []string
+	}{
+		{
+			name: "controller's own credentials",
+			calls: []scopeForRoleCall{
+				{
+					roleARN:  "",
+					wantRole: "",
+				},
+			},
+		},
+		{
+			name: "scope of IAM role is cached",
+			calls: []scopeForRoleCall{
+				{
+					roleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+					externalID: "my-cluster",
+					wantRole:   "arn:aws:iam::123456789012:role/lbc-networking",
+				},
+				{
+					roleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+					externalID: "my-cluster",
+					wantRole:   "arn:aws:iam::123456789012:role/lbc-networking",
+				},
+			},
+			wantAssumedRoles: []string{"arn:aws:iam::123456789012:role/lbc-networking#my-cluster"},
+		},
+		{
+			name: "scopes are distinct per external ID",
+			calls: []scopeForRoleCall{
+				{
+					roleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+					externalID: "cluster-a",
+					wantRole:   "arn:aws:iam::123456789012:role/lbc-networking",
+				},
+				{
+					roleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+					externalID: "cluster-b",
+					wantRole:   "arn:aws:iam::123456789012:role/lbc-networking",
+				},
+			},
+			wantAssumedRoles: []string{
+				"arn:aws:iam::123456789012:role/lbc-networking#cluster-a",
+				"arn:aws:iam::123456789012:role/lbc-networking#cluster-b",
+			},
+		},
+		{
+			name:          "IAM role cannot be assumed",
+			assumeRoleErr: errors.New("invalid IAM role ARN: not-an-arn"),
+			calls: []scopeForRoleCall{
+				{
+					roleARN: "not-an-arn",
+					wantErr: errors.New("failed to assume IAM role not-an-arn: invalid IAM role ARN: not-an-arn"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloud := &fakeCloud{vpcID: "vpc-xxx", assumeRoleErr: tt.assumeRoleErr}
+			p := NewDefaultCloudScopeProvider(CloudScope{Cloud: cloud}, aws.NewDefaultCloudProvider(cloud), nil,
+				config.ControllerConfig{ClusterName: "my-cluster"}, log.Log)
+			for _, call := range tt.calls {
+				got, err := p.ScopeForRole(call.roleARN, call.externalID)
+				if call.wantErr != nil {
+					assert.EqualError(t, err, call.wantErr.Error())
+					continue
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, call.wantRole, got.RoleARN)
+				if call.roleARN == "" {
+					assert.Same(t, cloud, got.Cloud)
+				} else {
+					assert.Equal(t, "vpc-xxx", got.Cloud.VpcID())
+				}
+			}
+			assert.Equal(t, tt.wantAssumedRoles, cloud.assumedRoles)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// DriftDetector detects drift between the live state of EC2 resources and the stack that has been deployed.
+type DriftDetector interface {
+	// Detect returns the drifted fields of SecurityGroups in stack.
+	Detect(ctx context.Context, stack core.Stack) ([]string, error)
+}
+
+// NewDefaultDriftDetector constructs new defaultDriftDetector.
+func NewDefaultDriftDetector(networkingSGManager networking.SecurityGroupManager) *defaultDriftDetector {
+	return &defaultDriftDetector{
+		networkingSGManager: networkingSGManager,
+	}
+}
+
+var _ DriftDetector = &defaultDriftDetector{}
+
+// default implementation for DriftDetector.
+type defaultDriftDetector struct {
+	networkingSGManager networking.SecurityGroupManager
+}
+
+func (d *defaultDriftDetector) Detect(ctx context.Context, stack core.Stack) ([]string, error) {
+	var resSGs []*ec2model.SecurityGroup
+	stack.ListResources(&resSGs)
+	var drifts []string
+	for _, resSG := range resSGs {
+		if resSG.Status == nil {
+			continue
+		}
+		sgID := resSG.Status.GroupID
+		sgInfoByID, err := d.networkingSGManager.FetchSGInfosByID(ctx, []string{sgID}, networking.WithReloadIgnoringCache())
+		if err != nil {
+			if isSecurityGroupNotFoundError(err) {
+				drifts = append(drifts, fmt.Sprintf("SecurityGroup/%v: deleted", resSG.ID()))
+				continue
+			}
+			return nil, err
+		}
+		desiredPermissions, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+		if err != nil {
+			return nil, err
+		}
+		if !isIPPermissionInfosEqual(desiredPermissions, sgInfoByID[sgID].Ingress) {
+			drifts = append(drifts, fmt.Sprintf("SecurityGroup/%v: ingress rules", resSG.ID()))
+		}
+	}
+	return drifts, nil
+}
+
+// isIPPermissionInfosEqual checks whether permissions are equal, in the same way as the SecurityGroupReconciler does.
+func isIPPermissionInfosEqual(desiredPermissions []networking.IPPermissionInfo, currentPermissions []networking.IPPermissionInfo) bool {
+	desiredHashCodes := sets.NewString()
+	for _, permission := range desiredPermissions {
+		desiredHashCodes.Insert(permission.HashCode())
+	}
+	currentHashCodes := sets.NewString()
+	for _, permission := range currentPermissions {
+		currentHashCodes.Insert(permission.HashCode())
+	}
+	return desiredHashCodes.Equal(currentHashCodes)
+}
+
+func isSecurityGroupNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "InvalidGroup.NotFound"
+	}
+	return false
+}
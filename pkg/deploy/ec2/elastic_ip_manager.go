@@ -0,0 +1,158 @@
+package ec2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"time"
+)
+
+const (
+	// ElasticIPRetainTagKey is the tag key applied on Elastic IP addresses that should be retained when no longer desired.
+	ElasticIPRetainTagKey = "elbv2.k8s.aws/eip-retain"
+
+	defaultWaitEIPReleasePollInterval = 5 * time.Second
+	defaultWaitEIPReleaseTimeout      = 2 * time.Minute
+)
+
+// ElasticIPWithTags contains Elastic IP address and its tags.
+type ElasticIPWithTags struct {
+	Address *ec2sdk.Address
+	Tags    map[string]string
+}
+
+// ElasticIPManager is responsible for allocate/update/release Elastic IP addresses.
+type ElasticIPManager interface {
+	Create(ctx context.Context, resEIP *ec2model.ElasticIP) (ec2model.ElasticIPStatus, error)
+
+	Update(ctx context.Context, resEIP *ec2model.ElasticIP, sdkEIP ElasticIPWithTags) (ec2model.ElasticIPStatus, error)
+
+	Delete(ctx context.Context, sdkEIP ElasticIPWithTags) error
+}
+
+// NewDefaultElasticIPManager constructs new defaultElasticIPManager.
+func NewDefaultElasticIPManager(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultElasticIPManager {
+	return &defaultElasticIPManager{
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitEIPReleasePollInterval: defaultWaitEIPReleasePollInterval,
+		waitEIPReleaseTimeout:      defaultWaitEIPReleaseTimeout,
+	}
+}
+
+var _ ElasticIPManager = &defaultElasticIPManager{}
+
+// default implementation for ElasticIPManager.
+type defaultElasticIPManager struct {
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitEIPReleasePollInterval time.Duration
+	waitEIPReleaseTimeout      time.Duration
+}
+
+func (m *defaultElasticIPManager) Create(ctx context.Context, resEIP *ec2model.ElasticIP) (ec2model.ElasticIPStatus, error) {
+	eipTags := m.buildElasticIPTags(resEIP)
+	req := &ec2sdk.AllocateAddressInput{
+		Domain:         awssdk.String(ec2sdk.DomainTypeVpc),
+		PublicIpv4Pool: resEIP.Spec.PublicIPv4Pool,
+		TagSpecifications: []*ec2sdk.TagSpecification{
+			{
+				ResourceType: awssdk.String(ec2sdk.ResourceTypeElasticIp),
+				Tags:         convertTagsToSDKTags(eipTags),
+			},
+		},
+	}
+
+	m.logger.Info("allocating elasticIP",
+		"stackID", resEIP.Stack().StackID(),
+		"resourceID", resEIP.ID())
+	resp, err := m.ec2Client.AllocateAddressWithContext(ctx, req)
+	if err != nil {
+		return ec2model.ElasticIPStatus{}, err
+	}
+	m.logger.Info("allocated elasticIP",
+		"stackID", resEIP.Stack().StackID(),
+		"resourceID", resEIP.ID(),
+		"allocationID", awssdk.StringValue(resp.AllocationId),
+		"publicIP", awssdk.StringValue(resp.PublicIp))
+
+	return ec2model.ElasticIPStatus{
+		AllocationID: awssdk.StringValue(resp.AllocationId),
+		PublicIP:     awssdk.StringValue(resp.PublicIp),
+	}, nil
+}
+
+func (m *defaultElasticIPManager) Update(ctx context.Context, resEIP *ec2model.ElasticIP, sdkEIP ElasticIPWithTags) (ec2model.ElasticIPStatus, error) {
+	desiredTags := m.buildElasticIPTags(resEIP)
+	if err := m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkEIP.Address.AllocationId), desiredTags,
+		WithCurrentTags(sdkEIP.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return ec2model.ElasticIPStatus{}, err
+	}
+	return buildResElasticIPStatus(sdkEIP), nil
+}
+
+func (m *defaultElasticIPManager) Delete(ctx context.Context, sdkEIP ElasticIPWithTags) error {
+	allocationID := awssdk.StringValue(sdkEIP.Address.AllocationId)
+	if _, retain := sdkEIP.Tags[ElasticIPRetainTagKey]; retain {
+		m.logger.Info("retaining elasticIP",
+			"allocationID", allocationID,
+			"publicIP", awssdk.StringValue(sdkEIP.Address.PublicIp))
+		return nil
+	}
+
+	req := &ec2sdk.ReleaseAddressInput{
+		AllocationId: awssdk.String(allocationID),
+	}
+	m.logger.Info("releasing elasticIP",
+		"allocationID", allocationID)
+	// the address can still be associated with network interfaces of deleted load balancers for a while.
+	if err := runtime.RetryImmediateOnError(m.waitEIPReleasePollInterval, m.waitEIPReleaseTimeout, isElasticIPInUseError, func() error {
+		_, err := m.ec2Client.ReleaseAddressWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to release elasticIP")
+	}
+	m.logger.Info("released elasticIP",
+		"allocationID", allocationID)
+	return nil
+}
+
+func (m *defaultElasticIPManager) buildElasticIPTags(resEIP *ec2model.ElasticIP) map[string]string {
+	eipTags := m.trackingProvider.ResourceTags(resEIP.Stack(), resEIP, resEIP.Spec.Tags)
+	if resEIP.Spec.Retain {
+		eipTags[ElasticIPRetainTagKey] = "true"
+	}
+	return eipTags
+}
+
+func buildResElasticIPStatus(sdkEIP ElasticIPWithTags) ec2model.ElasticIPStatus {
+	return ec2model.ElasticIPStatus{
+		AllocationID: awssdk.StringValue(sdkEIP.Address.AllocationId),
+		PublicIP:     awssdk.StringValue(sdkEIP.Address.PublicIp),
+	}
+}
+
+func isElasticIPInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "InvalidIPAddress.InUse"
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultElasticIPManager_Delete(t *testing.T) {
+	type releaseAddressWithContextCall struct {
+		req  *ec2sdk.ReleaseAddressInput
+		resp *ec2sdk.ReleaseAddressOutput
+		err  error
+	}
+	type fields struct {
+		releaseAddressWithContextCalls []releaseAddressWithContextCall
+	}
+	type args struct {
+		sdkEIP ElasticIPWithTags
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "release elasticIP",
+			fields: fields{
+				releaseAddressWithContextCalls: []releaseAddressWithContextCall{
+					{
+						req: &ec2sdk.ReleaseAddressInput{
+							AllocationId: awssdk.String("eipalloc-a"),
+						},
+						resp: &ec2sdk.ReleaseAddressOutput{},
+					},
+				},
+			},
+			args: args{
+				sdkEIP: ElasticIPWithTags{
+					Address: &ec2sdk.Address{
+						AllocationId: awssdk.String("eipalloc-a"),
+						PublicIp:     awssdk.String("1.2.3.4"),
+					},
+					Tags: map[string]string{
+						"service.k8s.aws/resource": "ElasticIP/us-west-2a",
+					},
+				},
+			},
+		},
+		{
+			name: "release elasticIP after it's no longer in use",
+			fields: fields{
+				releaseAddressWithContextCalls: []releaseAddressWithContextCall{
+					{
+						req: &ec2sdk.ReleaseAddressInput{
+							AllocationId: awssdk.String("eipalloc-a"),
+						},
+						err: awserr.New("InvalidIPAddress.InUse", "some message", nil),
+					},
+					{
+						req: &ec2sdk.ReleaseAddressInput{
+							AllocationId: awssdk.String("eipalloc-a"),
+						},
+						resp: &ec2sdk.ReleaseAddressOutput{},
+					},
+				},
+			},
+			args: args{
+				sdkEIP: ElasticIPWithTags{
+					Address: &ec2sdk.Address{
+						AllocationId: awssdk.String("eipalloc-a"),
+						PublicIp:     awssdk.String("1.2.3.4"),
+					},
+					Tags: map[string]string{
+						"service.k8s.aws/resource": "ElasticIP/us-west-2a",
+					},
+				},
+			},
+		},
+		{
+			name: "retain elasticIP",
+			args: args{
+				sdkEIP: ElasticIPWithTags{
+					Address: &ec2sdk.Address{
+						AllocationId: awssdk.String("eipalloc-a"),
+						PublicIp:     awssdk.String("1.2.3.4"),
+					},
+					Tags: map[string]string{
+						"service.k8s.aws/resource": "ElasticIP/us-west-2a",
+						"elbv2.k8s.aws/eip-retain": "true",
+					},
+				},
+			},
+		},
+		{
+			name: "failed to release elasticIP",
+			fields: fields{
+				releaseAddressWithContextCalls: []releaseAddressWithContextCall{
+					{
+						req: &ec2sdk.ReleaseAddressInput{
+							AllocationId: awssdk.String("eipalloc-a"),
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				sdkEIP: ElasticIPWithTags{
+					Address: &ec2sdk.Address{
+						AllocationId: awssdk.String("eipalloc-a"),
+						PublicIp:     awssdk.String("1.2.3.4"),
+					},
+				},
+			},
+			wantErr: errors.New("failed to release elasticIP: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.releaseAddressWithContextCalls {
+				ec2Client.EXPECT().ReleaseAddressWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultElasticIPManager{
+				ec2Client:                  ec2Client,
+				logger:                     log.Log,
+				waitEIPReleasePollInterval: 1 * time.Millisecond,
+				waitEIPReleaseTimeout:      1 * time.Second,
+			}
+			err := m.Delete(context.Background(), tt.args.sdkEIP)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_isElasticIPInUseError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "is InvalidIPAddress.InUse error",
+			args: args{
+				err: awserr.New("InvalidIPAddress.InUse", "some message", nil),
+			},
+			want: true,
+		},
+		{
+			name: "wraps InvalidIPAddress.InUse error",
+			args: args{
+				err: errors.Wrap(awserr.New("InvalidIPAddress.InUse", "some message", nil), "wrapped message"),
+			},
+			want: true,
+		},
+		{
+			name: "isn't InvalidIPAddress.InUse error",
+			args: args{
+				err: errors.New("some other error"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isElasticIPInUseError(tt.args.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package ec2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+// NewElasticIPSynthesizer constructs new elasticIPSynthesizer.
+func NewElasticIPSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	eipManager ElasticIPManager, logger logr.Logger, stack core.Stack) *elasticIPSynthesizer {
+	return &elasticIPSynthesizer{
+		trackingProvider: trackingProvider,
+		taggingManager:   taggingManager,
+		eipManager:       eipManager,
+		logger:           logger,
+		stack:            stack,
+		unmatchedSDKEIPs: nil,
+	}
+}
+
+type elasticIPSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	eipManager       ElasticIPManager
+	logger           logr.Logger
+
+	stack            core.Stack
+	unmatchedSDKEIPs []ElasticIPWithTags
+}
+
+func (s *elasticIPSynthesizer) Synthesize(ctx context.Context) error {
+	var resEIPs []*ec2model.ElasticIP
+	s.stack.ListResources(&resEIPs)
+	sdkEIPs, err := s.findSDKElasticIPs(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKEIPs, unmatchedResEIPs, unmatchedSDKEIPs, err := matchResAndSDKElasticIPs(resEIPs, sdkEIPs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For ElasticIP, we release unmatched ones during post synthesize,
+	// since they can only be released after LoadBalancers using them are deleted.
+	s.unmatchedSDKEIPs = unmatchedSDKEIPs
+
+	if len(unmatchedResEIPs) != 0 {
+		retainedSDKEIPs, err := s.findRetainedSDKElasticIPs(ctx)
+		if err != nil {
+			return err
+		}
+		// retained elasticIPs are reused if they match, otherwise they are kept intact.
+		matchedResAndRetainedSDKEIPs, stillUnmatchedResEIPs, _, err := matchResAndSDKElasticIPs(unmatchedResEIPs, retainedSDKEIPs, s.trackingProvider.ResourceIDTagKey())
+		if err != nil {
+			return err
+		}
+		matchedResAndSDKEIPs = append(matchedResAndSDKEIPs, matchedResAndRetainedSDKEIPs...)
+		unmatchedResEIPs = stillUnmatchedResEIPs
+	}
+	for _, resEIP := range unmatchedResEIPs {
+		eipStatus, err := s.eipManager.Create(ctx, resEIP)
+		if err != nil {
+			return err
+		}
+		resEIP.SetStatus(eipStatus)
+	}
+	for _, resAndSDKEIP := range matchedResAndSDKEIPs {
+		eipStatus, err := s.eipManager.Update(ctx, resAndSDKEIP.resEIP, resAndSDKEIP.sdkEIP)
+		if err != nil {
+			return err
+		}
+		resAndSDKEIP.resEIP.SetStatus(eipStatus)
+	}
+	return nil
+}
+
+func (s *elasticIPSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkEIP := range s.unmatchedSDKEIPs {
+		if err := s.eipManager.Delete(ctx, sdkEIP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSDKElasticIPs will find all Elastic IP addresses allocated for stack.
+func (s *elasticIPSynthesizer) findSDKElasticIPs(ctx context.Context) ([]ElasticIPWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListElasticIPs(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKElasticIPPair struct {
+	resEIP *ec2model.ElasticIP
+	sdkEIP ElasticIPWithTags
+}
+
+// findRetainedSDKElasticIPs will find all AWS ElasticIPs retained for stack.
+func (s *elasticIPSynthesizer) findRetainedSDKElasticIPs(ctx context.Context) ([]ElasticIPWithTags, error) {
+	retainedStackTags := s.trackingProvider.RetainedStackTags(s.stack)
+	return s.taggingManager.ListElasticIPs(ctx, tracking.TagsAsTagFilter(retainedStackTags))
+}
+
+func matchResAndSDKElasticIPs(resEIPs []*ec2model.ElasticIP, sdkEIPs []ElasticIPWithTags,
+	resourceIDTagKey string) ([]resAndSDKElasticIPPair, []*ec2model.ElasticIP, []ElasticIPWithTags, error) {
+	var matchedResAndSDKEIPs []resAndSDKElasticIPPair
+	var unmatchedResEIPs []*ec2model.ElasticIP
+	var unmatchedSDKEIPs []ElasticIPWithTags
+
+	resEIPsByID := make(map[string]*ec2model.ElasticIP, len(resEIPs))
+	for _, resEIP := range resEIPs {
+		resEIPsByID[resEIP.ID()] = resEIP
+	}
+	sdkEIPsByID := make(map[string][]ElasticIPWithTags, len(sdkEIPs))
+	for _, sdkEIP := range sdkEIPs {
+		resourceID, ok := sdkEIP.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected elasticIP with no resourceID: %v", awssdk.StringValue(sdkEIP.Address.AllocationId))
+		}
+		sdkEIPsByID[resourceID] = append(sdkEIPsByID[resourceID], sdkEIP)
+	}
+
+	resEIPIDs := sets.StringKeySet(resEIPsByID)
+	sdkEIPIDs := sets.StringKeySet(sdkEIPsByID)
+	for _, resID := range resEIPIDs.Intersection(sdkEIPIDs).List() {
+		resEIP := resEIPsByID[resID]
+		sdkEIPs := sdkEIPsByID[resID]
+		matchedResAndSDKEIPs = append(matchedResAndSDKEIPs, resAndSDKElasticIPPair{
+			resEIP: resEIP,
+			sdkEIP: sdkEIPs[0],
+		})
+		unmatchedSDKEIPs = append(unmatchedSDKEIPs, sdkEIPs[1:]...)
+	}
+	for _, resID := range resEIPIDs.Difference(sdkEIPIDs).List() {
+		unmatchedResEIPs = append(unmatchedResEIPs, resEIPsByID[resID])
+	}
+	for _, resID := range sdkEIPIDs.Difference(resEIPIDs).List() {
+		unmatchedSDKEIPs = append(unmatchedSDKEIPs, sdkEIPsByID[resID]...)
+	}
+	return matchedResAndSDKEIPs, unmatchedResEIPs, unmatchedSDKEIPs, nil
+}
@@ -54,6 +54,19 @@ func (s *securityGroupSynthesizer) Synthesize(ctx context.Context) error {
 	// For SecurityGroup, we delete unmatched ones during post synthesize.
 	s.unmatchedSDKSGs = unmatchedSDKSGs
 
+	if len(unmatchedResSGs) != 0 {
+		retainedSDKSGs, err := s.findRetainedSDKSecurityGroups(ctx)
+		if err != nil {
+			return err
+		}
+		// retained securityGroups are reused if they match, otherwise they are kept intact.
+		matchedResAndRetainedSDKSGs, stillUnmatchedResSGs, _, err := matchResAndSDKSecurityGroups(unmatchedResSGs, retainedSDKSGs, s.trackingProvider.ResourceIDTagKey())
+		if err != nil {
+			return err
+		}
+		matchedResAndSDKSGs = append(matchedResAndSDKSGs, matchedResAndRetainedSDKSGs...)
+		unmatchedResSGs = stillUnmatchedResSGs
+	}
 	for _, resSG := range unmatchedResSGs {
 		sgStatus, err := s.sgManager.Create(ctx, resSG)
 		if err != nil {
@@ -94,6 +107,12 @@ type resAndSDKSecurityGroupPair struct {
 	sdkSG networking.SecurityGroupInfo
 }
 
+// findRetainedSDKSecurityGroups will find all AWS SecurityGroups retained for stack.
+func (s *securityGroupSynthesizer) findRetainedSDKSecurityGroups(ctx context.Context) ([]networking.SecurityGroupInfo, error) {
+	retainedStackTags := s.trackingProvider.RetainedStackTags(s.stack)
+	return s.taggingManager.ListSecurityGroups(ctx, tracking.TagsAsTagFilter(retainedStackTags))
+}
+
 func matchResAndSDKSecurityGroups(resSGs []*ec2model.SecurityGroup, sdkSGs []networking.SecurityGroupInfo,
 	resourceIDTagKey string) ([]resAndSDKSecurityGroupPair, []*ec2model.SecurityGroup, []networking.SecurityGroupInfo, error) {
 	var matchedResAndSDKSGs []resAndSDKSecurityGroupPair
@@ -54,6 +54,12 @@ type TaggingManager interface {
 
 	// ListSecurityGroups returns SecurityGroups that matches any of the tagging requirements.
 	ListSecurityGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]networking.SecurityGroupInfo, error)
+
+	// ListElasticIPs returns Elastic IP addresses that matches any of the tagging requirements.
+	ListElasticIPs(ctx context.Context, tagFilters ...tracking.TagFilter) ([]ElasticIPWithTags, error)
+
+	// ListVPCEndpointServices returns VPC endpoint services that matches any of the tagging requirements.
+	ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error)
 }
 
 // NewDefaultTaggingManager constructs new defaultTaggingManager.
@@ -157,22 +163,90 @@ func (m *defaultTaggingManager) listSecurityGroupsWithTagFilter(ctx context.Cont
 		},
 	}
 
+	req.Filters = append(req.Filters, buildSDKTagFilters(tagFilter)...)
+	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+}
+
+func (m *defaultTaggingManager) ListElasticIPs(ctx context.Context, tagFilters ...tracking.TagFilter) ([]ElasticIPWithTags, error) {
+	eipByAllocationID := make(map[string]ElasticIPWithTags)
+	for _, tagFilter := range tagFilters {
+		req := &ec2sdk.DescribeAddressesInput{
+			Filters: append([]*ec2sdk.Filter{
+				{
+					Name:   awssdk.String("domain"),
+					Values: awssdk.StringSlice([]string{ec2sdk.DomainTypeVpc}),
+				},
+			}, buildSDKTagFilters(tagFilter)...),
+		}
+		resp, err := m.ec2Client.DescribeAddressesWithContext(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range resp.Addresses {
+			eipByAllocationID[awssdk.StringValue(address.AllocationId)] = ElasticIPWithTags{
+				Address: address,
+				Tags:    convertSDKTagsToTags(address.Tags),
+			}
+		}
+	}
+
+	eips := make([]ElasticIPWithTags, 0, len(eipByAllocationID))
+	for _, allocationID := range sets.StringKeySet(eipByAllocationID).List() {
+		eips = append(eips, eipByAllocationID[allocationID])
+	}
+	return eips, nil
+}
+
+func (m *defaultTaggingManager) ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error) {
+	vpcesByServiceID := make(map[string]VPCEndpointServiceWithTags)
+	for _, tagFilter := range tagFilters {
+		req := &ec2sdk.DescribeVpcEndpointServiceConfigurationsInput{
+			Filters: buildSDKTagFilters(tagFilter),
+		}
+		serviceConfigurations, err := m.ec2Client.DescribeVpcEndpointServiceConfigurationsAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, serviceConfiguration := range serviceConfigurations {
+			vpcesByServiceID[awssdk.StringValue(serviceConfiguration.ServiceId)] = VPCEndpointServiceWithTags{
+				ServiceConfiguration: serviceConfiguration,
+				Tags:                 convertSDKTagsToTags(serviceConfiguration.Tags),
+			}
+		}
+	}
+
+	vpcesList := make([]VPCEndpointServiceWithTags, 0, len(vpcesByServiceID))
+	for _, serviceID := range sets.StringKeySet(vpcesByServiceID).List() {
+		vpcesList = append(vpcesList, vpcesByServiceID[serviceID])
+	}
+	return vpcesList, nil
+}
+
+// buildSDKTagFilters converts tagFilter into AWS SDK filter presentation.
+func buildSDKTagFilters(tagFilter tracking.TagFilter) []*ec2sdk.Filter {
+	var filters []*ec2sdk.Filter
 	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
 		tagValues := tagFilter[tagKey]
 		var filter ec2sdk.Filter
 		if len(tagValues) == 0 {
-			tagFilterName := "tag-key"
-			filter.Name = awssdk.String(tagFilterName)
+			filter.Name = awssdk.String("tag-key")
 			filter.Values = awssdk.StringSlice([]string{tagKey})
 		} else {
-			tagFilterName := fmt.Sprintf("tag:%v", tagKey)
-			filter.Name = awssdk.String(tagFilterName)
+			filter.Name = awssdk.String(fmt.Sprintf("tag:%v", tagKey))
 			filter.Values = awssdk.StringSlice(tagValues)
 		}
-		req.Filters = append(req.Filters, &filter)
+		filters = append(filters, &filter)
 	}
+	return filters
+}
 
-	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+// convert AWS SDK tag presentation into tags.
+func convertSDKTagsToTags(sdkTags []*ec2sdk.Tag) map[string]string {
+	tags := make(map[string]string, len(sdkTags))
+	for _, sdkTag := range sdkTags {
+		tags[awssdk.StringValue(sdkTag.Key)] = awssdk.StringValue(sdkTag.Value)
+	}
+	return tags
 }
 
 // convert tags into AWS SDK tag presentation.
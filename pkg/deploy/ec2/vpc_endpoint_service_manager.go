@@ -0,0 +1,352 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+// VPCEndpointServiceWithTags contains VPC endpoint service configuration and its tags.
+type VPCEndpointServiceWithTags struct {
+	ServiceConfiguration *ec2sdk.ServiceConfiguration
+	Tags                 map[string]string
+}
+
+// VPCEndpointServiceManager is responsible for create/update/delete VPC endpoint services.
+type VPCEndpointServiceManager interface {
+	Create(ctx context.Context, resVPCES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error)
+
+	Update(ctx context.Context, resVPCES *ec2model.VPCEndpointService, sdkVPCES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error)
+
+	Delete(ctx context.Context, sdkVPCES VPCEndpointServiceWithTags) error
+
+	// DetachLoadBalancers removes LoadBalancers from VPC endpoint service.
+	DetachLoadBalancers(ctx context.Context, sdkVPCES VPCEndpointServiceWithTags, lbARNs []string) error
+}
+
+// NewDefaultVPCEndpointServiceManager constructs new defaultVPCEndpointServiceManager.
+func NewDefaultVPCEndpointServiceManager(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultVPCEndpointServiceManager {
+	return &defaultVPCEndpointServiceManager{
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+	}
+}
+
+var _ VPCEndpointServiceManager = &defaultVPCEndpointServiceManager{}
+
+// default implementation for VPCEndpointServiceManager.
+type defaultVPCEndpointServiceManager struct {
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+}
+
+func (m *defaultVPCEndpointServiceManager) Create(ctx context.Context, resVPCES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error) {
+	nlbARNs, err := resolveNetworkLoadBalancerARNs(ctx, resVPCES)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	vpcesTags := m.trackingProvider.ResourceTags(resVPCES.Stack(), resVPCES, resVPCES.Spec.Tags)
+	req := &ec2sdk.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      awssdk.Bool(resVPCES.Spec.AcceptanceRequired),
+		NetworkLoadBalancerArns: awssdk.StringSlice(nlbARNs),
+		PrivateDnsName:          resVPCES.Spec.PrivateDNSName,
+		TagSpecifications: []*ec2sdk.TagSpecification{
+			{
+				ResourceType: awssdk.String(ec2sdk.ResourceTypeVpcEndpointService),
+				Tags:         convertTagsToSDKTags(vpcesTags),
+			},
+		},
+	}
+	if len(resVPCES.Spec.SupportedIPAddressTypes) != 0 {
+		req.SupportedIpAddressTypes = awssdk.StringSlice(resVPCES.Spec.SupportedIPAddressTypes)
+	}
+
+	m.logger.Info("creating vpcEndpointService",
+		"stackID", resVPCES.Stack().StackID(),
+		"resourceID", resVPCES.ID())
+	resp, err := m.ec2Client.CreateVpcEndpointServiceConfigurationWithContext(ctx, req)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	serviceID := awssdk.StringValue(resp.ServiceConfiguration.ServiceId)
+	m.logger.Info("created vpcEndpointService",
+		"stackID", resVPCES.Stack().StackID(),
+		"resourceID", resVPCES.ID(),
+		"serviceID", serviceID)
+
+	if err := m.reconcileAllowedPrincipals(ctx, serviceID, resVPCES.Spec.AllowedPrincipals, nil); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	return buildResVPCEndpointServiceStatus(resp.ServiceConfiguration), nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Update(ctx context.Context, resVPCES *ec2model.VPCEndpointService, sdkVPCES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error) {
+	serviceID := awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId)
+	if err := m.updateSDKVPCEndpointServiceWithTags(ctx, resVPCES, sdkVPCES); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	modified, err := m.updateSDKVPCEndpointServiceWithConfiguration(ctx, resVPCES, sdkVPCES)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	currentPrincipals, err := m.fetchAllowedPrincipals(ctx, serviceID)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.reconcileAllowedPrincipals(ctx, serviceID, resVPCES.Spec.AllowedPrincipals, currentPrincipals); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+
+	if !modified {
+		return buildResVPCEndpointServiceStatus(sdkVPCES.ServiceConfiguration), nil
+	}
+	// refresh the configuration as private DNS name verification could have changed.
+	serviceConfigurations, err := m.ec2Client.DescribeVpcEndpointServiceConfigurationsAsList(ctx, &ec2sdk.DescribeVpcEndpointServiceConfigurationsInput{
+		ServiceIds: awssdk.StringSlice([]string{serviceID}),
+	})
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if len(serviceConfigurations) != 1 {
+		return ec2model.VPCEndpointServiceStatus{}, errors.Errorf("expect exactly one vpcEndpointService with ID %v, got %v", serviceID, len(serviceConfigurations))
+	}
+	return buildResVPCEndpointServiceStatus(serviceConfigurations[0]), nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Delete(ctx context.Context, sdkVPCES VPCEndpointServiceWithTags) error {
+	serviceID := awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId)
+	if err := m.rejectEndpointConnections(ctx, serviceID); err != nil {
+		return err
+	}
+
+	req := &ec2sdk.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: awssdk.StringSlice([]string{serviceID}),
+	}
+	m.logger.Info("deleting vpcEndpointService",
+		"serviceID", serviceID)
+	resp, err := m.ec2Client.DeleteVpcEndpointServiceConfigurationsWithContext(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete vpcEndpointService")
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return errors.Errorf("failed to delete vpcEndpointService %v: %v", awssdk.StringValue(item.ResourceId), awssdk.StringValue(item.Error.Message))
+		}
+	}
+	m.logger.Info("deleted vpcEndpointService",
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) DetachLoadBalancers(ctx context.Context, sdkVPCES VPCEndpointServiceWithTags, lbARNs []string) error {
+	serviceID := awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId)
+	req := &ec2sdk.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId:                     awssdk.String(serviceID),
+		RemoveNetworkLoadBalancerArns: awssdk.StringSlice(lbARNs),
+	}
+	m.logger.Info("detaching loadBalancers from vpcEndpointService",
+		"serviceID", serviceID,
+		"loadBalancerARNs", lbARNs)
+	if _, err := m.ec2Client.ModifyVpcEndpointServiceConfigurationWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to detach loadBalancers from vpcEndpointService")
+	}
+	m.logger.Info("detached loadBalancers from vpcEndpointService",
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceWithTags(ctx context.Context, resVPCES *ec2model.VPCEndpointService, sdkVPCES VPCEndpointServiceWithTags) error {
+	desiredTags := m.trackingProvider.ResourceTags(resVPCES.Stack(), resVPCES, resVPCES.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId), desiredTags,
+		WithCurrentTags(sdkVPCES.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceWithConfiguration(ctx context.Context, resVPCES *ec2model.VPCEndpointService, sdkVPCES VPCEndpointServiceWithTags) (bool, error) {
+	nlbARNs, err := resolveNetworkLoadBalancerARNs(ctx, resVPCES)
+	if err != nil {
+		return false, err
+	}
+	sdkConfiguration := sdkVPCES.ServiceConfiguration
+	req := &ec2sdk.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId: sdkConfiguration.ServiceId,
+	}
+	var changeDescriptions []interface{}
+	if resVPCES.Spec.AcceptanceRequired != awssdk.BoolValue(sdkConfiguration.AcceptanceRequired) {
+		req.AcceptanceRequired = awssdk.Bool(resVPCES.Spec.AcceptanceRequired)
+		changeDescriptions = append(changeDescriptions, "acceptanceRequired",
+			fmt.Sprintf("%v => %v", awssdk.BoolValue(sdkConfiguration.AcceptanceRequired), resVPCES.Spec.AcceptanceRequired))
+	}
+	desiredPrivateDNSName := awssdk.StringValue(resVPCES.Spec.PrivateDNSName)
+	currentPrivateDNSName := awssdk.StringValue(sdkConfiguration.PrivateDnsName)
+	if desiredPrivateDNSName != currentPrivateDNSName {
+		if desiredPrivateDNSName == "" {
+			req.RemovePrivateDnsName = awssdk.Bool(true)
+		} else {
+			req.PrivateDnsName = awssdk.String(desiredPrivateDNSName)
+		}
+		changeDescriptions = append(changeDescriptions, "privateDNSName",
+			fmt.Sprintf("%v => %v", currentPrivateDNSName, desiredPrivateDNSName))
+	}
+	desiredNLBARNs := sets.NewString(nlbARNs...)
+	currentNLBARNs := sets.NewString(awssdk.StringValueSlice(sdkConfiguration.NetworkLoadBalancerArns)...)
+	if !desiredNLBARNs.Equal(currentNLBARNs) {
+		req.AddNetworkLoadBalancerArns = buildSDKStringSetIfNotEmpty(desiredNLBARNs.Difference(currentNLBARNs))
+		req.RemoveNetworkLoadBalancerArns = buildSDKStringSetIfNotEmpty(currentNLBARNs.Difference(desiredNLBARNs))
+		changeDescriptions = append(changeDescriptions, "networkLoadBalancerARNs",
+			fmt.Sprintf("%v => %v", currentNLBARNs.List(), desiredNLBARNs.List()))
+	}
+	if len(resVPCES.Spec.SupportedIPAddressTypes) != 0 {
+		desiredIPAddressTypes := sets.NewString(resVPCES.Spec.SupportedIPAddressTypes...)
+		currentIPAddressTypes := sets.NewString(awssdk.StringValueSlice(sdkConfiguration.SupportedIpAddressTypes)...)
+		if !desiredIPAddressTypes.Equal(currentIPAddressTypes) {
+			req.AddSupportedIpAddressTypes = buildSDKStringSetIfNotEmpty(desiredIPAddressTypes.Difference(currentIPAddressTypes))
+			req.RemoveSupportedIpAddressTypes = buildSDKStringSetIfNotEmpty(currentIPAddressTypes.Difference(desiredIPAddressTypes))
+			changeDescriptions = append(changeDescriptions, "supportedIPAddressTypes",
+				fmt.Sprintf("%v => %v", currentIPAddressTypes.List(), desiredIPAddressTypes.List()))
+		}
+	}
+	if len(changeDescriptions) == 0 {
+		return false, nil
+	}
+
+	m.logger.Info("modifying vpcEndpointService",
+		append([]interface{}{
+			"stackID", resVPCES.Stack().StackID(),
+			"resourceID", resVPCES.ID(),
+			"serviceID", awssdk.StringValue(sdkConfiguration.ServiceId),
+		}, changeDescriptions...)...)
+	if _, err := m.ec2Client.ModifyVpcEndpointServiceConfigurationWithContext(ctx, req); err != nil {
+		return false, err
+	}
+	m.logger.Info("modified vpcEndpointService",
+		"stackID", resVPCES.Stack().StackID(),
+		"resourceID", resVPCES.ID(),
+		"serviceID", awssdk.StringValue(sdkConfiguration.ServiceId))
+	return true, nil
+}
+
+func (m *defaultVPCEndpointServiceManager) fetchAllowedPrincipals(ctx context.Context, serviceID string) ([]string, error) {
+	allowedPrincipals, err := m.ec2Client.DescribeVpcEndpointServicePermissionsAsList(ctx, &ec2sdk.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: awssdk.String(serviceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	principals := make([]string, 0, len(allowedPrincipals))
+	for _, allowedPrincipal := range allowedPrincipals {
+		principals = append(principals, awssdk.StringValue(allowedPrincipal.Principal))
+	}
+	return principals, nil
+}
+
+func (m *defaultVPCEndpointServiceManager) reconcileAllowedPrincipals(ctx context.Context, serviceID string, desiredPrincipals []string, currentPrincipals []string) error {
+	desired := sets.NewString(desiredPrincipals...)
+	current := sets.NewString(currentPrincipals...)
+	if desired.Equal(current) {
+		return nil
+	}
+	req := &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:               awssdk.String(serviceID),
+		AddAllowedPrincipals:    buildSDKStringSetIfNotEmpty(desired.Difference(current)),
+		RemoveAllowedPrincipals: buildSDKStringSetIfNotEmpty(current.Difference(desired)),
+	}
+	changeDesc := fmt.Sprintf("%v => %v", current.List(), desired.List())
+	m.logger.Info("modifying vpcEndpointService allowedPrincipals",
+		"serviceID", serviceID,
+		"change", changeDesc)
+	if _, err := m.ec2Client.ModifyVpcEndpointServicePermissionsWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified vpcEndpointService allowedPrincipals",
+		"serviceID", serviceID)
+	return nil
+}
+
+// rejectEndpointConnections rejects endpoint connections to the endpoint service, which is required before deletion.
+func (m *defaultVPCEndpointServiceManager) rejectEndpointConnections(ctx context.Context, serviceID string) error {
+	connections, err := m.ec2Client.DescribeVpcEndpointConnectionsAsList(ctx, &ec2sdk.DescribeVpcEndpointConnectionsInput{
+		Filters: []*ec2sdk.Filter{
+			{
+				Name:   awssdk.String("service-id"),
+				Values: awssdk.StringSlice([]string{serviceID}),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	var vpcEndpointIDs []string
+	for _, connection := range connections {
+		switch awssdk.StringValue(connection.VpcEndpointState) {
+		case ec2sdk.StateAvailable, ec2sdk.StatePendingAcceptance:
+			vpcEndpointIDs = append(vpcEndpointIDs, awssdk.StringValue(connection.VpcEndpointId))
+		}
+	}
+	if len(vpcEndpointIDs) == 0 {
+		return nil
+	}
+	m.logger.Info("rejecting vpcEndpoint connections",
+		"serviceID", serviceID,
+		"vpcEndpointIDs", vpcEndpointIDs)
+	if _, err := m.ec2Client.RejectVpcEndpointConnectionsWithContext(ctx, &ec2sdk.RejectVpcEndpointConnectionsInput{
+		ServiceId:      awssdk.String(serviceID),
+		VpcEndpointIds: awssdk.StringSlice(vpcEndpointIDs),
+	}); err != nil {
+		return err
+	}
+	m.logger.Info("rejected vpcEndpoint connections",
+		"serviceID", serviceID)
+	return nil
+}
+
+func resolveNetworkLoadBalancerARNs(ctx context.Context, resVPCES *ec2model.VPCEndpointService) ([]string, error) {
+	nlbARNs := make([]string, 0, len(resVPCES.Spec.NetworkLoadBalancerARNs))
+	for _, nlbARNToken := range resVPCES.Spec.NetworkLoadBalancerARNs {
+		nlbARN, err := nlbARNToken.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		nlbARNs = append(nlbARNs, nlbARN)
+	}
+	return nlbARNs, nil
+}
+
+// buildSDKStringSetIfNotEmpty converts string set into AWS SDK presentation, or nil if it's empty.
+func buildSDKStringSetIfNotEmpty(values sets.String) []*string {
+	if values.Len() == 0 {
+		return nil
+	}
+	return awssdk.StringSlice(values.List())
+}
+
+func buildResVPCEndpointServiceStatus(sdkConfiguration *ec2sdk.ServiceConfiguration) ec2model.VPCEndpointServiceStatus {
+	status := ec2model.VPCEndpointServiceStatus{
+		ServiceID:   awssdk.StringValue(sdkConfiguration.ServiceId),
+		ServiceName: awssdk.StringValue(sdkConfiguration.ServiceName),
+	}
+	if dnsConfig := sdkConfiguration.PrivateDnsNameConfiguration; dnsConfig != nil && dnsConfig.Name != nil {
+		status.PrivateDNSNameVerification = &ec2model.PrivateDNSNameVerification{
+			Name:  awssdk.StringValue(dnsConfig.Name),
+			Type:  awssdk.StringValue(dnsConfig.Type),
+			Value: awssdk.StringValue(dnsConfig.Value),
+			State: awssdk.StringValue(dnsConfig.State),
+		}
+	}
+	return status
+}
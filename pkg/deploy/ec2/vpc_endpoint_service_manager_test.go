@@ -0,0 +1,221 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultVPCEndpointServiceManager_Delete(t *testing.T) {
+	type describeVpcEndpointConnectionsAsListCall struct {
+		resp []*ec2sdk.VpcEndpointConnection
+		err  error
+	}
+	type rejectVpcEndpointConnectionsWithContextCall struct {
+		req *ec2sdk.RejectVpcEndpointConnectionsInput
+		err error
+	}
+	type deleteVpcEndpointServiceConfigurationsWithContextCall struct {
+		resp *ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput
+		err  error
+	}
+	type fields struct {
+		describeVpcEndpointConnectionsAsListCalls              []describeVpcEndpointConnectionsAsListCall
+		rejectVpcEndpointConnectionsWithContextCalls           []rejectVpcEndpointConnectionsWithContextCall
+		deleteVpcEndpointServiceConfigurationsWithContextCalls []deleteVpcEndpointServiceConfigurationsWithContextCall
+	}
+	sdkVPCES := VPCEndpointServiceWithTags{
+		ServiceConfiguration: &ec2sdk.ServiceConfiguration{
+			ServiceId: awssdk.String("vpce-svc-a"),
+		},
+		Tags: map[string]string{
+			"service.k8s.aws/resource": "VPCEndpointService",
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "delete vpcEndpointService without connections",
+			fields: fields{
+				describeVpcEndpointConnectionsAsListCalls: []describeVpcEndpointConnectionsAsListCall{
+					{},
+				},
+				deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+					{
+						resp: &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{},
+					},
+				},
+			},
+		},
+		{
+			name: "reject active connections before deleting vpcEndpointService",
+			fields: fields{
+				describeVpcEndpointConnectionsAsListCalls: []describeVpcEndpointConnectionsAsListCall{
+					{
+						resp: []*ec2sdk.VpcEndpointConnection{
+							{
+								VpcEndpointId:    awssdk.String("vpce-a"),
+								VpcEndpointState: awssdk.String(ec2sdk.StateAvailable),
+							},
+							{
+								VpcEndpointId:    awssdk.String("vpce-b"),
+								VpcEndpointState: awssdk.String(ec2sdk.StateRejected),
+							},
+							{
+								VpcEndpointId:    awssdk.String("vpce-c"),
+								VpcEndpointState: awssdk.String(ec2sdk.StatePendingAcceptance),
+							},
+						},
+					},
+				},
+				rejectVpcEndpointConnectionsWithContextCalls: []rejectVpcEndpointConnectionsWithContextCall{
+					{
+						req: &ec2sdk.RejectVpcEndpointConnectionsInput{
+							ServiceId:      awssdk.String("vpce-svc-a"),
+							VpcEndpointIds: awssdk.StringSlice([]string{"vpce-a", "vpce-c"}),
+						},
+					},
+				},
+				deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+					{
+						resp: &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{},
+					},
+				},
+			},
+		},
+		{
+			name: "vpcEndpointService deletion unsuccessful",
+			fields: fields{
+				describeVpcEndpointConnectionsAsListCalls: []describeVpcEndpointConnectionsAsListCall{
+					{},
+				},
+				deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+					{
+						resp: &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{
+							Unsuccessful: []*ec2sdk.UnsuccessfulItem{
+								{
+									ResourceId: awssdk.String("vpce-svc-a"),
+									Error: &ec2sdk.UnsuccessfulItemError{
+										Code:    awssdk.String("ExistingVpcEndpointConnections"),
+										Message: awssdk.String("some message"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed to delete vpcEndpointService vpce-svc-a: some message"),
+		},
+		{
+			name: "failed to delete vpcEndpointService",
+			fields: fields{
+				describeVpcEndpointConnectionsAsListCalls: []describeVpcEndpointConnectionsAsListCall{
+					{},
+				},
+				deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+					{
+						err: errors.New("some error"),
+					},
+				},
+			},
+			wantErr: errors.New("failed to delete vpcEndpointService: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.describeVpcEndpointConnectionsAsListCalls {
+				ec2Client.EXPECT().DescribeVpcEndpointConnectionsAsList(gomock.Any(), &ec2sdk.DescribeVpcEndpointConnectionsInput{
+					Filters: []*ec2sdk.Filter{
+						{
+							Name:   awssdk.String("service-id"),
+							Values: awssdk.StringSlice([]string{"vpce-svc-a"}),
+						},
+					},
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.rejectVpcEndpointConnectionsWithContextCalls {
+				ec2Client.EXPECT().RejectVpcEndpointConnectionsWithContext(gomock.Any(), call.req).Return(&ec2sdk.RejectVpcEndpointConnectionsOutput{}, call.err)
+			}
+			for _, call := range tt.fields.deleteVpcEndpointServiceConfigurationsWithContextCalls {
+				ec2Client.EXPECT().DeleteVpcEndpointServiceConfigurationsWithContext(gomock.Any(), &ec2sdk.DeleteVpcEndpointServiceConfigurationsInput{
+					ServiceIds: awssdk.StringSlice([]string{"vpce-svc-a"}),
+				}).Return(call.resp, call.err)
+			}
+			m := &defaultVPCEndpointServiceManager{
+				ec2Client: ec2Client,
+				logger:    log.Log,
+			}
+			err := m.Delete(context.Background(), sdkVPCES)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_buildResVPCEndpointServiceStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		sdkConfiguration *ec2sdk.ServiceConfiguration
+		want             ec2model.VPCEndpointServiceStatus
+	}{
+		{
+			name: "without private DNS name",
+			sdkConfiguration: &ec2sdk.ServiceConfiguration{
+				ServiceId:   awssdk.String("vpce-svc-a"),
+				ServiceName: awssdk.String("com.amazonaws.vpce.us-west-2.vpce-svc-a"),
+			},
+			want: ec2model.VPCEndpointServiceStatus{
+				ServiceID:   "vpce-svc-a",
+				ServiceName: "com.amazonaws.vpce.us-west-2.vpce-svc-a",
+			},
+		},
+		{
+			name: "with private DNS name",
+			sdkConfiguration: &ec2sdk.ServiceConfiguration{
+				ServiceId:   awssdk.String("vpce-svc-a"),
+				ServiceName: awssdk.String("com.amazonaws.vpce.us-west-2.vpce-svc-a"),
+				PrivateDnsNameConfiguration: &ec2sdk.PrivateDnsNameConfiguration{
+					Name:  awssdk.String("_abcdef"),
+					Type:  awssdk.String("TXT"),
+					Value: awssdk.String("vpce:abcdef"),
+					State: awssdk.String("pendingVerification"),
+				},
+			},
+			want: ec2model.VPCEndpointServiceStatus{
+				ServiceID:   "vpce-svc-a",
+				ServiceName: "com.amazonaws.vpce.us-west-2.vpce-svc-a",
+				PrivateDNSNameVerification: &ec2model.PrivateDNSNameVerification{
+					Name:  "_abcdef",
+					Type:  "TXT",
+					Value: "vpce:abcdef",
+					State: "pendingVerification",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildResVPCEndpointServiceStatus(tt.sdkConfiguration)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
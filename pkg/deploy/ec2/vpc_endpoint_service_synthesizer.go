@@ -0,0 +1,233 @@
+package ec2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+// UnmatchedLoadBalancerFinder finds the LoadBalancers of stack that will be deleted by LoadBalancer synthesizer.
+type UnmatchedLoadBalancerFinder interface {
+	FindUnmatchedLoadBalancerARNs(ctx context.Context) ([]string, error)
+}
+
+// NewVPCEndpointServiceSynthesizer constructs new vpcEndpointServiceSynthesizer.
+func NewVPCEndpointServiceSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	vpcesManager VPCEndpointServiceManager, unmatchedLBFinder UnmatchedLoadBalancerFinder, logger logr.Logger, stack core.Stack) *vpcEndpointServiceSynthesizer {
+	return &vpcEndpointServiceSynthesizer{
+		trackingProvider:  trackingProvider,
+		taggingManager:    taggingManager,
+		vpcesManager:      vpcesManager,
+		unmatchedLBFinder: unmatchedLBFinder,
+		logger:            logger,
+		stack:             stack,
+	}
+}
+
+type vpcEndpointServiceSynthesizer struct {
+	trackingProvider  tracking.Provider
+	taggingManager    TaggingManager
+	vpcesManager      VPCEndpointServiceManager
+	unmatchedLBFinder UnmatchedLoadBalancerFinder
+	logger            logr.Logger
+
+	stack                  core.Stack
+	matchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+	unmatchedResVPCESs     []*ec2model.VPCEndpointService
+}
+
+// Synthesize deletes unmatched VPC endpoint services, and detaches LoadBalancers that will be deleted from matched ones.
+// This synthesizer is expected to run before LoadBalancer synthesizer, since a LoadBalancer cannot be deleted while it's used by VPC endpoint service.
+func (s *vpcEndpointServiceSynthesizer) Synthesize(ctx context.Context) error {
+	var resVPCESs []*ec2model.VPCEndpointService
+	s.stack.ListResources(&resVPCESs)
+	sdkVPCESs, err := s.findSDKVPCEndpointServices(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKVPCESs, unmatchedResVPCESs, unmatchedSDKVPCESs, err := matchResAndSDKVPCEndpointServices(resVPCESs, sdkVPCESs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+	for _, sdkVPCES := range unmatchedSDKVPCESs {
+		if err := s.vpcesManager.Delete(ctx, sdkVPCES); err != nil {
+			return err
+		}
+	}
+	if len(unmatchedResVPCESs) != 0 {
+		retainedSDKVPCESs, err := s.findRetainedSDKVPCEndpointServices(ctx)
+		if err != nil {
+			return err
+		}
+		// retained vpcEndpointServices are reused if they match, otherwise they are kept intact.
+		matchedResAndRetainedSDKVPCESs, stillUnmatchedResVPCESs, _, err := matchResAndSDKVPCEndpointServices(unmatchedResVPCESs, retainedSDKVPCESs, s.trackingProvider.ResourceIDTagKey())
+		if err != nil {
+			return err
+		}
+		matchedResAndSDKVPCESs = append(matchedResAndSDKVPCESs, matchedResAndRetainedSDKVPCESs...)
+		unmatchedResVPCESs = stillUnmatchedResVPCESs
+	}
+	matchedResAndSDKVPCESs, recreatingResVPCESs, err := s.detachUnmatchedLoadBalancers(ctx, matchedResAndSDKVPCESs)
+	if err != nil {
+		return err
+	}
+	unmatchedResVPCESs = append(unmatchedResVPCESs, recreatingResVPCESs...)
+	s.matchedResAndSDKVPCESs = matchedResAndSDKVPCESs
+	s.unmatchedResVPCESs = unmatchedResVPCESs
+	return nil
+}
+
+// PostSynthesize creates or updates VPC endpoint services, after the LoadBalancers they use are synthesized.
+func (s *vpcEndpointServiceSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, resVPCES := range s.unmatchedResVPCESs {
+		vpcesStatus, err := s.vpcesManager.Create(ctx, resVPCES)
+		if err != nil {
+			return err
+		}
+		resVPCES.SetStatus(vpcesStatus)
+	}
+	for _, resAndSDKVPCES := range s.matchedResAndSDKVPCESs {
+		vpcesStatus, err := s.vpcesManager.Update(ctx, resAndSDKVPCES.resVPCES, resAndSDKVPCES.sdkVPCES)
+		if err != nil {
+			return err
+		}
+		resAndSDKVPCES.resVPCES.SetStatus(vpcesStatus)
+	}
+	return nil
+}
+
+// detachUnmatchedLoadBalancers detaches the LoadBalancers that will be deleted by LoadBalancer synthesizer from matched VPC endpoint services,
+// e.g. when a LoadBalancer requires replacement, so that they can be deleted.
+// VPC endpoint services that would be left without any LoadBalancer are deleted instead, and returned to be recreated in PostSynthesize.
+// Since recreation rejects the existing endpoint connections and changes the service name, it fails unless explicitly allowed.
+func (s *vpcEndpointServiceSynthesizer) detachUnmatchedLoadBalancers(ctx context.Context, matchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair) ([]resAndSDKVPCEndpointServicePair, []*ec2model.VPCEndpointService, error) {
+	if len(matchedResAndSDKVPCESs) == 0 {
+		return nil, nil, nil
+	}
+	unmatchedLBARNs, err := s.unmatchedLBFinder.FindUnmatchedLoadBalancerARNs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	stillMatchedResAndSDKVPCESs, detachments, recreatingResAndSDKVPCESs := classifyResAndSDKVPCEndpointServicesByUnmatchedLoadBalancers(matchedResAndSDKVPCESs, unmatchedLBARNs)
+	for _, resAndSDKVPCES := range recreatingResAndSDKVPCESs {
+		if !resAndSDKVPCES.resVPCES.Spec.AllowRecreation {
+			return nil, nil, errors.Errorf("vpcEndpointService %v must be recreated since all of its loadBalancers %v will be replaced, which rejects its endpoint connections and changes its service name, but recreation isn't allowed",
+				awssdk.StringValue(resAndSDKVPCES.sdkVPCES.ServiceConfiguration.ServiceId),
+				awssdk.StringValueSlice(resAndSDKVPCES.sdkVPCES.ServiceConfiguration.NetworkLoadBalancerArns))
+		}
+	}
+	for _, detachment := range detachments {
+		if err := s.vpcesManager.DetachLoadBalancers(ctx, detachment.resAndSDKVPCES.sdkVPCES, detachment.lbARNs); err != nil {
+			return nil, nil, err
+		}
+	}
+	var recreatingResVPCESs []*ec2model.VPCEndpointService
+	for _, resAndSDKVPCES := range recreatingResAndSDKVPCESs {
+		if err := s.vpcesManager.Delete(ctx, resAndSDKVPCES.sdkVPCES); err != nil {
+			return nil, nil, err
+		}
+		recreatingResVPCESs = append(recreatingResVPCESs, resAndSDKVPCES.resVPCES)
+	}
+	return stillMatchedResAndSDKVPCESs, recreatingResVPCESs, nil
+}
+
+// findSDKVPCEndpointServices will find all VPC endpoint services created for stack.
+func (s *vpcEndpointServiceSynthesizer) findSDKVPCEndpointServices(ctx context.Context) ([]VPCEndpointServiceWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListVPCEndpointServices(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKVPCEndpointServicePair struct {
+	resVPCES *ec2model.VPCEndpointService
+	sdkVPCES VPCEndpointServiceWithTags
+}
+
+// findRetainedSDKVPCEndpointServices will find all AWS VPCEndpointServices retained for stack.
+func (s *vpcEndpointServiceSynthesizer) findRetainedSDKVPCEndpointServices(ctx context.Context) ([]VPCEndpointServiceWithTags, error) {
+	retainedStackTags := s.trackingProvider.RetainedStackTags(s.stack)
+	return s.taggingManager.ListVPCEndpointServices(ctx, tracking.TagsAsTagFilter(retainedStackTags))
+}
+
+func matchResAndSDKVPCEndpointServices(resVPCESs []*ec2model.VPCEndpointService, sdkVPCESs []VPCEndpointServiceWithTags,
+	resourceIDTagKey string) ([]resAndSDKVPCEndpointServicePair, []*ec2model.VPCEndpointService, []VPCEndpointServiceWithTags, error) {
+	var matchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+	var unmatchedResVPCESs []*ec2model.VPCEndpointService
+	var unmatchedSDKVPCESs []VPCEndpointServiceWithTags
+
+	resVPCESsByID := make(map[string]*ec2model.VPCEndpointService, len(resVPCESs))
+	for _, resVPCES := range resVPCESs {
+		resVPCESsByID[resVPCES.ID()] = resVPCES
+	}
+	sdkVPCESsByID := make(map[string][]VPCEndpointServiceWithTags, len(sdkVPCESs))
+	for _, sdkVPCES := range sdkVPCESs {
+		resourceID, ok := sdkVPCES.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected vpcEndpointService with no resourceID: %v", awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId))
+		}
+		sdkVPCESsByID[resourceID] = append(sdkVPCESsByID[resourceID], sdkVPCES)
+	}
+
+	resVPCESIDs := sets.StringKeySet(resVPCESsByID)
+	sdkVPCESIDs := sets.StringKeySet(sdkVPCESsByID)
+	for _, resID := range resVPCESIDs.Intersection(sdkVPCESIDs).List() {
+		resVPCES := resVPCESsByID[resID]
+		sdkVPCESs := sdkVPCESsByID[resID]
+		matchedResAndSDKVPCESs = append(matchedResAndSDKVPCESs, resAndSDKVPCEndpointServicePair{
+			resVPCES: resVPCES,
+			sdkVPCES: sdkVPCESs[0],
+		})
+		unmatchedSDKVPCESs = append(unmatchedSDKVPCESs, sdkVPCESs[1:]...)
+	}
+	for _, resID := range resVPCESIDs.Difference(sdkVPCESIDs).List() {
+		unmatchedResVPCESs = append(unmatchedResVPCESs, resVPCESsByID[resID])
+	}
+	for _, resID := range sdkVPCESIDs.Difference(resVPCESIDs).List() {
+		unmatchedSDKVPCESs = append(unmatchedSDKVPCESs, sdkVPCESsByID[resID]...)
+	}
+	return matchedResAndSDKVPCESs, unmatchedResVPCESs, unmatchedSDKVPCESs, nil
+}
+
+type vpcEndpointServiceLoadBalancerDetachment struct {
+	resAndSDKVPCES resAndSDKVPCEndpointServicePair
+	lbARNs         []string
+}
+
+// classifyResAndSDKVPCEndpointServicesByUnmatchedLoadBalancers classifies matched VPC endpoint services by the unmatched LoadBalancers they use into:
+//   - VPC endpoint services that remain matched, including those with some LoadBalancers to detach.
+//   - the LoadBalancers to detach from VPC endpoint services.
+//   - VPC endpoint services to recreate, since all of their LoadBalancers are unmatched.
+//
+// the sdkVPCES of VPC endpoint services with LoadBalancers to detach is updated to exclude the detached LoadBalancers.
+func classifyResAndSDKVPCEndpointServicesByUnmatchedLoadBalancers(matchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair, unmatchedLBARNs []string) (
+	[]resAndSDKVPCEndpointServicePair, []vpcEndpointServiceLoadBalancerDetachment, []resAndSDKVPCEndpointServicePair) {
+	var stillMatchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+	var detachments []vpcEndpointServiceLoadBalancerDetachment
+	var recreatingResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+
+	unmatchedLBARNSet := sets.NewString(unmatchedLBARNs...)
+	for _, resAndSDKVPCES := range matchedResAndSDKVPCESs {
+		currentLBARNs := sets.NewString(awssdk.StringValueSlice(resAndSDKVPCES.sdkVPCES.ServiceConfiguration.NetworkLoadBalancerArns)...)
+		detachingLBARNs := currentLBARNs.Intersection(unmatchedLBARNSet)
+		if detachingLBARNs.Len() == 0 {
+			stillMatchedResAndSDKVPCESs = append(stillMatchedResAndSDKVPCESs, resAndSDKVPCES)
+			continue
+		}
+		// a VPC endpoint service requires at least one LoadBalancer.
+		if detachingLBARNs.Equal(currentLBARNs) {
+			recreatingResAndSDKVPCESs = append(recreatingResAndSDKVPCESs, resAndSDKVPCES)
+			continue
+		}
+		detachments = append(detachments, vpcEndpointServiceLoadBalancerDetachment{
+			resAndSDKVPCES: resAndSDKVPCES,
+			lbARNs:         detachingLBARNs.List(),
+		})
+		resAndSDKVPCES.sdkVPCES.ServiceConfiguration.NetworkLoadBalancerArns = awssdk.StringSlice(currentLBARNs.Difference(detachingLBARNs).List())
+		stillMatchedResAndSDKVPCESs = append(stillMatchedResAndSDKVPCESs, resAndSDKVPCES)
+	}
+	return stillMatchedResAndSDKVPCESs, detachments, recreatingResAndSDKVPCESs
+}
@@ -0,0 +1,171 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// stubUnmatchedLoadBalancerFinder finds a static list of unmatched LoadBalancers.
+type stubUnmatchedLoadBalancerFinder struct {
+	lbARNs []string
+}
+
+func (f *stubUnmatchedLoadBalancerFinder) FindUnmatchedLoadBalancerARNs(_ context.Context) ([]string, error) {
+	return f.lbARNs, nil
+}
+
+// recordingVPCEndpointServiceManager records the VPC endpoint services deleted.
+type recordingVPCEndpointServiceManager struct {
+	VPCEndpointServiceManager
+	deletedServiceIDs []string
+}
+
+func (m *recordingVPCEndpointServiceManager) Delete(_ context.Context, sdkVPCES VPCEndpointServiceWithTags) error {
+	m.deletedServiceIDs = append(m.deletedServiceIDs, awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId))
+	return nil
+}
+
+func Test_classifyResAndSDKVPCEndpointServicesByUnmatchedLoadBalancers(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	resVPCES := &ec2model.VPCEndpointService{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", "id-1"),
+	}
+	newPair := func(lbARNs ...string) resAndSDKVPCEndpointServicePair {
+		return resAndSDKVPCEndpointServicePair{
+			resVPCES: resVPCES,
+			sdkVPCES: VPCEndpointServiceWithTags{
+				ServiceConfiguration: &ec2sdk.ServiceConfiguration{
+					ServiceId:               awssdk.String("vpce-svc-a"),
+					NetworkLoadBalancerArns: awssdk.StringSlice(lbARNs),
+				},
+			},
+		}
+	}
+	type args struct {
+		matchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+		unmatchedLBARNs        []string
+	}
+	tests := []struct {
+		name                            string
+		args                            args
+		wantStillMatchedResAndSDKVPCESs []resAndSDKVPCEndpointServicePair
+		wantDetachments                 []vpcEndpointServiceLoadBalancerDetachment
+		wantRecreatingResAndSDKVPCESs   []resAndSDKVPCEndpointServicePair
+	}{
+		{
+			name: "no unmatched loadBalancers",
+			args: args{
+				matchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+			},
+			wantStillMatchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+		},
+		{
+			name: "unmatched loadBalancers not used by vpcEndpointService",
+			args: args{
+				matchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+				unmatchedLBARNs:        []string{"lb-2"},
+			},
+			wantStillMatchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+		},
+		{
+			name: "the only loadBalancer of vpcEndpointService is replaced",
+			args: args{
+				matchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+				unmatchedLBARNs:        []string{"lb-1"},
+			},
+			wantRecreatingResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+		},
+		{
+			name: "one of the loadBalancers of vpcEndpointService is replaced",
+			args: args{
+				matchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1", "lb-2")},
+				unmatchedLBARNs:        []string{"lb-2", "lb-3"},
+			},
+			wantStillMatchedResAndSDKVPCESs: []resAndSDKVPCEndpointServicePair{newPair("lb-1")},
+			wantDetachments: []vpcEndpointServiceLoadBalancerDetachment{
+				{
+					resAndSDKVPCES: newPair("lb-1"),
+					lbARNs:         []string{"lb-2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStillMatchedResAndSDKVPCESs, gotDetachments, gotRecreatingResAndSDKVPCESs := classifyResAndSDKVPCEndpointServicesByUnmatchedLoadBalancers(tt.args.matchedResAndSDKVPCESs, tt.args.unmatchedLBARNs)
+			assert.Equal(t, tt.wantStillMatchedResAndSDKVPCESs, gotStillMatchedResAndSDKVPCESs)
+			assert.Equal(t, tt.wantDetachments, gotDetachments)
+			assert.Equal(t, tt.wantRecreatingResAndSDKVPCESs, gotRecreatingResAndSDKVPCESs)
+		})
+	}
+}
+
+func Test_vpcEndpointServiceSynthesizer_detachUnmatchedLoadBalancers(t *testing.T) {
+	tests := []struct {
+		name                  string
+		allowRecreation       bool
+		wantRecreating        bool
+		wantDeletedServiceIDs []string
+		wantErr               error
+	}{
+		{
+			name:            "recreation not allowed",
+			allowRecreation: false,
+			wantErr:         errors.New("vpcEndpointService vpce-svc-a must be recreated since all of its loadBalancers [lb-1] will be replaced, which rejects its endpoint connections and changes its service name, but recreation isn't allowed"),
+		},
+		{
+			name:                  "recreation allowed",
+			allowRecreation:       true,
+			wantRecreating:        true,
+			wantDeletedServiceIDs: []string{"vpce-svc-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+			resVPCES := &ec2model.VPCEndpointService{
+				ResourceMeta: core.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", "id-1"),
+				Spec: ec2model.VPCEndpointServiceSpec{
+					AllowRecreation: tt.allowRecreation,
+				},
+			}
+			vpcesManager := &recordingVPCEndpointServiceManager{}
+			s := &vpcEndpointServiceSynthesizer{
+				vpcesManager:      vpcesManager,
+				unmatchedLBFinder: &stubUnmatchedLoadBalancerFinder{lbARNs: []string{"lb-1"}},
+				logger:            log.Log,
+				stack:             stack,
+			}
+			matchedResAndSDKVPCESs := []resAndSDKVPCEndpointServicePair{
+				{
+					resVPCES: resVPCES,
+					sdkVPCES: VPCEndpointServiceWithTags{
+						ServiceConfiguration: &ec2sdk.ServiceConfiguration{
+							ServiceId:               awssdk.String("vpce-svc-a"),
+							NetworkLoadBalancerArns: awssdk.StringSlice([]string{"lb-1"}),
+						},
+					},
+				},
+			}
+			gotStillMatched, gotRecreating, err := s.detachUnmatchedLoadBalancers(context.Background(), matchedResAndSDKVPCESs)
+			assert.Equal(t, tt.wantDeletedServiceIDs, vpcesManager.deletedServiceIDs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, gotStillMatched)
+			if tt.wantRecreating {
+				assert.Equal(t, []*ec2model.VPCEndpointService{resVPCES}, gotRecreating)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// DriftDetector detects drift between the live state of ELBV2 resources and the stack that has been deployed.
+type DriftDetector interface {
+	// Detect returns the drifted fields of LoadBalancers, Listeners and ListenerRules in stack.
+	Detect(ctx context.Context, stack core.Stack) ([]string, error)
+}
+
+// NewDefaultDriftDetector constructs new defaultDriftDetector.
+func NewDefaultDriftDetector(elbv2Client services.ELBV2, featureGates config.FeatureGates) *defaultDriftDetector {
+	return &defaultDriftDetector{
+		elbv2Client:  elbv2Client,
+		featureGates: featureGates,
+	}
+}
+
+var _ DriftDetector = &defaultDriftDetector{}
+
+// default implementation for DriftDetector.
+// it compares resources the same way as the synthesizers and managers do, but never modifies them.
+type defaultDriftDetector struct {
+	elbv2Client  services.ELBV2
+	featureGates config.FeatureGates
+}
+
+func (d *defaultDriftDetector) Detect(ctx context.Context, stack core.Stack) ([]string, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	stack.ListResources(&resLBs)
+	var resLSs []*elbv2model.Listener
+	stack.ListResources(&resLSs)
+	var resLRs []*elbv2model.ListenerRule
+	stack.ListResources(&resLRs)
+	resLSsByLBARN, err := mapResListenerByLoadBalancerARN(resLSs)
+	if err != nil {
+		return nil, err
+	}
+	resLRsByLSARN, err := mapResListenerRuleByListenerARN(resLRs)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []string
+	for _, resLB := range resLBs {
+		if resLB.Status == nil {
+			continue
+		}
+		lbARN := resLB.Status.LoadBalancerARN
+		lbDrifts, exists, err := d.detectLoadBalancerDrift(ctx, resLB, lbARN)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, lbDrifts...)
+		if !exists {
+			continue
+		}
+		lsDrifts, err := d.detectListenersDrift(ctx, lbARN, resLSsByLBARN[lbARN], resLRsByLSARN)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, lsDrifts...)
+	}
+	return drifts, nil
+}
+
+// detectLoadBalancerDrift detects drift of LoadBalancer attributes and securityGroups, and returns whether LoadBalancer still exists.
+func (d *defaultDriftDetector) detectLoadBalancerDrift(ctx context.Context, resLB *elbv2model.LoadBalancer, lbARN string) ([]string, bool, error) {
+	sdkLBs, err := d.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+	})
+	if err != nil {
+		if isLoadBalancerNotFoundError(err) {
+			return []string{fmt.Sprintf("LoadBalancer/%v: deleted", resLB.ID())}, false, nil
+		}
+		return nil, false, err
+	}
+	if len(sdkLBs) == 0 {
+		return []string{fmt.Sprintf("LoadBalancer/%v: deleted", resLB.ID())}, false, nil
+	}
+	sdkLB := sdkLBs[0]
+
+	var drifts []string
+	desiredAttrs := make(map[string]string, len(resLB.Spec.LoadBalancerAttributes))
+	for _, attr := range resLB.Spec.LoadBalancerAttributes {
+		desiredAttrs[attr.Key] = attr.Value
+	}
+	resp, err := d.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2sdk.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: awssdk.String(lbARN),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	currentAttrs := make(map[string]string, len(resp.Attributes))
+	for _, attr := range resp.Attributes {
+		currentAttrs[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
+		drifts = append(drifts, fmt.Sprintf("LoadBalancer/%v: attribute %v", resLB.ID(), attrKey))
+	}
+
+	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	if err != nil {
+		return nil, false, err
+	}
+	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
+	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.SecurityGroups)...)
+	if !desiredSecurityGroups.Equal(currentSecurityGroups) {
+		drifts = append(drifts, fmt.Sprintf("LoadBalancer/%v: securityGroups", resLB.ID()))
+	}
+	return drifts, true, nil
+}
+
+// detectListenersDrift detects drift of Listeners and their ListenerRules on LoadBalancer.
+func (d *defaultDriftDetector) detectListenersDrift(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener,
+	resLRsByLSARN map[string][]*elbv2model.ListenerRule) ([]string, error) {
+	sdkListeners, err := d.elbv2Client.DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		LoadBalancerArn: awssdk.String(lbARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sdkLSs := make([]ListenerWithTags, 0, len(sdkListeners))
+	for _, listener := range sdkListeners {
+		sdkLSs = append(sdkLSs, ListenerWithTags{Listener: listener})
+	}
+
+	var drifts []string
+	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
+	for _, resLS := range unmatchedResLSs {
+		drifts = append(drifts, fmt.Sprintf("Listener/%v: deleted", resLS.ID()))
+	}
+	for _, sdkLS := range unmatchedSDKLSs {
+		drifts = append(drifts, fmt.Sprintf("Listener on port %v: unexpected", awssdk.Int64Value(sdkLS.Listener.Port)))
+	}
+	for _, resAndSDKLS := range matchedResAndSDKLSs {
+		resLS, sdkLS := resAndSDKLS.resLS, resAndSDKLS.sdkLS
+		desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions, d.featureGates)
+		if err != nil {
+			return nil, err
+		}
+		desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
+		desiredDefaultMutualAuthentication := buildSDKMutualAuthenticationConfig(resLS.Spec.MutualAuthentication)
+		if isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication) {
+			drifts = append(drifts, fmt.Sprintf("Listener/%v: settings", resLS.ID()))
+		}
+		lsARN := awssdk.StringValue(sdkLS.Listener.ListenerArn)
+		lrDrifts, err := d.detectListenerRulesDrift(ctx, resLS, lsARN, resLRsByLSARN[lsARN])
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, lrDrifts...)
+	}
+	return drifts, nil
+}
+
+// detectListenerRulesDrift detects drift of non-default ListenerRules on Listener.
+func (d *defaultDriftDetector) detectListenerRulesDrift(ctx context.Context, resLS *elbv2model.Listener, lsARN string,
+	resLRs []*elbv2model.ListenerRule) ([]string, error) {
+	sdkRules, err := d.elbv2Client.DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{
+		ListenerArn: awssdk.String(lsARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sdkLRs := make([]ListenerRuleWithTags, 0, len(sdkRules))
+	for _, rule := range sdkRules {
+		if awssdk.BoolValue(rule.IsDefault) {
+			continue
+		}
+		sdkLRs = append(sdkLRs, ListenerRuleWithTags{ListenerRule: rule})
+	}
+
+	var drifts []string
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
+	for _, resLR := range unmatchedResLRs {
+		drifts = append(drifts, fmt.Sprintf("ListenerRule/%v: deleted", resLR.ID()))
+	}
+	for _, sdkLR := range unmatchedSDKLRs {
+		drifts = append(drifts, fmt.Sprintf("ListenerRule with priority %v on Listener/%v: unexpected",
+			awssdk.StringValue(sdkLR.ListenerRule.Priority), resLS.ID()))
+	}
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		resLR, sdkLR := resAndSDKLR.resLR, resAndSDKLR.sdkLR
+		desiredActions, err := buildSDKActions(resLR.Spec.Actions, d.featureGates)
+		if err != nil {
+			return nil, err
+		}
+		desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+		if isSDKListenerRuleSettingsDrifted(resLR.Spec, sdkLR, desiredActions, desiredConditions) {
+			drifts = append(drifts, fmt.Sprintf("ListenerRule/%v: settings", resLR.ID()))
+		}
+	}
+	return drifts, nil
+}
+
+func isLoadBalancerNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "LoadBalancerNotFound"
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultDriftDetector_Detect(t *testing.T) {
+	sdkFixedResponseActions := []*elbv2sdk.Action{
+		{
+			Type: awssdk.String("fixed-response"),
+			FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+				StatusCode: awssdk.String("404"),
+			},
+		},
+	}
+	sdkLB := &elbv2sdk.LoadBalancer{
+		LoadBalancerArn: awssdk.String("lb-arn"),
+		SecurityGroups:  awssdk.StringSlice([]string{"sg-a"}),
+	}
+	sdkLBAttributes := []*elbv2sdk.LoadBalancerAttribute{
+		{
+			Key:   awssdk.String("idle_timeout.timeout_seconds"),
+			Value: awssdk.String("60"),
+		},
+	}
+	sdkListener := &elbv2sdk.Listener{
+		ListenerArn:     awssdk.String("ls-arn"),
+		LoadBalancerArn: awssdk.String("lb-arn"),
+		Port:            awssdk.Int64(80),
+		Protocol:        awssdk.String("HTTP"),
+		DefaultActions:  sdkFixedResponseActions,
+	}
+	sdkDefaultRule := &elbv2sdk.Rule{
+		RuleArn:   awssdk.String("default-rule-arn"),
+		Priority:  awssdk.String("default"),
+		IsDefault: awssdk.Bool(true),
+	}
+	sdkRule := &elbv2sdk.Rule{
+		RuleArn:  awssdk.String("rule-arn"),
+		Priority: awssdk.String("1"),
+		Actions:  sdkFixedResponseActions,
+		Conditions: []*elbv2sdk.RuleCondition{
+			{
+				Field: awssdk.String("path-pattern"),
+				PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+					Values: awssdk.StringSlice([]string{"/foo"}),
+				},
+			},
+		},
+	}
+
+	type describeLoadBalancersAsListCall struct {
+		resp []*elbv2sdk.LoadBalancer
+		err  error
+	}
+	type fields struct {
+		describeLoadBalancersAsListCall  describeLoadBalancersAsListCall
+		describeLoadBalancerAttributes   []*elbv2sdk.LoadBalancerAttribute
+		describeListenersAsList          []*elbv2sdk.Listener
+		describeRulesAsList              []*elbv2sdk.Rule
+		expectLoadBalancerAttributesCall bool
+		expectListenersCall              bool
+		expectRulesCall                  bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "no drift",
+			fields: fields{
+				describeLoadBalancersAsListCall:  describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{sdkLB}},
+				describeLoadBalancerAttributes:   sdkLBAttributes,
+				describeListenersAsList:          []*elbv2sdk.Listener{sdkListener},
+				describeRulesAsList:              []*elbv2sdk.Rule{sdkDefaultRule, sdkRule},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+				expectRulesCall:                  true,
+			},
+		},
+		{
+			name: "loadBalancer deleted",
+			fields: fields{
+				describeLoadBalancersAsListCall: describeLoadBalancersAsListCall{
+					err: awserr.New("LoadBalancerNotFound", "some message", nil),
+				},
+			},
+			want: []string{"LoadBalancer/LoadBalancer: deleted"},
+		},
+		{
+			name: "loadBalancer attribute and securityGroups drifted",
+			fields: fields{
+				describeLoadBalancersAsListCall: describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{
+					{
+						LoadBalancerArn: awssdk.String("lb-arn"),
+						SecurityGroups:  awssdk.StringSlice([]string{"sg-a", "sg-b"}),
+					},
+				}},
+				describeLoadBalancerAttributes: []*elbv2sdk.LoadBalancerAttribute{
+					{
+						Key:   awssdk.String("idle_timeout.timeout_seconds"),
+						Value: awssdk.String("120"),
+					},
+				},
+				describeListenersAsList:          []*elbv2sdk.Listener{sdkListener},
+				describeRulesAsList:              []*elbv2sdk.Rule{sdkDefaultRule, sdkRule},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+				expectRulesCall:                  true,
+			},
+			want: []string{
+				"LoadBalancer/LoadBalancer: attribute idle_timeout.timeout_seconds",
+				"LoadBalancer/LoadBalancer: securityGroups",
+			},
+		},
+		{
+			name: "listener deleted and unexpected listener added",
+			fields: fields{
+				describeLoadBalancersAsListCall: describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{sdkLB}},
+				describeLoadBalancerAttributes:  sdkLBAttributes,
+				describeListenersAsList: []*elbv2sdk.Listener{
+					{
+						ListenerArn:     awssdk.String("other-ls-arn"),
+						LoadBalancerArn: awssdk.String("lb-arn"),
+						Port:            awssdk.Int64(8080),
+						Protocol:        awssdk.String("HTTP"),
+						DefaultActions:  sdkFixedResponseActions,
+					},
+				},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+			},
+			want: []string{
+				"Listener/80: deleted",
+				"Listener on port 8080: unexpected",
+			},
+		},
+		{
+			name: "listener settings drifted",
+			fields: fields{
+				describeLoadBalancersAsListCall: describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{sdkLB}},
+				describeLoadBalancerAttributes:  sdkLBAttributes,
+				describeListenersAsList: []*elbv2sdk.Listener{
+					{
+						ListenerArn:     awssdk.String("ls-arn"),
+						LoadBalancerArn: awssdk.String("lb-arn"),
+						Port:            awssdk.Int64(80),
+						Protocol:        awssdk.String("HTTP"),
+						DefaultActions: []*elbv2sdk.Action{
+							{
+								Type: awssdk.String("fixed-response"),
+								FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+									StatusCode: awssdk.String("503"),
+								},
+							},
+						},
+					},
+				},
+				describeRulesAsList:              []*elbv2sdk.Rule{sdkDefaultRule, sdkRule},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+				expectRulesCall:                  true,
+			},
+			want: []string{"Listener/80: settings"},
+		},
+		{
+			name: "listener rule deleted",
+			fields: fields{
+				describeLoadBalancersAsListCall:  describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{sdkLB}},
+				describeLoadBalancerAttributes:   sdkLBAttributes,
+				describeListenersAsList:          []*elbv2sdk.Listener{sdkListener},
+				describeRulesAsList:              []*elbv2sdk.Rule{sdkDefaultRule},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+				expectRulesCall:                  true,
+			},
+			want: []string{"ListenerRule/80:1: deleted"},
+		},
+		{
+			name: "listener rule settings drifted and unexpected listener rule added",
+			fields: fields{
+				describeLoadBalancersAsListCall: describeLoadBalancersAsListCall{resp: []*elbv2sdk.LoadBalancer{sdkLB}},
+				describeLoadBalancerAttributes:  sdkLBAttributes,
+				describeListenersAsList:         []*elbv2sdk.Listener{sdkListener},
+				describeRulesAsList: []*elbv2sdk.Rule{
+					sdkDefaultRule,
+					{
+						RuleArn:  awssdk.String("rule-arn"),
+						Priority: awssdk.String("1"),
+						Actions:  sdkFixedResponseActions,
+						Conditions: []*elbv2sdk.RuleCondition{
+							{
+								Field: awssdk.String("path-pattern"),
+								PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+									Values: awssdk.StringSlice([]string{"/bar"}),
+								},
+							},
+						},
+					},
+					{
+						RuleArn:  awssdk.String("other-rule-arn"),
+						Priority: awssdk.String("2"),
+						Actions:  sdkFixedResponseActions,
+					},
+				},
+				expectLoadBalancerAttributesCall: true,
+				expectListenersCall:              true,
+				expectRulesCall:                  true,
+			},
+			want: []string{
+				"ListenerRule with priority 2 on Listener/80: unexpected",
+				"ListenerRule/80:1: settings",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2sdk.DescribeLoadBalancersInput{
+				LoadBalancerArns: awssdk.StringSlice([]string{"lb-arn"}),
+			}).Return(tt.fields.describeLoadBalancersAsListCall.resp, tt.fields.describeLoadBalancersAsListCall.err)
+			if tt.fields.expectLoadBalancerAttributesCall {
+				elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeLoadBalancerAttributesInput{
+					LoadBalancerArn: awssdk.String("lb-arn"),
+				}).Return(&elbv2sdk.DescribeLoadBalancerAttributesOutput{Attributes: tt.fields.describeLoadBalancerAttributes}, nil)
+			}
+			if tt.fields.expectListenersCall {
+				elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), &elbv2sdk.DescribeListenersInput{
+					LoadBalancerArn: awssdk.String("lb-arn"),
+				}).Return(tt.fields.describeListenersAsList, nil)
+			}
+			if tt.fields.expectRulesCall {
+				elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2sdk.DescribeRulesInput{
+					ListenerArn: awssdk.String("ls-arn"),
+				}).Return(tt.fields.describeRulesAsList, nil)
+			}
+
+			stack := buildDriftDetectorTestStack()
+			d := NewDefaultDriftDetector(elbv2Client, config.NewFeatureGates())
+			got, err := d.Detect(context.Background(), stack)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// buildDriftDetectorTestStack builds a deployed stack with one LoadBalancer, Listener and ListenerRule.
+func buildDriftDetectorTestStack() core.Stack {
+	fixedResponseActions := []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeFixedResponse,
+			FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+				StatusCode: "404",
+			},
+		},
+	}
+	stack := core.NewDefaultStack(core.StackID{Name: "my-group"})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		SecurityGroups: []core.StringToken{core.LiteralStringToken("sg-a")},
+		LoadBalancerAttributes: []elbv2model.LoadBalancerAttribute{
+			{
+				Key:   "idle_timeout.timeout_seconds",
+				Value: "60",
+			},
+		},
+	})
+	lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn"})
+	ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+		LoadBalancerARN: lb.LoadBalancerARN(),
+		Port:            80,
+		Protocol:        elbv2model.ProtocolHTTP,
+		DefaultActions:  fixedResponseActions,
+	})
+	ls.SetStatus(elbv2model.ListenerStatus{ListenerARN: "ls-arn"})
+	lr := elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+		ListenerARN: ls.ListenerARN(),
+		Priority:    1,
+		Actions:     fixedResponseActions,
+		Conditions: []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldPathPattern,
+				PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+					Values: []string{"/foo"},
+				},
+			},
+		},
+	})
+	lr.SetStatus(elbv2model.ListenerRuleStatus{RuleARN: "rule-arn"})
+	return stack
+}
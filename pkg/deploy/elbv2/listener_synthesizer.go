@@ -4,28 +4,32 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
-func NewListenerSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
+func NewListenerSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
 	lsManager ListenerManager, logger logr.Logger, stack core.Stack) *listenerSynthesizer {
 	return &listenerSynthesizer{
-		elbv2Client:    elbv2Client,
-		lsManager:      lsManager,
-		logger:         logger,
-		taggingManager: taggingManager,
-		stack:          stack,
+		elbv2Client:      elbv2Client,
+		trackingProvider: trackingProvider,
+		lsManager:        lsManager,
+		logger:           logger,
+		taggingManager:   taggingManager,
+		stack:            stack,
 	}
 }
 
 type listenerSynthesizer struct {
-	elbv2Client    services.ELBV2
-	lsManager      ListenerManager
-	logger         logr.Logger
-	taggingManager TaggingManager
+	elbv2Client      services.ELBV2
+	trackingProvider tracking.Provider
+	lsManager        ListenerManager
+	logger           logr.Logger
+	taggingManager   TaggingManager
 
 	stack core.Stack
 }
@@ -37,9 +41,13 @@ func (s *listenerSynthesizer) Synthesize(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	adoptionByLBARN, err := s.mapLoadBalancerAdoptionByLoadBalancerARN(ctx)
+	if err != nil {
+		return err
+	}
 
 	for lbARN, resLSs := range resLSsByLBARN {
-		if err := s.synthesizeListenersOnLB(ctx, lbARN, resLSs); err != nil {
+		if err := s.synthesizeListenersOnLB(ctx, lbARN, resLSs, adoptionByLBARN[lbARN]); err != nil {
 			return err
 		}
 	}
@@ -51,11 +59,17 @@ func (s *listenerSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener) error {
+func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener, adoption *elbv2model.LoadBalancerAdoption) error {
 	sdkLSs, err := s.findSDKListenersOnLB(ctx, lbARN)
 	if err != nil {
 		return err
 	}
+	if adoption != nil && adoption.UnmanagedListeners {
+		sdkLSs, err = s.filterUnmanagedSDKListeners(lbARN, resLSs, sdkLSs, adoption)
+		if err != nil {
+			return err
+		}
+	}
 	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
 	for _, sdkLS := range unmatchedSDKLSs {
 		if err := s.lsManager.Delete(ctx, sdkLS); err != nil {
@@ -84,6 +98,46 @@ func (s *listenerSynthesizer) findSDKListenersOnLB(ctx context.Context, lbARN st
 	return s.taggingManager.ListListeners(ctx, lbARN)
 }
 
+// filterUnmanagedSDKListeners filters out listeners on an adopted LoadBalancer that are neither created by us nor claimed.
+// these listeners are left untouched, so a listener cannot be desired on the port of an unmanaged listener.
+func (s *listenerSynthesizer) filterUnmanagedSDKListeners(lbARN string, resLSs []*elbv2model.Listener, sdkLSs []ListenerWithTags,
+	adoption *elbv2model.LoadBalancerAdoption) ([]ListenerWithTags, error) {
+	stackTagFilter := tracking.TagsAsTagFilter(s.trackingProvider.StackTags(s.stack))
+	claimedPorts := sets.NewInt64(adoption.ClaimedListenerPorts...)
+	resLSPorts := sets.Int64KeySet(mapResListenerByPort(resLSs))
+	var managedSDKLSs []ListenerWithTags
+	for _, sdkLS := range sdkLSs {
+		port := awssdk.Int64Value(sdkLS.Listener.Port)
+		if stackTagFilter.Matches(sdkLS.Tags) || claimedPorts.Has(port) {
+			managedSDKLSs = append(managedSDKLSs, sdkLS)
+			continue
+		}
+		if resLSPorts.Has(port) {
+			return nil, errors.Errorf("listener on port %v of adopted loadBalancer %v is unmanaged, it must be claimed to be managed",
+				port, lbARN)
+		}
+	}
+	return managedSDKLSs, nil
+}
+
+// mapLoadBalancerAdoptionByLoadBalancerARN returns the adoption of LoadBalancers in stack, indexed by LoadBalancer ARN.
+func (s *listenerSynthesizer) mapLoadBalancerAdoptionByLoadBalancerARN(ctx context.Context) (map[string]*elbv2model.LoadBalancerAdoption, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	adoptionByLBARN := make(map[string]*elbv2model.LoadBalancerAdoption, len(resLBs))
+	for _, resLB := range resLBs {
+		if resLB.Spec.Adoption == nil {
+			continue
+		}
+		lbARN, err := resLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		adoptionByLBARN[lbARN] = resLB.Spec.Adoption
+	}
+	return adoptionByLBARN, nil
+}
+
 type resAndSDKListenerPair struct {
 	resLS *elbv2model.Listener
 	sdkLS ListenerWithTags
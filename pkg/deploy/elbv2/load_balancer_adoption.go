@@ -0,0 +1,43 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// ValidateLoadBalancerAdoption checks whether an existing LoadBalancer can be adopted for the stack identified by stackTags.
+// the LoadBalancer must be of the desired type, within our VPC and not tracked by another stack.
+func ValidateLoadBalancerAdoption(sdkLB LoadBalancerWithTags, lbType elbv2model.LoadBalancerType, vpcID string, stackTags map[string]string) error {
+	if err := validateSDKLoadBalancerAdoption(sdkLB, lbType, stackTags); err != nil {
+		return err
+	}
+	if awssdk.StringValue(sdkLB.LoadBalancer.VpcId) != vpcID {
+		return errors.Errorf("cannot adopt loadBalancer %v: it's in VPC %v instead of %v",
+			awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), awssdk.StringValue(sdkLB.LoadBalancer.VpcId), vpcID)
+	}
+	return nil
+}
+
+// validateSDKLoadBalancerAdoption checks the type and tracking tags of a LoadBalancer to adopt.
+func validateSDKLoadBalancerAdoption(sdkLB LoadBalancerWithTags, lbType elbv2model.LoadBalancerType, stackTags map[string]string) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	if awssdk.StringValue(sdkLB.LoadBalancer.Type) != string(lbType) {
+		return errors.Errorf("cannot adopt loadBalancer %v: it's of type %v instead of %v",
+			lbARN, awssdk.StringValue(sdkLB.LoadBalancer.Type), lbType)
+	}
+	for tagKey, tagValue := range stackTags {
+		if currentTagValue, exists := sdkLB.Tags[tagKey]; exists && currentTagValue != tagValue {
+			return errors.Errorf("cannot adopt loadBalancer %v: it's tracked by another stack with tag %v: %v",
+				lbARN, tagKey, currentTagValue)
+		}
+	}
+	return nil
+}
+
+// isSDKLoadBalancerAdopted checks whether a sdk LoadBalancer is adopted rather than created by us.
+func isSDKLoadBalancerAdopted(sdkLB LoadBalancerWithTags) bool {
+	_, adopted := sdkLB.Tags[tracking.AdoptedTagKey]
+	return adopted
+}
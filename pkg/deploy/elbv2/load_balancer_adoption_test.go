@@ -0,0 +1,274 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_ValidateLoadBalancerAdoption(t *testing.T) {
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	}
+	type args struct {
+		sdkLB  LoadBalancerWithTags
+		lbType elbv2model.LoadBalancerType
+		vpcID  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "loadBalancer without tracking tags can be adopted",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						VpcId:           awssdk.String("vpc-xxx"),
+					},
+					Tags: map[string]string{
+						"team": "web",
+					},
+				},
+				lbType: elbv2model.LoadBalancerTypeApplication,
+				vpcID:  "vpc-xxx",
+			},
+		},
+		{
+			name: "loadBalancer already adopted for the stack can be adopted",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						VpcId:           awssdk.String("vpc-xxx"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/cluster": "cluster-name",
+						"ingress.k8s.aws/stack": "my-group",
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				lbType: elbv2model.LoadBalancerTypeApplication,
+				vpcID:  "vpc-xxx",
+			},
+		},
+		{
+			name: "loadBalancer of different type cannot be adopted",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("network"),
+						VpcId:           awssdk.String("vpc-xxx"),
+					},
+				},
+				lbType: elbv2model.LoadBalancerTypeApplication,
+				vpcID:  "vpc-xxx",
+			},
+			wantErr: errors.New("cannot adopt loadBalancer my-arn: it's of type network instead of application"),
+		},
+		{
+			name: "loadBalancer in different VPC cannot be adopted",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						VpcId:           awssdk.String("vpc-yyy"),
+					},
+				},
+				lbType: elbv2model.LoadBalancerTypeApplication,
+				vpcID:  "vpc-xxx",
+			},
+			wantErr: errors.New("cannot adopt loadBalancer my-arn: it's in VPC vpc-yyy instead of vpc-xxx"),
+		},
+		{
+			name: "loadBalancer tracked by another stack cannot be adopted",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						VpcId:           awssdk.String("vpc-xxx"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/cluster": "cluster-name",
+						"ingress.k8s.aws/stack": "other-group",
+					},
+				},
+				lbType: elbv2model.LoadBalancerTypeApplication,
+				vpcID:  "vpc-xxx",
+			},
+			wantErr: errors.New("cannot adopt loadBalancer my-arn: it's tracked by another stack with tag ingress.k8s.aws/stack: other-group"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLoadBalancerAdoption(tt.args.sdkLB, tt.args.lbType, tt.args.vpcID, stackTags)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_loadBalancerSynthesizer_releaseLoadBalancer(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Name: "my-group"})
+	trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	}
+	sdkLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2sdk.LoadBalancer{
+			LoadBalancerArn: awssdk.String("lb-arn"),
+		},
+		Tags: map[string]string{
+			"elbv2.k8s.aws/cluster":    "cluster-name",
+			"ingress.k8s.aws/stack":    "my-group",
+			"ingress.k8s.aws/resource": "LoadBalancer",
+			"elbv2.k8s.aws/adopted":    "true",
+			"team":                     "web",
+		},
+	}
+	tests := []struct {
+		name           string
+		sdkLSs         []ListenerWithTags
+		wantDeletedLSs []string
+	}{
+		{
+			name: "listeners created by us are deleted and tracking tags are removed",
+			sdkLSs: []ListenerWithTags{
+				{
+					Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn-80"), Port: awssdk.Int64(80)},
+					Tags:     stackTags,
+				},
+				{
+					Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn-443"), Port: awssdk.Int64(443)},
+					Tags:     map[string]string{"team": "web"},
+				},
+			},
+			wantDeletedLSs: []string{"ls-arn-80"},
+		},
+		{
+			name: "listeners without tracking tags are kept",
+			sdkLSs: []ListenerWithTags{
+				{
+					Listener: &elbv2sdk.Listener{ListenerArn: awssdk.String("ls-arn-443"), Port: awssdk.Int64(443)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			taggingManager := NewMockTaggingManager(ctrl)
+			taggingManager.EXPECT().ListListeners(gomock.Any(), "lb-arn").Return(tt.sdkLSs, nil)
+			for _, lsARN := range tt.wantDeletedLSs {
+				elbv2Client.EXPECT().DeleteListenerWithContext(gomock.Any(), &elbv2sdk.DeleteListenerInput{
+					ListenerArn: awssdk.String(lsARN),
+				}).Return(&elbv2sdk.DeleteListenerOutput{}, nil)
+			}
+			taggingManager.EXPECT().ReconcileTags(gomock.Any(), "lb-arn", map[string]string{"team": "web"}, gomock.Any()).Return(nil)
+
+			s := NewLoadBalancerSynthesizer(elbv2Client, trackingProvider, taggingManager, nil, logr.Discard(), stack)
+			err := s.releaseLoadBalancer(context.Background(), sdkLB)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_listenerSynthesizer_filterUnmanagedSDKListeners(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Name: "my-group"})
+	trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	}
+	newSDKListener := func(port int64, tags map[string]string) ListenerWithTags {
+		return ListenerWithTags{
+			Listener: &elbv2sdk.Listener{Port: awssdk.Int64(port)},
+			Tags:     tags,
+		}
+	}
+	tests := []struct {
+		name         string
+		resLSPorts   []int64
+		sdkLSs       []ListenerWithTags
+		claimedPorts []int64
+		wantPorts    []int64
+		wantErr      error
+	}{
+		{
+			name:       "listeners without tracking tags are unmanaged",
+			resLSPorts: []int64{80},
+			sdkLSs: []ListenerWithTags{
+				newSDKListener(80, stackTags),
+				newSDKListener(443, map[string]string{"team": "web"}),
+				newSDKListener(8443, nil),
+			},
+			wantPorts: []int64{80},
+		},
+		{
+			name:       "claimed listeners are managed",
+			resLSPorts: []int64{443},
+			sdkLSs: []ListenerWithTags{
+				newSDKListener(443, nil),
+				newSDKListener(8443, nil),
+			},
+			claimedPorts: []int64{443, 8443},
+			wantPorts:    []int64{443, 8443},
+		},
+		{
+			name:       "listener cannot be desired on port of unmanaged listener",
+			resLSPorts: []int64{443},
+			sdkLSs: []ListenerWithTags{
+				newSDKListener(443, nil),
+			},
+			wantErr: errors.New("listener on port 443 of adopted loadBalancer lb-arn is unmanaged, it must be claimed to be managed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resLSs []*elbv2model.Listener
+			for _, port := range tt.resLSPorts {
+				resLSs = append(resLSs, &elbv2model.Listener{Spec: elbv2model.ListenerSpec{Port: port}})
+			}
+			s := NewListenerSynthesizer(nil, trackingProvider, nil, nil, logr.Discard(), stack)
+			got, err := s.filterUnmanagedSDKListeners("lb-arn", resLSs, tt.sdkLSs, &elbv2model.LoadBalancerAdoption{
+				LoadBalancerARN:      "lb-arn",
+				UnmanagedListeners:   true,
+				ClaimedListenerPorts: tt.claimedPorts,
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotPorts []int64
+			for _, sdkLS := range got {
+				gotPorts = append(gotPorts, awssdk.Int64Value(sdkLS.Listener.Port))
+			}
+			assert.Equal(t, tt.wantPorts, gotPorts)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -50,11 +51,11 @@ type defaultLoadBalancerManager struct {
 }
 
 func (m *defaultLoadBalancerManager) Create(ctx context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
-	req, err := buildSDKCreateLoadBalancerInput(resLB.Spec)
+	req, err := buildSDKCreateLoadBalancerInput(ctx, resLB.Spec)
 	if err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
-	lbTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	lbTags := m.buildSDKLoadBalancerTags(resLB)
 	req.Tags = convertTagsToSDKTags(lbTags)
 
 	m.logger.Info("creating loadBalancer",
@@ -165,9 +166,13 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx
 		return nil
 	}
 
+	sdkSubnetMappings, err := buildSDKSubnetMappings(ctx, resLB.Spec.SubnetMappings)
+	if err != nil {
+		return err
+	}
 	req := &elbv2sdk.SetSubnetsInput{
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		SubnetMappings:  buildSDKSubnetMappings(resLB.Spec.SubnetMappings),
+		SubnetMappings:  sdkSubnetMappings,
 	}
 	changeDesc := fmt.Sprintf("%v => %v", currentSubnets.List(), desiredSubnets.List())
 	m.logger.Info("modifying loadBalancer subnetMappings",
@@ -240,14 +245,40 @@ func (m *defaultLoadBalancerManager) checkSDKLoadBalancerWithCOIPv4Pool(_ contex
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	desiredLBTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	desiredLBTags := m.buildSDKLoadBalancerTags(resLB)
+	var adoptedLBTagKeys []string
+	if resLB.Spec.Adoption != nil {
+		desiredLBTags = algorithm.MergeStringMap(desiredLBTags, map[string]string{tracking.AdoptedTagKey: "true"})
+		// the adopted LoadBalancer isn't created by us, so we never remove tags from it except our tracking tags.
+		retainedStackTags := m.trackingProvider.RetainedStackTags(resLB.Stack())
+		for tagKey := range sdkLB.Tags {
+			if _, exists := desiredLBTags[tagKey]; exists {
+				continue
+			}
+			if _, retained := retainedStackTags[tagKey]; retained || tagKey == tracking.DeletionPolicyTagKey {
+				continue
+			}
+			adoptedLBTagKeys = append(adoptedLBTagKeys, tagKey)
+		}
+	}
 	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), desiredLBTags,
 		WithCurrentTags(sdkLB.Tags),
 		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
-		WithIgnoredTagKeys(m.externalManagedTags))
+		WithIgnoredTagKeys(m.externalManagedTags),
+		WithIgnoredTagKeys(adoptedLBTagKeys))
 }
 
-func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
+// buildSDKLoadBalancerTags builds the desired tags of LoadBalancer, including tracking tags and the tag for deletion policy.
+func (m *defaultLoadBalancerManager) buildSDKLoadBalancerTags(resLB *elbv2model.LoadBalancer) map[string]string {
+	lbTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	deletionPolicy := resLB.Spec.DeletionPolicy
+	if deletionPolicy == "" || deletionPolicy == elbv2model.LoadBalancerDeletionPolicyDelete {
+		return lbTags
+	}
+	return algorithm.MergeStringMap(map[string]string{tracking.DeletionPolicyTagKey: string(deletionPolicy)}, lbTags)
+}
+
+func buildSDKCreateLoadBalancerInput(ctx context.Context, lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
 	sdkObj := &elbv2sdk.CreateLoadBalancerInput{}
 	sdkObj.Name = awssdk.String(lbSpec.Name)
 	sdkObj.Type = awssdk.String(string(lbSpec.Type))
@@ -264,7 +295,11 @@ func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2
 		sdkObj.IpAddressType = nil
 	}
 
-	sdkObj.SubnetMappings = buildSDKSubnetMappings(lbSpec.SubnetMappings)
+	if sdkSubnetMappings, err := buildSDKSubnetMappings(ctx, lbSpec.SubnetMappings); err != nil {
+		return nil, err
+	} else {
+		sdkObj.SubnetMappings = sdkSubnetMappings
+	}
 	if sdkSecurityGroups, err := buildSDKSecurityGroups(lbSpec.SecurityGroups); err != nil {
 		return nil, err
 	} else {
@@ -275,15 +310,19 @@ func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2
 	return sdkObj, nil
 }
 
-func buildSDKSubnetMappings(modelSubnetMappings []elbv2model.SubnetMapping) []*elbv2sdk.SubnetMapping {
+func buildSDKSubnetMappings(ctx context.Context, modelSubnetMappings []elbv2model.SubnetMapping) ([]*elbv2sdk.SubnetMapping, error) {
 	var sdkSubnetMappings []*elbv2sdk.SubnetMapping
 	if len(modelSubnetMappings) != 0 {
 		sdkSubnetMappings = make([]*elbv2sdk.SubnetMapping, 0, len(modelSubnetMappings))
 		for _, modelSubnetMapping := range modelSubnetMappings {
-			sdkSubnetMappings = append(sdkSubnetMappings, buildSDKSubnetMapping(modelSubnetMapping))
+			sdkSubnetMapping, err := buildSDKSubnetMapping(ctx, modelSubnetMapping)
+			if err != nil {
+				return nil, err
+			}
+			sdkSubnetMappings = append(sdkSubnetMappings, sdkSubnetMapping)
 		}
 	}
-	return sdkSubnetMappings
+	return sdkSubnetMappings, nil
 }
 
 func buildSDKSecurityGroups(modelSecurityGroups []coremodel.StringToken) ([]*string, error) {
@@ -302,13 +341,21 @@ func buildSDKSecurityGroups(modelSecurityGroups []coremodel.StringToken) ([]*str
 	return sdkSecurityGroups, nil
 }
 
-func buildSDKSubnetMapping(modelSubnetMapping elbv2model.SubnetMapping) *elbv2sdk.SubnetMapping {
+func buildSDKSubnetMapping(ctx context.Context, modelSubnetMapping elbv2model.SubnetMapping) (*elbv2sdk.SubnetMapping, error) {
+	var allocationID *string
+	if modelSubnetMapping.AllocationID != nil {
+		token, err := modelSubnetMapping.AllocationID.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		allocationID = awssdk.String(token)
+	}
 	return &elbv2sdk.SubnetMapping{
-		AllocationId:       modelSubnetMapping.AllocationID,
+		AllocationId:       allocationID,
 		PrivateIPv4Address: modelSubnetMapping.PrivateIPv4Address,
 		IPv6Address:        modelSubnetMapping.IPv6Address,
 		SubnetId:           awssdk.String(modelSubnetMapping.SubnetID),
-	}
+	}, nil
 }
 
 func buildResLoadBalancerStatus(sdkLB LoadBalancerWithTags) elbv2model.LoadBalancerStatus {
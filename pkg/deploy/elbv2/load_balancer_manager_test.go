@@ -139,7 +139,7 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := buildSDKCreateLoadBalancerInput(tt.args.lbSpec)
+			got, err := buildSDKCreateLoadBalancerInput(context.Background(), tt.args.lbSpec)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -183,7 +183,8 @@ func Test_buildSDKSubnetMappings(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildSDKSubnetMappings(tt.args.modelSubnetMappings)
+			got, err := buildSDKSubnetMappings(context.Background(), tt.args.modelSubnetMappings)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -245,7 +246,7 @@ func Test_buildSDKSubnetMapping(t *testing.T) {
 			name: "stand case",
 			args: args{
 				modelSubnetMapping: elbv2model.SubnetMapping{
-					AllocationID:       awssdk.String("some-id"),
+					AllocationID:       coremodel.LiteralStringToken("some-id"),
 					PrivateIPv4Address: awssdk.String("192.168.100.0"),
 					SubnetID:           "subnet-abc",
 				},
@@ -270,7 +271,8 @@ func Test_buildSDKSubnetMapping(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildSDKSubnetMapping(tt.args.modelSubnetMapping)
+			got, err := buildSDKSubnetMapping(context.Background(), tt.args.modelSubnetMapping)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -61,6 +61,12 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 	//  * we can avoid the operation to detach a targetGroup from unmatched LBs. (a targetGroup can only attach to one LB).
 	// I don't like this, but it's the easiest solution to meet our requirement :D.
 	for _, sdkLB := range unmatchedSDKLBs {
+		if isSDKLoadBalancerAdopted(sdkLB) {
+			if err := s.releaseLoadBalancer(ctx, sdkLB); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := s.lbManager.Delete(ctx, sdkLB); err != nil {
 			errMessage := err.Error()
 			if strings.Contains(errMessage, "OperationNotPermitted") && strings.Contains(errMessage, "deletion protection") {
@@ -73,8 +79,26 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 			}
 		}
 	}
+	if len(unmatchedResLBs) != 0 {
+		retainedSDKLBs, err := s.findRetainedSDKLoadBalancers(ctx)
+		if err != nil {
+			return err
+		}
+		// retained LoadBalancers are reused if they match, otherwise they are kept intact.
+		matchedResAndRetainedSDKLBs, stillUnmatchedResLBs, _, err := matchResAndSDKLoadBalancers(unmatchedResLBs, retainedSDKLBs, s.trackingProvider.ResourceIDTagKey())
+		if err != nil {
+			return err
+		}
+		matchedResAndSDKLBs = append(matchedResAndSDKLBs, matchedResAndRetainedSDKLBs...)
+		unmatchedResLBs = stillUnmatchedResLBs
+	}
 	for _, resLB := range unmatchedResLBs {
-		lbStatus, err := s.lbManager.Create(ctx, resLB)
+		var lbStatus elbv2model.LoadBalancerStatus
+		if resLB.Spec.Adoption != nil {
+			lbStatus, err = s.adoptLoadBalancer(ctx, resLB)
+		} else {
+			lbStatus, err = s.lbManager.Create(ctx, resLB)
+		}
 		if err != nil {
 			return err
 		}
@@ -104,11 +128,104 @@ func (s *loadBalancerSynthesizer) disableDeletionProtection(lb *elbv2sdk.LoadBal
 	return err
 }
 
+// adoptLoadBalancer adopts an existing LoadBalancer by adding our tracking tags to it, and then updates it like LoadBalancers created by us.
+func (s *loadBalancerSynthesizer) adoptLoadBalancer(ctx context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
+	sdkLB, err := s.taggingManager.GetLoadBalancer(ctx, resLB.Spec.Adoption.LoadBalancerARN)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	// the LoadBalancer is validated when building the model, but its tags might have changed since then.
+	if err := validateSDKLoadBalancerAdoption(sdkLB, resLB.Spec.Type, s.trackingProvider.StackTags(s.stack)); err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	s.logger.Info("adopting loadBalancer",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", resLB.Spec.Adoption.LoadBalancerARN)
+	// Update tags the LoadBalancer before modifying it, since the IAM policy only allows modifying LoadBalancers tagged with the cluster tag.
+	lbStatus, err := s.lbManager.Update(ctx, resLB, sdkLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	s.logger.Info("adopted loadBalancer",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", resLB.Spec.Adoption.LoadBalancerARN)
+	return lbStatus, nil
+}
+
+// releaseLoadBalancer releases an adopted LoadBalancer instead of deleting it.
+// the listeners created or claimed by us are deleted and our tracking tags are removed, other listeners and tags are kept intact.
+func (s *loadBalancerSynthesizer) releaseLoadBalancer(ctx context.Context, sdkLB LoadBalancerWithTags) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	sdkLSs, err := s.taggingManager.ListListeners(ctx, lbARN)
+	if err != nil {
+		return err
+	}
+	stackTagFilter := tracking.TagsAsTagFilter(stackTags)
+	for _, sdkLS := range sdkLSs {
+		if !stackTagFilter.Matches(sdkLS.Tags) {
+			continue
+		}
+		req := &elbv2sdk.DeleteListenerInput{
+			ListenerArn: sdkLS.Listener.ListenerArn,
+		}
+		s.logger.Info("deleting listener of adopted loadBalancer",
+			"loadBalancerARN", lbARN,
+			"arn", awssdk.StringValue(req.ListenerArn))
+		if _, err := s.elbv2Client.DeleteListenerWithContext(ctx, req); err != nil {
+			return err
+		}
+		s.logger.Info("deleted listener of adopted loadBalancer",
+			"loadBalancerARN", lbARN,
+			"arn", awssdk.StringValue(req.ListenerArn))
+	}
+
+	trackingTagKeys := sets.StringKeySet(stackTags).Insert(s.trackingProvider.ResourceIDTagKey(), tracking.AdoptedTagKey)
+	desiredTags := make(map[string]string, len(sdkLB.Tags))
+	for tagKey, tagValue := range sdkLB.Tags {
+		if !trackingTagKeys.Has(tagKey) {
+			desiredTags[tagKey] = tagValue
+		}
+	}
+	s.logger.Info("releasing loadBalancer", "arn", lbARN)
+	if err := s.taggingManager.ReconcileTags(ctx, lbARN, desiredTags, WithCurrentTags(sdkLB.Tags)); err != nil {
+		return err
+	}
+	s.logger.Info("released loadBalancer", "arn", lbARN)
+	return nil
+}
+
 func (s *loadBalancerSynthesizer) PostSynthesize(ctx context.Context) error {
 	// nothing to do here.
 	return nil
 }
 
+// FindUnmatchedLoadBalancerARNs finds the AWS LoadBalancers of stack that will be deleted by Synthesize,
+// e.g. LoadBalancers that are removed from stack or require replacement.
+func (s *loadBalancerSynthesizer) FindUnmatchedLoadBalancerARNs(ctx context.Context) ([]string, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	sdkLBs, err := s.findSDKLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, _, unmatchedSDKLBs, err := matchResAndSDKLoadBalancers(resLBs, sdkLBs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+	var unmatchedLBARNs []string
+	for _, sdkLB := range unmatchedSDKLBs {
+		// adopted LoadBalancers are released instead of deleted.
+		if isSDKLoadBalancerAdopted(sdkLB) {
+			continue
+		}
+		unmatchedLBARNs = append(unmatchedLBARNs, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+	}
+	return unmatchedLBARNs, nil
+}
+
 // findSDKLoadBalancers will find all AWS LoadBalancer created for stack.
 func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]LoadBalancerWithTags, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
@@ -118,6 +235,12 @@ func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]L
 		tracking.TagsAsTagFilter(stackTagsLegacy))
 }
 
+// findRetainedSDKLoadBalancers will find all AWS LoadBalancer retained for stack.
+func (s *loadBalancerSynthesizer) findRetainedSDKLoadBalancers(ctx context.Context) ([]LoadBalancerWithTags, error) {
+	retainedStackTags := s.trackingProvider.RetainedStackTags(s.stack)
+	return s.taggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(retainedStackTags))
+}
+
 type resAndSDKLoadBalancerPair struct {
 	resLB *elbv2model.LoadBalancer
 	sdkLB LoadBalancerWithTags
@@ -188,6 +311,13 @@ func mapSDKLoadBalancerByResourceID(sdkLBs []LoadBalancerWithTags, resourceIDTag
 
 // isSDKLoadBalancerRequiresReplacement checks whether a sdk LoadBalancer requires replacement to fulfill a LoadBalancer resource.
 func isSDKLoadBalancerRequiresReplacement(sdkLB LoadBalancerWithTags, resLB *elbv2model.LoadBalancer) bool {
+	// an adopted LoadBalancer is never replaced, it's released instead when no longer desired.
+	if isSDKLoadBalancerAdopted(sdkLB) != (resLB.Spec.Adoption != nil) {
+		return true
+	}
+	if resLB.Spec.Adoption != nil && resLB.Spec.Adoption.LoadBalancerARN != awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn) {
+		return true
+	}
 	if string(resLB.Spec.Type) != awssdk.StringValue(sdkLB.LoadBalancer.Type) {
 		return true
 	}
@@ -587,6 +587,108 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "adopted loadBalancer with same ARN don't need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:   elbv2model.LoadBalancerTypeApplication,
+						Scheme: &schemaInternetFacing,
+						Name:   "my-lb",
+						Adoption: &elbv2model.LoadBalancerAdoption{
+							LoadBalancerARN: "my-arn",
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "adopted loadBalancer with different ARN need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:   elbv2model.LoadBalancerTypeApplication,
+						Scheme: &schemaInternetFacing,
+						Name:   "my-lb",
+						Adoption: &elbv2model.LoadBalancerAdoption{
+							LoadBalancerARN: "other-arn",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "adopted loadBalancer that's no longer adopted need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:   elbv2model.LoadBalancerTypeApplication,
+						Scheme: &schemaInternetFacing,
+						Name:   "my-lb",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "loadBalancer created by us need replacement to adopt another one",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:   elbv2model.LoadBalancerTypeApplication,
+						Scheme: &schemaInternetFacing,
+						Name:   "my-lb",
+						Adoption: &elbv2model.LoadBalancerAdoption{
+							LoadBalancerARN: "other-arn",
+						},
+					},
+				},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -91,6 +91,9 @@ type TaggingManager interface {
 	// ListLoadBalancers returns LoadBalancers that matches any of the tagging requirements.
 	ListLoadBalancers(ctx context.Context, tagFilters ...tracking.TagFilter) ([]LoadBalancerWithTags, error)
 
+	// GetLoadBalancer returns the LoadBalancer with specified ARN along with tags, regardless of its tags.
+	GetLoadBalancer(ctx context.Context, lbARN string) (LoadBalancerWithTags, error)
+
 	// ListTargetGroups returns TargetGroups that matches any of the tagging requirements.
 	ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error)
 
@@ -265,6 +268,28 @@ func (m *defaultTaggingManager) ListLoadBalancers(ctx context.Context, tagFilter
 	return m.listLoadBalancersNative(ctx, tagFilters)
 }
 
+func (m *defaultTaggingManager) GetLoadBalancer(ctx context.Context, lbARN string) (LoadBalancerWithTags, error) {
+	req := &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+	}
+	lbs, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, req)
+	if err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	if len(lbs) == 0 {
+		return LoadBalancerWithTags{}, errors.Errorf("no load balancer found for the arn: %v", lbARN)
+	}
+	// tags are always described from AWS, since they decide whether the LoadBalancer can be adopted.
+	tagsByARN, err := m.describeResourceTagsFromAWS(ctx, []string{lbARN})
+	if err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	return LoadBalancerWithTags{
+		LoadBalancer: lbs[0],
+		Tags:         tagsByARN[lbARN],
+	}, nil
+}
+
 func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error) {
 	if m.featureGates.Enabled(config.EnableRGTAPI) {
 		m.logger.V(1).Info("ResourceGroupTagging enabled, list the target groups via RGT API")
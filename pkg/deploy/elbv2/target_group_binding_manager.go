@@ -176,6 +176,7 @@ func buildK8sTargetGroupBindingSpec(ctx context.Context, resTGB *elbv2model.Targ
 		TargetGroupARN: tgARN,
 		TargetType:     resTGB.Spec.Template.Spec.TargetType,
 		ServiceRef:     resTGB.Spec.Template.Spec.ServiceRef,
+		ALBTargetRef:   resTGB.Spec.Template.Spec.ALBTargetRef,
 	}
 
 	if resTGB.Spec.Template.Spec.Networking != nil {
@@ -58,6 +58,20 @@ func (s *targetGroupSynthesizer) Synthesize(ctx context.Context) error {
 	// * unmatched targetGroups might still be use by a listener rule.
 	s.unmatchedSDKTGs = unmatchedSDKTGs
 
+	if len(unmatchedResTGs) != 0 {
+		retainedSDKTGs, err := s.findRetainedSDKTargetGroups(ctx)
+		if err != nil {
+			return err
+		}
+		// retained targetGroups are reused if they match, otherwise they are kept intact.
+		matchedResAndRetainedSDKTGs, stillUnmatchedResTGs, _, err := matchResAndSDKTargetGroups(unmatchedResTGs, retainedSDKTGs,
+			s.trackingProvider.ResourceIDTagKey(), s.featureGates)
+		if err != nil {
+			return err
+		}
+		matchedResAndSDKTGs = append(matchedResAndSDKTGs, matchedResAndRetainedSDKTGs...)
+		unmatchedResTGs = stillUnmatchedResTGs
+	}
 	for _, resTG := range unmatchedResTGs {
 		tgStatus, err := s.tgManager.Create(ctx, resTG)
 		if err != nil {
@@ -93,6 +107,12 @@ func (s *targetGroupSynthesizer) findSDKTargetGroups(ctx context.Context) ([]Tar
 		tracking.TagsAsTagFilter(stackTagsLegacy))
 }
 
+// findRetainedSDKTargetGroups will find all AWS TargetGroups retained for stack.
+func (s *targetGroupSynthesizer) findRetainedSDKTargetGroups(ctx context.Context) ([]TargetGroupWithTags, error) {
+	retainedStackTags := s.trackingProvider.RetainedStackTags(s.stack)
+	return s.taggingManager.ListTargetGroups(ctx, tracking.TagsAsTagFilter(retainedStackTags))
+}
+
 type resAndSDKTargetGroupPair struct {
 	resTG *elbv2model.TargetGroup
 	sdkTG TargetGroupWithTags
@@ -0,0 +1,217 @@
+package deploy
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	lbAttrsDeletionProtectionEnabled = "deletion_protection.enabled"
+)
+
+// LoadBalancerRetainer retains AWS resources of a stack instead of deleting them, per the deletion policy of its LoadBalancer.
+type LoadBalancerRetainer interface {
+	// Retain retains AWS resources of stack if the stack no longer desires any LoadBalancer,
+	// and its existing LoadBalancer has Retain or Orphan deletion policy.
+	// It returns whether AWS resources of stack are retained.
+	Retain(ctx context.Context, stack core.Stack) (bool, error)
+}
+
+// NewDefaultLoadBalancerRetainer constructs new defaultLoadBalancerRetainer.
+func NewDefaultLoadBalancerRetainer(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
+	elbv2TaggingManager elbv2.TaggingManager, ec2TaggingManager ec2.TaggingManager,
+	networkingSGManager networking.SecurityGroupManager, logger logr.Logger) *defaultLoadBalancerRetainer {
+	return &defaultLoadBalancerRetainer{
+		elbv2Client:         elbv2Client,
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2TaggingManager,
+		ec2TaggingManager:   ec2TaggingManager,
+		networkingSGManager: networkingSGManager,
+		logger:              logger,
+	}
+}
+
+var _ LoadBalancerRetainer = &defaultLoadBalancerRetainer{}
+
+// defaultLoadBalancerRetainer retains LoadBalancers along with TargetGroups, SecurityGroups, ElasticIPs and VPCEndpointServices of stack.
+// * Retain replaces the stack tags with retained stack tags, so that resources are reused once the stack is created again.
+// * Orphan removes the tracking tags, so that resources are no longer tracked by us.
+type defaultLoadBalancerRetainer struct {
+	elbv2Client         services.ELBV2
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2.TaggingManager
+	ec2TaggingManager   ec2.TaggingManager
+	networkingSGManager networking.SecurityGroupManager
+	logger              logr.Logger
+}
+
+func (r *defaultLoadBalancerRetainer) Retain(ctx context.Context, stack core.Stack) (bool, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	stack.ListResources(&resLBs)
+	if len(resLBs) != 0 {
+		return false, nil
+	}
+	stackTagFilter := tracking.TagsAsTagFilter(r.trackingProvider.StackTags(stack))
+	stackTagFilterLegacy := tracking.TagsAsTagFilter(r.trackingProvider.StackTagsLegacy(stack))
+	sdkLBs, err := r.elbv2TaggingManager.ListLoadBalancers(ctx, stackTagFilter, stackTagFilterLegacy)
+	if err != nil {
+		return false, err
+	}
+	deletionPolicy := elbv2model.LoadBalancerDeletionPolicyDelete
+	for _, sdkLB := range sdkLBs {
+		switch policy := elbv2model.LoadBalancerDeletionPolicy(sdkLB.Tags[tracking.DeletionPolicyTagKey]); policy {
+		case elbv2model.LoadBalancerDeletionPolicyRetain, elbv2model.LoadBalancerDeletionPolicyOrphan:
+			deletionPolicy = policy
+		}
+	}
+	if deletionPolicy == elbv2model.LoadBalancerDeletionPolicyDelete {
+		return false, nil
+	}
+
+	r.logger.Info("retaining resources",
+		"stackID", stack.StackID(),
+		"deletionPolicy", deletionPolicy)
+	for _, sdkLB := range sdkLBs {
+		if err := r.retainLoadBalancer(ctx, stack, sdkLB, deletionPolicy); err != nil {
+			return false, err
+		}
+	}
+	sdkTGs, err := r.elbv2TaggingManager.ListTargetGroups(ctx, stackTagFilter, stackTagFilterLegacy)
+	if err != nil {
+		return false, err
+	}
+	for _, sdkTG := range sdkTGs {
+		if err := r.elbv2TaggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+			r.buildRetainedTags(stack, deletionPolicy, sdkTG.Tags), elbv2.WithCurrentTags(sdkTG.Tags)); err != nil {
+			return false, err
+		}
+	}
+	sdkSGs, err := r.ec2TaggingManager.ListSecurityGroups(ctx, stackTagFilter, stackTagFilterLegacy)
+	if err != nil {
+		return false, err
+	}
+	for _, sdkSG := range sdkSGs {
+		if err := r.ec2TaggingManager.ReconcileTags(ctx, sdkSG.SecurityGroupID,
+			r.buildRetainedTags(stack, deletionPolicy, sdkSG.Tags), ec2.WithCurrentTags(sdkSG.Tags)); err != nil {
+			return false, err
+		}
+	}
+	sdkEIPs, err := r.ec2TaggingManager.ListElasticIPs(ctx, stackTagFilter)
+	if err != nil {
+		return false, err
+	}
+	for _, sdkEIP := range sdkEIPs {
+		if err := r.ec2TaggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkEIP.Address.AllocationId),
+			r.buildRetainedTags(stack, deletionPolicy, sdkEIP.Tags), ec2.WithCurrentTags(sdkEIP.Tags)); err != nil {
+			return false, err
+		}
+	}
+	sdkVPCESs, err := r.ec2TaggingManager.ListVPCEndpointServices(ctx, stackTagFilter)
+	if err != nil {
+		return false, err
+	}
+	for _, sdkVPCES := range sdkVPCESs {
+		if err := r.ec2TaggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkVPCES.ServiceConfiguration.ServiceId),
+			r.buildRetainedTags(stack, deletionPolicy, sdkVPCES.Tags), ec2.WithCurrentTags(sdkVPCES.Tags)); err != nil {
+			return false, err
+		}
+	}
+	r.logger.Info("retained resources",
+		"stackID", stack.StackID(),
+		"deletionPolicy", deletionPolicy)
+	return true, nil
+}
+
+// retainLoadBalancer retains the LoadBalancer, deletion protection is enabled for LoadBalancer with Retain deletion policy.
+func (r *defaultLoadBalancerRetainer) retainLoadBalancer(ctx context.Context, stack core.Stack, sdkLB elbv2.LoadBalancerWithTags,
+	deletionPolicy elbv2model.LoadBalancerDeletionPolicy) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	if err := r.detachBackendSG(ctx, sdkLB); err != nil {
+		return err
+	}
+	if deletionPolicy == elbv2model.LoadBalancerDeletionPolicyRetain {
+		req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+			Attributes: []*elbv2sdk.LoadBalancerAttribute{
+				{
+					Key:   awssdk.String(lbAttrsDeletionProtectionEnabled),
+					Value: awssdk.String("true"),
+				},
+			},
+		}
+		if _, err := r.elbv2Client.ModifyLoadBalancerAttributesWithContext(ctx, req); err != nil {
+			return err
+		}
+	}
+	if err := r.elbv2TaggingManager.ReconcileTags(ctx, lbARN, r.buildRetainedTags(stack, deletionPolicy, sdkLB.Tags),
+		elbv2.WithCurrentTags(sdkLB.Tags)); err != nil {
+		return err
+	}
+	r.logger.Info("retained loadBalancer",
+		"stackID", stack.StackID(),
+		"arn", lbARN)
+	return nil
+}
+
+// detachBackendSG detaches the auto-generated backend SG from LoadBalancer, so that it can be deleted once no longer required.
+// the ingress rules for LoadBalancer on the backend SG are removed along with TargetGroupBindings of stack.
+func (r *defaultLoadBalancerRetainer) detachBackendSG(ctx context.Context, sdkLB elbv2.LoadBalancerWithTags) error {
+	sgIDs := awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)
+	if len(sgIDs) == 0 {
+		return nil
+	}
+	sgInfoByID, err := r.networkingSGManager.FetchSGInfosByID(ctx, sgIDs)
+	if err != nil {
+		return err
+	}
+	var retainedSGIDs []string
+	for _, sgID := range sgIDs {
+		if sgInfo, exists := sgInfoByID[sgID]; exists && networking.IsAutoGeneratedBackendSG(sgInfo) {
+			continue
+		}
+		retainedSGIDs = append(retainedSGIDs, sgID)
+	}
+	// LoadBalancer with security groups cannot have its last security group detached.
+	if len(retainedSGIDs) == len(sgIDs) || len(retainedSGIDs) == 0 {
+		return nil
+	}
+	req := &elbv2sdk.SetSecurityGroupsInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		SecurityGroups:  awssdk.StringSlice(retainedSGIDs),
+	}
+	_, err = r.elbv2Client.SetSecurityGroupsWithContext(ctx, req)
+	return err
+}
+
+// buildRetainedTags builds the tags of resource retained per deletionPolicy.
+func (r *defaultLoadBalancerRetainer) buildRetainedTags(stack core.Stack, deletionPolicy elbv2model.LoadBalancerDeletionPolicy,
+	currentTags map[string]string) map[string]string {
+	untrackedTagKeys := sets.StringKeySet(r.trackingProvider.StackTags(stack))
+	if deletionPolicy == elbv2model.LoadBalancerDeletionPolicyOrphan {
+		untrackedTagKeys.Insert(sets.StringKeySet(r.trackingProvider.StackTagsLegacy(stack)).List()...)
+		untrackedTagKeys.Insert(r.trackingProvider.ResourceIDTagKey(), tracking.DeletionPolicyTagKey, tracking.AdoptedTagKey)
+	}
+	retainedTags := make(map[string]string, len(currentTags))
+	for tagKey, tagValue := range currentTags {
+		if !untrackedTagKeys.Has(tagKey) {
+			retainedTags[tagKey] = tagValue
+		}
+	}
+	if deletionPolicy == elbv2model.LoadBalancerDeletionPolicyRetain {
+		for tagKey, tagValue := range r.trackingProvider.RetainedStackTags(stack) {
+			retainedTags[tagKey] = tagValue
+		}
+	}
+	return retainedTags
+}
@@ -0,0 +1,120 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultLoadBalancerRetainer_Retain(t *testing.T) {
+	tests := []struct {
+		name         string
+		desiresLB    bool
+		sdkLBs       []elbv2.LoadBalancerWithTags
+		wantRetained bool
+	}{
+		{
+			name:      "stack still desires loadBalancer",
+			desiresLB: true,
+		},
+		{
+			name: "loadBalancer without deletion policy",
+			sdkLBs: []elbv2.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/cluster": "cluster-name",
+						"ingress.k8s.aws/stack": "my-group",
+					},
+				},
+			},
+		},
+		{
+			name: "loadBalancer with Delete deletion policy",
+			sdkLBs: []elbv2.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/cluster":         "cluster-name",
+						"ingress.k8s.aws/stack":         "my-group",
+						"elbv2.k8s.aws/deletion-policy": "Delete",
+					},
+				},
+			},
+		},
+		{
+			name: "no loadBalancer exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stack := core.NewDefaultStack(core.StackID{Name: "my-group"})
+			if tt.desiresLB {
+				_ = elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			}
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			if !tt.desiresLB {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.sdkLBs, nil)
+			}
+			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+			r := NewDefaultLoadBalancerRetainer(nil, trackingProvider, elbv2TaggingManager, nil, nil, logr.Discard())
+			got, err := r.Retain(context.Background(), stack)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRetained, got)
+		})
+	}
+}
+
+func Test_defaultLoadBalancerRetainer_buildRetainedTags(t *testing.T) {
+	currentTags := map[string]string{
+		"elbv2.k8s.aws/cluster":         "cluster-name",
+		"ingress.k8s.aws/stack":         "my-group",
+		"ingress.k8s.aws/resource":      "LoadBalancer",
+		"elbv2.k8s.aws/deletion-policy": "Retain",
+		"team":                          "web",
+	}
+	tests := []struct {
+		name           string
+		deletionPolicy elbv2model.LoadBalancerDeletionPolicy
+		want           map[string]string
+	}{
+		{
+			name:           "Retain replaces stack tags with retained stack tags",
+			deletionPolicy: elbv2model.LoadBalancerDeletionPolicyRetain,
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":          "cluster-name",
+				"ingress.k8s.aws/retained-stack": "my-group",
+				"ingress.k8s.aws/resource":       "LoadBalancer",
+				"elbv2.k8s.aws/deletion-policy":  "Retain",
+				"team":                           "web",
+			},
+		},
+		{
+			name:           "Orphan removes tracking tags",
+			deletionPolicy: elbv2model.LoadBalancerDeletionPolicyOrphan,
+			want: map[string]string{
+				"team": "web",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Name: "my-group"})
+			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+			r := NewDefaultLoadBalancerRetainer(nil, trackingProvider, nil, nil, nil, logr.Discard())
+			got := r.buildRetainedTags(stack, tt.deletionPolicy, currentTags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package deploy
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
+	wafregionalmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafregional"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultApplicationLoadBalancerRuleQuota is the default quota of rules per application load balancer, excluding default rules.
+	defaultApplicationLoadBalancerRuleQuota = 100
+)
+
+// LoadBalancerStateReporter reports the reconciled AWS state of load balancers via LoadBalancerState objects.
+type LoadBalancerStateReporter interface {
+	// ReportReconciled reports the state of load balancer from the stack that has been deployed.
+	ReportReconciled(ctx context.Context, source elbv2api.LoadBalancerSource, members []elbv2api.LoadBalancerStateMember, stack core.Stack) error
+
+	// ReportFailed reports a failed reconcile, the last reconciled state of load balancer is kept.
+	ReportFailed(ctx context.Context, source elbv2api.LoadBalancerSource, members []elbv2api.LoadBalancerStateMember, reason string, reconcileErr error) error
+
+	// Delete deletes the state of load balancer once it's deleted.
+	Delete(ctx context.Context, source elbv2api.LoadBalancerSource) error
+}
+
+// NewDefaultLoadBalancerStateReporter constructs new defaultLoadBalancerStateReporter.
+func NewDefaultLoadBalancerStateReporter(k8sClient client.Client, logger logr.Logger) *defaultLoadBalancerStateReporter {
+	return &defaultLoadBalancerStateReporter{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ LoadBalancerStateReporter = &defaultLoadBalancerStateReporter{}
+
+// default implementation for LoadBalancerStateReporter.
+type defaultLoadBalancerStateReporter struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (r *defaultLoadBalancerStateReporter) ReportReconciled(ctx context.Context, source elbv2api.LoadBalancerSource, members []elbv2api.LoadBalancerStateMember, stack core.Stack) error {
+	status, err := buildLoadBalancerStateStatus(ctx, stack)
+	if err != nil {
+		return err
+	}
+	status.Members = members
+	status.LastReconcile = &elbv2api.LastReconcile{
+		Result: elbv2api.ReconcileResultSuccess,
+		Time:   metav1.Now(),
+	}
+	return r.updateLoadBalancerState(ctx, source, func(lbStatus *elbv2api.LoadBalancerStateStatus) {
+		preserveMemberConditionTransitionTimes(lbStatus.Members, status.Members)
+		*lbStatus = status
+	})
+}
+
+func (r *defaultLoadBalancerStateReporter) ReportFailed(ctx context.Context, source elbv2api.LoadBalancerSource, members []elbv2api.LoadBalancerStateMember, reason string, reconcileErr error) error {
+	return r.updateLoadBalancerState(ctx, source, func(lbStatus *elbv2api.LoadBalancerStateStatus) {
+		lbStatus.Members = members
+		lbStatus.LastReconcile = &elbv2api.LastReconcile{
+			Result:  elbv2api.ReconcileResultFailure,
+			Reason:  reason,
+			Message: reconcileErr.Error(),
+			Time:    metav1.Now(),
+		}
+	})
+}
+
+// preserveMemberConditionTransitionTimes keeps the lastTransitionTime of member conditions whose status hasn't changed since the last report.
+func preserveMemberConditionTransitionTimes(lastMembers []elbv2api.LoadBalancerStateMember, members []elbv2api.LoadBalancerStateMember) {
+	lastMemberByKey := make(map[types.NamespacedName]elbv2api.LoadBalancerStateMember, len(lastMembers))
+	for _, member := range lastMembers {
+		lastMemberByKey[types.NamespacedName{Namespace: member.Namespace, Name: member.Name}] = member
+	}
+	for i := range members {
+		lastMember, exists := lastMemberByKey[types.NamespacedName{Namespace: members[i].Namespace, Name: members[i].Name}]
+		if !exists {
+			continue
+		}
+		for j := range members[i].Conditions {
+			condition := &members[i].Conditions[j]
+			lastCondition := meta.FindStatusCondition(lastMember.Conditions, condition.Type)
+			if lastCondition != nil && lastCondition.Status == condition.Status {
+				condition.LastTransitionTime = lastCondition.LastTransitionTime
+			}
+		}
+	}
+}
+
+func (r *defaultLoadBalancerStateReporter) Delete(ctx context.Context, source elbv2api.LoadBalancerSource) error {
+	lbState := &elbv2api.LoadBalancerState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: LoadBalancerStateName(source),
+		},
+	}
+	if err := r.k8sClient.Delete(ctx, lbState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete loadBalancerState: %v", lbState.Name)
+	}
+	r.logger.V(1).Info("deleted loadBalancerState", "loadBalancerState", lbState.Name)
+	return nil
+}
+
+// updateLoadBalancerState creates the LoadBalancerState for source if not exists, and updates its status.
+func (r *defaultLoadBalancerStateReporter) updateLoadBalancerState(ctx context.Context, source elbv2api.LoadBalancerSource, mutateStatus func(lbStatus *elbv2api.LoadBalancerStateStatus)) error {
+	lbStateName := LoadBalancerStateName(source)
+	lbState := &elbv2api.LoadBalancerState{}
+	if err := r.k8sClient.Get(ctx, client.ObjectKey{Name: lbStateName}, lbState); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get loadBalancerState: %v", lbStateName)
+		}
+		lbState = &elbv2api.LoadBalancerState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: lbStateName,
+			},
+			Spec: elbv2api.LoadBalancerStateSpec{
+				Source: source,
+			},
+		}
+		if err := r.k8sClient.Create(ctx, lbState); err != nil {
+			return errors.Wrapf(err, "failed to create loadBalancerState: %v", lbStateName)
+		}
+	}
+	lbStateOld := lbState.DeepCopy()
+	mutateStatus(&lbState.Status)
+	if err := r.k8sClient.Status().Patch(ctx, lbState, client.MergeFrom(lbStateOld)); err != nil {
+		return errors.Wrapf(err, "failed to update loadBalancerState status: %v", lbStateName)
+	}
+	return nil
+}
+
+// LoadBalancerStateName returns the name of LoadBalancerState for source.
+// implicit IngressGroups and Services are prefixed with their namespace, which never contains dots.
+func LoadBalancerStateName(source elbv2api.LoadBalancerSource) string {
+	switch {
+	case source.Kind == elbv2api.LoadBalancerSourceKindService:
+		return "service." + source.Namespace + "." + source.Name
+	case source.Namespace != "":
+		return "ingress." + source.Namespace + "." + source.Name
+	default:
+		return "ingressgroup." + source.Name
+	}
+}
+
+// buildLoadBalancerStateStatus builds the status of LoadBalancerState from the stack that has been deployed.
+func buildLoadBalancerStateStatus(ctx context.Context, stack core.Stack) (elbv2api.LoadBalancerStateStatus, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&resLBs); err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	if len(resLBs) != 1 {
+		return elbv2api.LoadBalancerStateStatus{}, errors.Errorf("expect exactly one load balancer in stack, got %v", len(resLBs))
+	}
+	resLB := resLBs[0]
+	if resLB.Status == nil {
+		return elbv2api.LoadBalancerStateStatus{}, errors.New("load balancer is not deployed")
+	}
+	status := elbv2api.LoadBalancerStateStatus{
+		LoadBalancerARN: resLB.Status.LoadBalancerARN,
+		DNSName:         resLB.Status.DNSName,
+	}
+	for _, sgToken := range resLB.Spec.SecurityGroups {
+		sgID, err := sgToken.Resolve(ctx)
+		if err != nil {
+			return elbv2api.LoadBalancerStateStatus{}, err
+		}
+		status.SecurityGroups = append(status.SecurityGroups, sgID)
+	}
+	sort.Strings(status.SecurityGroups)
+
+	listeners, ruleCount, err := buildListenerStates(ctx, stack)
+	if err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	status.Listeners = listeners
+	if resLB.Spec.Type == elbv2model.LoadBalancerTypeApplication {
+		status.RuleCount = ruleCount
+		status.RuleQuota = defaultApplicationLoadBalancerRuleQuota
+	}
+
+	targetGroups, err := buildTargetGroupStates(ctx, stack)
+	if err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	status.TargetGroups = targetGroups
+
+	var resWAFv2Associations []*wafv2model.WebACLAssociation
+	if err := stack.ListResources(&resWAFv2Associations); err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	for _, association := range resWAFv2Associations {
+		status.WAFv2WebACLARN = association.Spec.WebACLARN
+	}
+	var resWAFRegionalAssociations []*wafregionalmodel.WebACLAssociation
+	if err := stack.ListResources(&resWAFRegionalAssociations); err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	for _, association := range resWAFRegionalAssociations {
+		status.WAFRegionalWebACLID = association.Spec.WebACLID
+	}
+	var resProtections []*shieldmodel.Protection
+	if err := stack.ListResources(&resProtections); err != nil {
+		return elbv2api.LoadBalancerStateStatus{}, err
+	}
+	status.ShieldProtection = len(resProtections) != 0
+	return status, nil
+}
+
+// buildListenerStates builds the state of listeners sorted by port, along with the total count of listener rules.
+func buildListenerStates(ctx context.Context, stack core.Stack) ([]elbv2api.ListenerState, int32, error) {
+	var resListeners []*elbv2model.Listener
+	if err := stack.ListResources(&resListeners); err != nil {
+		return nil, 0, err
+	}
+	var resListenerRules []*elbv2model.ListenerRule
+	if err := stack.ListResources(&resListenerRules); err != nil {
+		return nil, 0, err
+	}
+	ruleCountByListenerARN := make(map[string]int32, len(resListeners))
+	for _, resListenerRule := range resListenerRules {
+		lsARN, err := resListenerRule.Spec.ListenerARN.Resolve(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		ruleCountByListenerARN[lsARN]++
+	}
+
+	var listeners []elbv2api.ListenerState
+	var ruleCount int32
+	for _, resListener := range resListeners {
+		lsARN, err := resListener.ListenerARN().Resolve(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		listeners = append(listeners, elbv2api.ListenerState{
+			Port:        resListener.Spec.Port,
+			Protocol:    string(resListener.Spec.Protocol),
+			ListenerARN: lsARN,
+			RuleCount:   ruleCountByListenerARN[lsARN],
+		})
+		ruleCount += ruleCountByListenerARN[lsARN]
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].Port < listeners[j].Port
+	})
+	return listeners, ruleCount, nil
+}
+
+// buildTargetGroupStates builds the state of target groups sorted by ARN, along with their TargetGroupBindings.
+func buildTargetGroupStates(ctx context.Context, stack core.Stack) ([]elbv2api.TargetGroupState, error) {
+	var resTGs []*elbv2model.TargetGroup
+	if err := stack.ListResources(&resTGs); err != nil {
+		return nil, err
+	}
+	var resTGBs []*elbv2model.TargetGroupBindingResource
+	if err := stack.ListResources(&resTGBs); err != nil {
+		return nil, err
+	}
+	bindingByTGARN := make(map[string]*elbv2api.TargetGroupBindingReference, len(resTGBs))
+	for _, resTGB := range resTGBs {
+		tgARN, err := resTGB.Spec.Template.Spec.TargetGroupARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bindingByTGARN[tgARN] = &elbv2api.TargetGroupBindingReference{
+			Namespace:  resTGB.Spec.Template.Namespace,
+			Name:       resTGB.Spec.Template.Name,
+			ServiceRef: resTGB.Spec.Template.Spec.ServiceRef,
+		}
+	}
+
+	var targetGroups []elbv2api.TargetGroupState
+	for _, resTG := range resTGs {
+		tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targetGroups = append(targetGroups, elbv2api.TargetGroupState{
+			TargetGroupARN: tgARN,
+			TargetType:     string(resTG.Spec.TargetType),
+			Binding:        bindingByTGARN[tgARN],
+		})
+	}
+	sort.Slice(targetGroups, func(i, j int) bool {
+		return targetGroups[i].TargetGroupARN < targetGroups[j].TargetGroupARN
+	})
+	return targetGroups, nil
+}
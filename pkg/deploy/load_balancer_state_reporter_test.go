@@ -0,0 +1,392 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func buildDeployedALBStack() core.Stack {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Type:           elbv2model.LoadBalancerTypeApplication,
+		SecurityGroups: []core.StringToken{core.LiteralStringToken("sg-b"), core.LiteralStringToken("sg-a")},
+	})
+	lb.SetStatus(elbv2model.LoadBalancerStatus{
+		LoadBalancerARN: "lb-arn",
+		DNSName:         "lb.example.com",
+	})
+	ls443 := elbv2model.NewListener(stack, "443", elbv2model.ListenerSpec{
+		LoadBalancerARN: lb.LoadBalancerARN(),
+		Port:            443,
+		Protocol:        elbv2model.ProtocolHTTPS,
+	})
+	ls443.SetStatus(elbv2model.ListenerStatus{ListenerARN: "ls-443-arn"})
+	ls80 := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+		LoadBalancerARN: lb.LoadBalancerARN(),
+		Port:            80,
+		Protocol:        elbv2model.ProtocolHTTP,
+	})
+	ls80.SetStatus(elbv2model.ListenerStatus{ListenerARN: "ls-80-arn"})
+	_ = elbv2model.NewListenerRule(stack, "443:1", elbv2model.ListenerRuleSpec{ListenerARN: ls443.ListenerARN(), Priority: 1})
+	_ = elbv2model.NewListenerRule(stack, "443:2", elbv2model.ListenerRuleSpec{ListenerARN: ls443.ListenerARN(), Priority: 2})
+	_ = elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{ListenerARN: ls80.ListenerARN(), Priority: 1})
+	tg := elbv2model.NewTargetGroup(stack, "awesome-ns/svc-1:http", elbv2model.TargetGroupSpec{
+		TargetType: elbv2model.TargetTypeIP,
+	})
+	tg.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "tg-arn"})
+	_ = elbv2model.NewTargetGroupBindingResource(stack, tg.ID(), elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "k8s-svc1-abc",
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				ServiceRef: elbv2api.ServiceReference{
+					Name: "svc-1",
+					Port: intstr.FromString("http"),
+				},
+			},
+		},
+	})
+	_ = wafv2model.NewWebACLAssociation(stack, "LoadBalancer", wafv2model.WebACLAssociationSpec{
+		WebACLARN:   "web-acl-arn",
+		ResourceARN: lb.LoadBalancerARN(),
+	})
+	_ = shieldmodel.NewProtection(stack, "LoadBalancer", shieldmodel.ProtectionSpec{
+		ResourceARN: lb.LoadBalancerARN(),
+	})
+	return stack
+}
+
+func Test_defaultLoadBalancerStateReporter_ReportReconciled(t *testing.T) {
+	source := elbv2api.LoadBalancerSource{
+		Kind: elbv2api.LoadBalancerSourceKindIngressGroup,
+		Name: "awesome-group",
+	}
+	members := []elbv2api.LoadBalancerStateMember{
+		{Namespace: "awesome-ns", Name: "ing-1", ObservedGeneration: 3},
+	}
+	tests := []struct {
+		name         string
+		existingLBSs []*elbv2api.LoadBalancerState
+		stack        core.Stack
+		want         elbv2api.LoadBalancerStateStatus
+		wantErr      error
+	}{
+		{
+			name:  "new state is created",
+			stack: buildDeployedALBStack(),
+			want: elbv2api.LoadBalancerStateStatus{
+				LoadBalancerARN: "lb-arn",
+				DNSName:         "lb.example.com",
+				Listeners: []elbv2api.ListenerState{
+					{Port: 80, Protocol: "HTTP", ListenerARN: "ls-80-arn", RuleCount: 1},
+					{Port: 443, Protocol: "HTTPS", ListenerARN: "ls-443-arn", RuleCount: 2},
+				},
+				RuleCount: 3,
+				RuleQuota: 100,
+				TargetGroups: []elbv2api.TargetGroupState{
+					{
+						TargetGroupARN: "tg-arn",
+						TargetType:     "ip",
+						Binding: &elbv2api.TargetGroupBindingReference{
+							Namespace: "awesome-ns",
+							Name:      "k8s-svc1-abc",
+							ServiceRef: elbv2api.ServiceReference{
+								Name: "svc-1",
+								Port: intstr.FromString("http"),
+							},
+						},
+					},
+				},
+				SecurityGroups:   []string{"sg-a", "sg-b"},
+				WAFv2WebACLARN:   "web-acl-arn",
+				ShieldProtection: true,
+				Members:          members,
+				LastReconcile: &elbv2api.LastReconcile{
+					Result: elbv2api.ReconcileResultSuccess,
+				},
+			},
+		},
+		{
+			name: "existing state is replaced",
+			existingLBSs: []*elbv2api.LoadBalancerState{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ingressgroup.awesome-group"},
+					Spec:       elbv2api.LoadBalancerStateSpec{Source: source},
+					Status: elbv2api.LoadBalancerStateStatus{
+						LoadBalancerARN:     "lb-arn",
+						WAFRegionalWebACLID: "web-acl-id",
+						LastReconcile: &elbv2api.LastReconcile{
+							Result:  elbv2api.ReconcileResultFailure,
+							Reason:  "FailedDeployModel",
+							Message: "some error",
+						},
+					},
+				},
+			},
+			stack: func() core.Stack {
+				stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+				lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeApplication,
+				})
+				lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn", DNSName: "lb.example.com"})
+				return stack
+			}(),
+			want: elbv2api.LoadBalancerStateStatus{
+				LoadBalancerARN: "lb-arn",
+				DNSName:         "lb.example.com",
+				RuleQuota:       100,
+				Members:         members,
+				LastReconcile: &elbv2api.LastReconcile{
+					Result: elbv2api.ReconcileResultSuccess,
+				},
+			},
+		},
+		{
+			name: "load balancer not deployed",
+			stack: func() core.Stack {
+				stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+				_ = elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeApplication,
+				})
+				return stack
+			}(),
+			wantErr: errors.New("load balancer is not deployed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := newLoadBalancerStateTestClient()
+			for _, lbs := range tt.existingLBSs {
+				assert.NoError(t, k8sClient.Create(context.Background(), lbs.DeepCopy()))
+			}
+			r := NewDefaultLoadBalancerStateReporter(k8sClient, logr.Discard())
+			err := r.ReportReconciled(context.Background(), source, members, tt.stack)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			got := &elbv2api.LoadBalancerState{}
+			assert.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "ingressgroup.awesome-group"}, got))
+			assert.Equal(t, source, got.Spec.Source)
+			assert.False(t, got.Status.LastReconcile.Time.IsZero())
+			got.Status.LastReconcile.Time = metav1.Time{}
+			assert.Equal(t, tt.want, got.Status)
+		})
+	}
+}
+
+func Test_preserveMemberConditionTransitionTimes(t *testing.T) {
+	lastTransitionTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	quarantinedCondition := func(status metav1.ConditionStatus, transitionTime metav1.Time) []metav1.Condition {
+		return []metav1.Condition{
+			{
+				Type:               elbv2api.LoadBalancerStateMemberConditionQuarantined,
+				Status:             status,
+				Reason:             "FailedBuildModel",
+				LastTransitionTime: transitionTime,
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		lastMembers []elbv2api.LoadBalancerStateMember
+		members     []elbv2api.LoadBalancerStateMember
+		want        []elbv2api.LoadBalancerStateMember
+	}{
+		{
+			name: "new member keeps its transition time",
+			members: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, now)},
+			},
+			want: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, now)},
+			},
+		},
+		{
+			name: "unchanged condition keeps last transition time",
+			lastMembers: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, lastTransitionTime)},
+			},
+			members: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, now)},
+			},
+			want: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, lastTransitionTime)},
+			},
+		},
+		{
+			name: "changed condition uses new transition time",
+			lastMembers: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionFalse, lastTransitionTime)},
+			},
+			members: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, now)},
+			},
+			want: []elbv2api.LoadBalancerStateMember{
+				{Namespace: "awesome-ns", Name: "ing-1", Conditions: quarantinedCondition(metav1.ConditionTrue, now)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preserveMemberConditionTransitionTimes(tt.lastMembers, tt.members)
+			assert.Equal(t, tt.want, tt.members)
+		})
+	}
+}
+
+func Test_defaultLoadBalancerStateReporter_ReportFailed(t *testing.T) {
+	source := elbv2api.LoadBalancerSource{
+		Kind:      elbv2api.LoadBalancerSourceKindService,
+		Namespace: "awesome-ns",
+		Name:      "svc-1",
+	}
+	members := []elbv2api.LoadBalancerStateMember{
+		{Namespace: "awesome-ns", Name: "svc-1", ObservedGeneration: 2},
+	}
+	tests := []struct {
+		name         string
+		existingLBSs []*elbv2api.LoadBalancerState
+		want         elbv2api.LoadBalancerStateStatus
+	}{
+		{
+			name: "new state is created",
+			want: elbv2api.LoadBalancerStateStatus{
+				Members: members,
+				LastReconcile: &elbv2api.LastReconcile{
+					Result:  elbv2api.ReconcileResultFailure,
+					Reason:  "FailedDeployModel",
+					Message: "some error",
+				},
+			},
+		},
+		{
+			name: "last reconciled state is kept",
+			existingLBSs: []*elbv2api.LoadBalancerState{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "service.awesome-ns.svc-1"},
+					Spec:       elbv2api.LoadBalancerStateSpec{Source: source},
+					Status: elbv2api.LoadBalancerStateStatus{
+						LoadBalancerARN: "lb-arn",
+						DNSName:         "lb.example.com",
+						Members: []elbv2api.LoadBalancerStateMember{
+							{Namespace: "awesome-ns", Name: "svc-1", ObservedGeneration: 1},
+						},
+						LastReconcile: &elbv2api.LastReconcile{
+							Result: elbv2api.ReconcileResultSuccess,
+						},
+					},
+				},
+			},
+			want: elbv2api.LoadBalancerStateStatus{
+				LoadBalancerARN: "lb-arn",
+				DNSName:         "lb.example.com",
+				Members:         members,
+				LastReconcile: &elbv2api.LastReconcile{
+					Result:  elbv2api.ReconcileResultFailure,
+					Reason:  "FailedDeployModel",
+					Message: "some error",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := newLoadBalancerStateTestClient()
+			for _, lbs := range tt.existingLBSs {
+				assert.NoError(t, k8sClient.Create(context.Background(), lbs.DeepCopy()))
+			}
+			r := NewDefaultLoadBalancerStateReporter(k8sClient, logr.Discard())
+			err := r.ReportFailed(context.Background(), source, members, "FailedDeployModel", errors.New("some error"))
+			assert.NoError(t, err)
+			got := &elbv2api.LoadBalancerState{}
+			assert.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "service.awesome-ns.svc-1"}, got))
+			got.Status.LastReconcile.Time = metav1.Time{}
+			assert.Equal(t, tt.want, got.Status)
+		})
+	}
+}
+
+func Test_defaultLoadBalancerStateReporter_Delete(t *testing.T) {
+	source := elbv2api.LoadBalancerSource{
+		Kind: elbv2api.LoadBalancerSourceKindIngressGroup,
+		Name: "awesome-group",
+	}
+	k8sClient := newLoadBalancerStateTestClient()
+	r := NewDefaultLoadBalancerStateReporter(k8sClient, logr.Discard())
+	assert.NoError(t, r.ReportFailed(context.Background(), source, nil, "FailedBuildModel", errors.New("some error")))
+	assert.NoError(t, r.Delete(context.Background(), source))
+	lbStates := &elbv2api.LoadBalancerStateList{}
+	assert.NoError(t, k8sClient.List(context.Background(), lbStates))
+	assert.Empty(t, lbStates.Items)
+	// deleting a nonexistent state is a no-op.
+	assert.NoError(t, r.Delete(context.Background(), source))
+}
+
+func Test_LoadBalancerStateName(t *testing.T) {
+	tests := []struct {
+		name   string
+		source elbv2api.LoadBalancerSource
+		want   string
+	}{
+		{
+			name: "explicit IngressGroup",
+			source: elbv2api.LoadBalancerSource{
+				Kind: elbv2api.LoadBalancerSourceKindIngressGroup,
+				Name: "awesome.group",
+			},
+			want: "ingressgroup.awesome.group",
+		},
+		{
+			name: "implicit IngressGroup",
+			source: elbv2api.LoadBalancerSource{
+				Kind:      elbv2api.LoadBalancerSourceKindIngressGroup,
+				Namespace: "awesome-ns",
+				Name:      "ing-1",
+			},
+			want: "ingress.awesome-ns.ing-1",
+		},
+		{
+			name: "Service",
+			source: elbv2api.LoadBalancerSource{
+				Kind:      elbv2api.LoadBalancerSourceKindService,
+				Namespace: "awesome-ns",
+				Name:      "svc-1",
+			},
+			want: "service.awesome-ns.svc-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LoadBalancerStateName(tt.source))
+		})
+	}
+}
+
+func newLoadBalancerStateTestClient() client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	return testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+}
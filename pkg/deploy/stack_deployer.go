@@ -38,12 +38,15 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 		trackingProvider:                    trackingProvider,
 		ec2TaggingManager:                   ec2TaggingManager,
 		ec2SGManager:                        ec2.NewDefaultSecurityGroupManager(cloud.EC2(), trackingProvider, ec2TaggingManager, networkingSGReconciler, cloud.VpcID(), config.ExternalManagedTags, logger),
+		ec2EIPManager:                       ec2.NewDefaultElasticIPManager(cloud.EC2(), trackingProvider, ec2TaggingManager, config.ExternalManagedTags, logger),
+		ec2VPCESManager:                     ec2.NewDefaultVPCEndpointServiceManager(cloud.EC2(), trackingProvider, ec2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2TaggingManager:                 elbv2TaggingManager,
 		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		lbRetainer:                          NewDefaultLoadBalancerRetainer(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, ec2TaggingManager, networkingSGManager, logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
@@ -63,12 +66,15 @@ type defaultStackDeployer struct {
 	trackingProvider                    tracking.Provider
 	ec2TaggingManager                   ec2.TaggingManager
 	ec2SGManager                        ec2.SecurityGroupManager
+	ec2EIPManager                       ec2.ElasticIPManager
+	ec2VPCESManager                     ec2.VPCEndpointServiceManager
 	elbv2TaggingManager                 elbv2.TaggingManager
 	elbv2LBManager                      elbv2.LoadBalancerManager
 	elbv2LSManager                      elbv2.ListenerManager
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
+	lbRetainer                          LoadBalancerRetainer
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
@@ -85,11 +91,23 @@ type ResourceSynthesizer interface {
 
 // Deploy a resource stack.
 func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
+	retained, err := d.lbRetainer.Retain(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if retained {
+		// only TargetGroupBindings are deleted for retained stack, the AWS resources are kept.
+		return d.deployRetainedStack(ctx, stack)
+	}
+
+	lbSynthesizer := elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack)
 	synthesizers := []ResourceSynthesizer{
+		ec2.NewElasticIPSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2EIPManager, d.logger, stack),
 		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
 		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack),
-		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
-		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
+		ec2.NewVPCEndpointServiceSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2VPCESManager, lbSynthesizer, d.logger, stack),
+		lbSynthesizer,
+		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
 		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
 		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
 	}
@@ -122,3 +140,12 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) err
 
 	return nil
 }
+
+// deployRetainedStack deploys a resource stack whose AWS resources are retained.
+func (d *defaultStackDeployer) deployRetainedStack(ctx context.Context, stack core.Stack) error {
+	synthesizer := elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack)
+	if err := synthesizer.Synthesize(ctx); err != nil {
+		return err
+	}
+	return synthesizer.PostSynthesize(ctx)
+}
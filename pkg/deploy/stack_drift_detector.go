@@ -0,0 +1,47 @@
+package deploy
+
+import (
+	"context"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// StackDriftDetector detects drift between the live state of AWS resources and a stack that has been deployed, without redeploying it.
+type StackDriftDetector interface {
+	// Detect returns the drifted fields of AWS resources in stack, formatted as `<ResourceType>/<resourceID>: <field>`.
+	Detect(ctx context.Context, stack core.Stack) ([]string, error)
+}
+
+// NewDefaultStackDriftDetector constructs new defaultStackDriftDetector.
+func NewDefaultStackDriftDetector(cloud aws.Cloud, networkingSGManager networking.SecurityGroupManager,
+	config config.ControllerConfig) *defaultStackDriftDetector {
+	return &defaultStackDriftDetector{
+		ec2DriftDetector:   ec2.NewDefaultDriftDetector(networkingSGManager),
+		elbv2DriftDetector: elbv2.NewDefaultDriftDetector(cloud.ELBV2(), config.FeatureGates),
+	}
+}
+
+var _ StackDriftDetector = &defaultStackDriftDetector{}
+
+// defaultStackDriftDetector is the default implementation for StackDriftDetector
+type defaultStackDriftDetector struct {
+	ec2DriftDetector   ec2.DriftDetector
+	elbv2DriftDetector elbv2.DriftDetector
+}
+
+func (d *defaultStackDriftDetector) Detect(ctx context.Context, stack core.Stack) ([]string, error) {
+	sgDrifts, err := d.ec2DriftDetector.Detect(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+	elbv2Drifts, err := d.elbv2DriftDetector.Detect(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+	return append(sgDrifts, elbv2Drifts...), nil
+}
@@ -22,6 +22,10 @@ import (
 //  * `service.k8s.aws/resource: resource-id` will be applied on all AWS resources provisioned for Service resources:
 //    * For LoadBalancer, `resource-id` will be `LoadBalancer`
 //    * For TargetGroup, `resource-id` will be `namespace/serviceName:servicePort`
+//  * `elbv2.k8s.aws/adopted: true` will be applied on existing LoadBalancers adopted for Ingress or Service resources.
+//  * `elbv2.k8s.aws/deletion-policy: policy` will be applied on LoadBalancers with Retain or Orphan deletion policy.
+//  * `ingress.k8s.aws/retained-stack: stack-id` or `service.k8s.aws/retained-stack: stack-id` replaces the stack tag on AWS resources
+//    retained after the stack is deleted, so that they can be reused once the stack is created again.
 //For K8s resources created by this controller, the labelling strategy is as follows:
 //  * For explicit IngressGroup, the following tags will be applied on all K8s resources:
 //    * `ingress.k8s.aws/stack: groupName`
@@ -38,6 +42,13 @@ const clusterNameTagKey = "elbv2.k8s.aws/cluster"
 // Legacy AWS TagKey for cluster resources, which is used by AWSALBIngressController(v1.1.3+)
 const clusterNameTagKeyLegacy = "ingress.k8s.aws/cluster"
 
+// AWS TagKey for existing resources adopted by the controller.
+// adopted resources are released instead of deleted when they're no longer desired.
+const AdoptedTagKey = "elbv2.k8s.aws/adopted"
+
+// AWS TagKey for the deletion policy of LoadBalancers, which is applied when the policy isn't Delete.
+const DeletionPolicyTagKey = "elbv2.k8s.aws/deletion-policy"
+
 // an abstraction that generates metadata to track actual resources provisioned for stack.
 type Provider interface {
 	// ResourceIDTagKey provide the tagKey for resourceID.
@@ -49,6 +60,9 @@ type Provider interface {
 	// ResourceTags provide the tags for stack resources
 	ResourceTags(stack core.Stack, res core.Resource, additionalTags map[string]string) map[string]string
 
+	// RetainedStackTags provide the tags that replace stack tags on resources retained after the stack is deleted.
+	RetainedStackTags(stack core.Stack) map[string]string
+
 	// StackLabels provide the suitable k8s labels for stack.
 	StackLabels(stack core.Stack) map[string]string
 
@@ -98,6 +112,14 @@ func (p *defaultProvider) ResourceTags(stack core.Stack, res core.Resource, addi
 	return algorithm.MergeStringMap(stackTags, resourceIDTags, additionalTags)
 }
 
+func (p *defaultProvider) RetainedStackTags(stack core.Stack) map[string]string {
+	stackID := stack.StackID()
+	return map[string]string{
+		clusterNameTagKey:                       p.clusterName,
+		p.prefixedTrackingKey("retained-stack"): stackID.String(),
+	}
+}
+
 func (p *defaultProvider) StackLabels(stack core.Stack) map[string]string {
 	stackID := stack.StackID()
 	if stackID.Namespace == "" {
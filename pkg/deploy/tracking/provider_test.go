@@ -114,6 +114,43 @@ func Test_defaultProvider_ResourceTags(t *testing.T) {
 	}
 }
 
+func Test_defaultProvider_RetainedStackTags(t *testing.T) {
+	type args struct {
+		stack core.Stack
+	}
+	tests := []struct {
+		name     string
+		provider *defaultProvider
+		args     args
+		want     map[string]string
+	}{
+		{
+			name:     "retainedStackTags for explicit IngressGroup",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "", Name: "awesome-group"})},
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":          "cluster-name",
+				"ingress.k8s.aws/retained-stack": "awesome-group",
+			},
+		},
+		{
+			name:     "retainedStackTags for Service",
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":          "cluster-name",
+				"service.k8s.aws/retained-stack": "namespace/serviceName",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.provider.RetainedStackTags(tt.args.stack)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultProvider_StackLabels(t *testing.T) {
 	type args struct {
 		stack core.Stack
@@ -0,0 +1,36 @@
+package drift
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemDrift = "drift_detection"
+
+	metricDriftedFields = "drifted_fields"
+)
+
+const (
+	labelController = "controller"
+	labelStack      = "stack"
+)
+
+type instruments struct {
+	driftedFields *prometheus.GaugeVec
+}
+
+// newInstruments allocates and register new metrics to registerer
+func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
+	driftedFields := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemDrift,
+		Name:      metricDriftedFields,
+		Help:      "Number of fields of AWS resources that drifted from the last deployed stack",
+	}, []string{labelController, labelStack})
+
+	if err := registerer.Register(driftedFields); err != nil {
+		return nil, err
+	}
+	return &instruments{
+		driftedFields: driftedFields,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Target is where the drift of a tracked stack is reported.
+type Target struct {
+	// Objects are the K8s objects of the stack, drift is reported on them via Events.
+	Objects []client.Object
+	// EventRecorder records Events on Objects.
+	EventRecorder record.EventRecorder
+	// EventReason is the reason of Events on Objects.
+	EventReason string
+	// EventChan receives a generic event of the first object to trigger an immediate reconcile of drifted stack,
+	// if drift remediation is enabled.
+	EventChan chan<- event.GenericEvent
+}
+
+// Monitor monitors the drift between the live state of AWS resources and the stacks that have been deployed.
+type Monitor interface {
+	// Track tracks the stack that has been deployed by controller, replacing the stack previously tracked with the same stackID.
+	Track(controllerName string, stack core.Stack, target Target)
+
+	// Untrack stops tracking the stack with stackID of controller, e.g. when it's being redeployed or deleted.
+	Untrack(controllerName string, stackID core.StackID)
+
+	// UntrackIf stops tracking the stacks of controller that match predicate, e.g. when they're no longer reconciled by this replica.
+	UntrackIf(controllerName string, predicate func(stackID core.StackID) bool)
+}
+
+// NewDefaultMonitor constructs new defaultMonitor.
+func NewDefaultMonitor(stackDriftDetector deploy.StackDriftDetector, driftDetectionConfig config.DriftDetectionConfig,
+	registerer prometheus.Registerer, logger logr.Logger) (*defaultMonitor, error) {
+	instruments, err := newInstruments(registerer)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultMonitor{
+		stackDriftDetector:   stackDriftDetector,
+		driftDetectionConfig: driftDetectionConfig,
+		instruments:          instruments,
+		logger:               logger,
+		trackedStacks:        make(map[trackedStackKey]*trackedStack),
+	}, nil
+}
+
+var _ Monitor = &defaultMonitor{}
+var _ manager.Runnable = &defaultMonitor{}
+var _ manager.LeaderElectionRunnable = &defaultMonitor{}
+
+type trackedStackKey struct {
+	controllerName string
+	stackID        core.StackID
+}
+
+type trackedStack struct {
+	stack  core.Stack
+	target Target
+	// drifts are the drifted fields last reported.
+	drifts []string
+}
+
+// default implementation for Monitor.
+// drift is reported via metrics, and via Events whenever the drifted fields change.
+type defaultMonitor struct {
+	stackDriftDetector   deploy.StackDriftDetector
+	driftDetectionConfig config.DriftDetectionConfig
+	instruments          *instruments
+	logger               logr.Logger
+
+	trackedStacksMutex sync.Mutex
+	trackedStacks      map[trackedStackKey]*trackedStack
+}
+
+func (m *defaultMonitor) Track(controllerName string, stack core.Stack, target Target) {
+	m.trackedStacksMutex.Lock()
+	defer m.trackedStacksMutex.Unlock()
+	key := trackedStackKey{controllerName: controllerName, stackID: stack.StackID()}
+	m.trackedStacks[key] = &trackedStack{
+		stack:  stack,
+		target: target,
+	}
+	m.instruments.driftedFields.WithLabelValues(controllerName, stack.StackID().String()).Set(0)
+}
+
+func (m *defaultMonitor) Untrack(controllerName string, stackID core.StackID) {
+	m.trackedStacksMutex.Lock()
+	defer m.trackedStacksMutex.Unlock()
+	key := trackedStackKey{controllerName: controllerName, stackID: stackID}
+	delete(m.trackedStacks, key)
+	m.instruments.driftedFields.DeleteLabelValues(controllerName, stackID.String())
+}
+
+func (m *defaultMonitor) UntrackIf(controllerName string, predicate func(stackID core.StackID) bool) {
+	m.trackedStacksMutex.Lock()
+	defer m.trackedStacksMutex.Unlock()
+	for key := range m.trackedStacks {
+		if key.controllerName != controllerName || !predicate(key.stackID) {
+			continue
+		}
+		delete(m.trackedStacks, key)
+		m.instruments.driftedFields.DeleteLabelValues(controllerName, key.stackID.String())
+	}
+}
+
+// Start runs the drift detection periodically until ctx is done.
+func (m *defaultMonitor) Start(ctx context.Context) error {
+	m.logger.Info("starting drift detection",
+		"interval", m.driftDetectionConfig.Interval,
+		"remediationEnabled", m.driftDetectionConfig.EnableRemediation)
+	wait.UntilWithContext(ctx, m.Detect, m.driftDetectionConfig.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, the drift detection only runs on the leader.
+func (m *defaultMonitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Detect detects and reports the drift of all tracked stacks.
+func (m *defaultMonitor) Detect(ctx context.Context) {
+	m.trackedStacksMutex.Lock()
+	trackedStacks := make(map[trackedStackKey]*trackedStack, len(m.trackedStacks))
+	for key, tracked := range m.trackedStacks {
+		trackedStacks[key] = tracked
+	}
+	m.trackedStacksMutex.Unlock()
+
+	for key, tracked := range trackedStacks {
+		drifts, err := m.stackDriftDetector.Detect(ctx, tracked.stack)
+		if err != nil {
+			m.logger.Error(err, "failed to detect drift",
+				"controller", key.controllerName,
+				"stackID", key.stackID)
+			continue
+		}
+		sort.Strings(drifts)
+		if m.reportDrifts(key, tracked, drifts) && m.driftDetectionConfig.EnableRemediation {
+			m.remediate(key, tracked)
+		}
+	}
+}
+
+// reportDrifts reports the drifted fields of stack, and returns whether the stack is still tracked with drift.
+// stacks that are retracked or untracked during detection are ignored, as their drift has been compared with stale stack.
+func (m *defaultMonitor) reportDrifts(key trackedStackKey, tracked *trackedStack, drifts []string) bool {
+	m.trackedStacksMutex.Lock()
+	defer m.trackedStacksMutex.Unlock()
+	if m.trackedStacks[key] != tracked {
+		return false
+	}
+	m.instruments.driftedFields.WithLabelValues(key.controllerName, key.stackID.String()).Set(float64(len(drifts)))
+	if len(drifts) != 0 && !isDriftsEqual(drifts, tracked.drifts) {
+		m.logger.Info("detected drift",
+			"controller", key.controllerName,
+			"stackID", key.stackID,
+			"drifts", drifts)
+		message := fmt.Sprintf("Detected drift of AWS resources from the deployed model: %v", strings.Join(drifts, ", "))
+		for _, obj := range tracked.target.Objects {
+			tracked.target.EventRecorder.Event(obj, corev1.EventTypeWarning, tracked.target.EventReason, message)
+		}
+	}
+	tracked.drifts = drifts
+	return len(drifts) != 0
+}
+
+// remediate triggers an immediate reconcile of the drifted stack.
+func (m *defaultMonitor) remediate(key trackedStackKey, tracked *trackedStack) {
+	if tracked.target.EventChan == nil || len(tracked.target.Objects) == 0 {
+		return
+	}
+	m.logger.Info("triggering reconcile of drifted stack",
+		"controller", key.controllerName,
+		"stackID", key.stackID)
+	tracked.target.EventChan <- event.GenericEvent{
+		Object: tracked.target.Objects[0],
+	}
+}
+
+func isDriftsEqual(drifts []string, otherDrifts []string) bool {
+	if len(drifts) != len(otherDrifts) {
+		return false
+	}
+	for i := range drifts {
+		if drifts[i] != otherDrifts[i] {
+			return false
+		}
+	}
+	return true
+}
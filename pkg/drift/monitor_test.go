@@ -0,0 +1,200 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeStackDriftDetector returns the drifts configured per stackID.
+type fakeStackDriftDetector struct {
+	driftsByStackID map[core.StackID][]string
+	// onDetect is invoked before returning the drifts, if set.
+	onDetect func(stack core.Stack)
+}
+
+func (d *fakeStackDriftDetector) Detect(_ context.Context, stack core.Stack) ([]string, error) {
+	if d.onDetect != nil {
+		d.onDetect(stack)
+	}
+	return d.driftsByStackID[stack.StackID()], nil
+}
+
+func Test_defaultMonitor_Detect(t *testing.T) {
+	stackID := core.StackID{Name: "my-group"}
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ing-1",
+		},
+	}
+	tests := []struct {
+		name               string
+		enableRemediation  bool
+		driftsPerDetection [][]string
+		wantEvents         []string
+		wantGenericEvents  int
+		wantDriftedFields  float64
+	}{
+		{
+			name:               "no drift",
+			driftsPerDetection: [][]string{nil, nil},
+			wantDriftedFields:  0,
+		},
+		{
+			name: "drift reported once while unchanged",
+			driftsPerDetection: [][]string{
+				{"Listener/80: settings", "LoadBalancer/LoadBalancer: attribute idle_timeout.timeout_seconds"},
+				{"LoadBalancer/LoadBalancer: attribute idle_timeout.timeout_seconds", "Listener/80: settings"},
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of AWS resources from the deployed model: Listener/80: settings, LoadBalancer/LoadBalancer: attribute idle_timeout.timeout_seconds",
+			},
+			wantDriftedFields: 2,
+		},
+		{
+			name: "drift reported again when changed",
+			driftsPerDetection: [][]string{
+				{"Listener/80: settings"},
+				nil,
+				{"Listener/80: settings"},
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of AWS resources from the deployed model: Listener/80: settings",
+				"Warning DriftDetected Detected drift of AWS resources from the deployed model: Listener/80: settings",
+			},
+			wantDriftedFields: 1,
+		},
+		{
+			name:              "drift remediated on every detection",
+			enableRemediation: true,
+			driftsPerDetection: [][]string{
+				{"Listener/80: deleted"},
+				{"Listener/80: deleted"},
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of AWS resources from the deployed model: Listener/80: deleted",
+			},
+			wantGenericEvents: 2,
+			wantDriftedFields: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := &fakeStackDriftDetector{}
+			registry := prometheus.NewRegistry()
+			m, err := NewDefaultMonitor(detector, config.DriftDetectionConfig{EnableRemediation: tt.enableRemediation},
+				registry, log.Log)
+			assert.NoError(t, err)
+
+			recorder := record.NewFakeRecorder(10)
+			eventChan := make(chan event.GenericEvent, 10)
+			m.Track("ingress", core.NewDefaultStack(stackID), Target{
+				Objects:       []client.Object{ing},
+				EventRecorder: recorder,
+				EventReason:   "DriftDetected",
+				EventChan:     eventChan,
+			})
+			for _, drifts := range tt.driftsPerDetection {
+				detector.driftsByStackID = map[core.StackID][]string{stackID: drifts}
+				m.Detect(context.Background())
+			}
+
+			close(recorder.Events)
+			var gotEvents []string
+			for e := range recorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+			assert.Equal(t, tt.wantGenericEvents, len(eventChan))
+			assert.Equal(t, tt.wantDriftedFields,
+				testutil.ToFloat64(m.instruments.driftedFields.WithLabelValues("ingress", stackID.String())))
+		})
+	}
+}
+
+func Test_defaultMonitor_Detect_stackChangedDuringDetection(t *testing.T) {
+	stackID := core.StackID{Name: "my-group"}
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ing-1",
+		},
+	}
+	tests := []struct {
+		name     string
+		onDetect func(m *defaultMonitor, target Target)
+	}{
+		{
+			name: "stack untracked during detection",
+			onDetect: func(m *defaultMonitor, _ Target) {
+				m.Untrack("ingress", stackID)
+			},
+		},
+		{
+			name: "stack retracked during detection",
+			onDetect: func(m *defaultMonitor, target Target) {
+				m.Track("ingress", core.NewDefaultStack(stackID), target)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := &fakeStackDriftDetector{
+				driftsByStackID: map[core.StackID][]string{stackID: {"Listener/80: deleted"}},
+			}
+			m, err := NewDefaultMonitor(detector, config.DriftDetectionConfig{EnableRemediation: true},
+				prometheus.NewRegistry(), log.Log)
+			assert.NoError(t, err)
+
+			recorder := record.NewFakeRecorder(10)
+			eventChan := make(chan event.GenericEvent, 10)
+			target := Target{
+				Objects:       []client.Object{ing},
+				EventRecorder: recorder,
+				EventReason:   "DriftDetected",
+				EventChan:     eventChan,
+			}
+			m.Track("ingress", core.NewDefaultStack(stackID), target)
+			detector.onDetect = func(_ core.Stack) {
+				tt.onDetect(m, target)
+			}
+			m.Detect(context.Background())
+
+			assert.Equal(t, 0, len(recorder.Events))
+			assert.Equal(t, 0, len(eventChan))
+		})
+	}
+}
+
+func Test_defaultMonitor_UntrackIf(t *testing.T) {
+	m, err := NewDefaultMonitor(&fakeStackDriftDetector{}, config.DriftDetectionConfig{}, prometheus.NewRegistry(), log.Log)
+	assert.NoError(t, err)
+	m.Track("ingress", core.NewDefaultStack(core.StackID{Name: "group-a"}), Target{})
+	m.Track("ingress", core.NewDefaultStack(core.StackID{Name: "group-b"}), Target{})
+	m.Track("service", core.NewDefaultStack(core.StackID{Namespace: "ns-1", Name: "group-a"}), Target{})
+
+	m.UntrackIf("ingress", func(stackID core.StackID) bool {
+		return stackID.Name == "group-a"
+	})
+
+	var gotStacks []trackedStackKey
+	for key := range m.trackedStacks {
+		gotStacks = append(gotStacks, key)
+	}
+	assert.ElementsMatch(t, []trackedStackKey{
+		{controllerName: "ingress", stackID: core.StackID{Name: "group-b"}},
+		{controllerName: "service", stackID: core.StackID{Namespace: "ns-1", Name: "group-a"}},
+	}, gotStacks)
+}
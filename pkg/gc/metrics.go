@@ -0,0 +1,50 @@
+package gc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemGC = "orphaned_resource_gc"
+
+	metricOrphanedResources     = "orphaned_resources"
+	metricOrphanedStackDeletion = "stack_deletions_total"
+)
+
+const (
+	labelResourceType = "resource_type"
+	labelResult       = "result"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+type instruments struct {
+	orphanedResources      *prometheus.GaugeVec
+	orphanedStackDeletions *prometheus.CounterVec
+}
+
+// newInstruments allocates and register new metrics to registerer
+func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
+	orphanedResources := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemGC,
+		Name:      metricOrphanedResources,
+		Help:      "Number of AWS resources tracked by the controller that no longer belong to any Ingress group or Service",
+	}, []string{labelResourceType})
+	orphanedStackDeletions := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemGC,
+		Name:      metricOrphanedStackDeletion,
+		Help:      "Total number of attempts to delete the AWS resources of orphaned stacks",
+	}, []string{labelResult})
+
+	if err := registerer.Register(orphanedResources); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(orphanedStackDeletions); err != nil {
+		return nil, err
+	}
+	return &instruments{
+		orphanedResources:      orphanedResources,
+		orphanedStackDeletions: orphanedStackDeletions,
+	}, nil
+}
@@ -0,0 +1,348 @@
+package gc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	ingresscontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
+	servicecontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	clusterNameTagKey = "elbv2.k8s.aws/cluster"
+
+	resourceTypeLoadBalancer  = "LoadBalancer"
+	resourceTypeTargetGroup   = "TargetGroup"
+	resourceTypeSecurityGroup = "SecurityGroup"
+)
+
+// OrphanedResourceCollector collects AWS resources that were provisioned by the controller,
+// but no longer belong to any Ingress group or Service, e.g. when finalizers are removed forcibly.
+type OrphanedResourceCollector interface {
+	// Collect reports the orphaned AWS resources,
+	// and deletes them once they've been orphaned for the grace period if deletion is enabled.
+	Collect(ctx context.Context) error
+}
+
+// NewDefaultOrphanedResourceCollector constructs new defaultOrphanedResourceCollector.
+func NewDefaultOrphanedResourceCollector(k8sReader client.Reader, elbv2TaggingManager elbv2.TaggingManager, ec2TaggingManager ec2.TaggingManager,
+	ingressStackDeployer deploy.StackDeployer, serviceStackDeployer deploy.StackDeployer, gcConfig config.OrphanedResourceGCConfig,
+	clusterName string, registerer prometheus.Registerer, logger logr.Logger) (*defaultOrphanedResourceCollector, error) {
+	instruments, err := newInstruments(registerer)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultOrphanedResourceCollector{
+		k8sReader:           k8sReader,
+		elbv2TaggingManager: elbv2TaggingManager,
+		ec2TaggingManager:   ec2TaggingManager,
+		stackDeployerByTagPrefix: map[string]deploy.StackDeployer{
+			ingresscontroller.IngressTagPrefix: ingressStackDeployer,
+			servicecontroller.ServiceTagPrefix: serviceStackDeployer,
+		},
+		gcConfig:         gcConfig,
+		clusterName:      clusterName,
+		instruments:      instruments,
+		clock:            clock.RealClock{},
+		logger:           logger,
+		orphanedSinceMap: make(map[orphanedStackKey]time.Time),
+	}, nil
+}
+
+var _ OrphanedResourceCollector = &defaultOrphanedResourceCollector{}
+var _ manager.Runnable = &defaultOrphanedResourceCollector{}
+var _ manager.LeaderElectionRunnable = &defaultOrphanedResourceCollector{}
+
+// orphanedStackKey identifies the stack of AWS resources, by the tag prefix of its controller and its stackID.
+type orphanedStackKey struct {
+	tagPrefix string
+	stackID   core.StackID
+}
+
+// orphanedResource is an AWS resource of an orphaned stack.
+type orphanedResource struct {
+	resourceType string
+	id           string
+}
+
+// default implementation for OrphanedResourceCollector.
+// the AWS resources are grouped into stacks by their stack tag, and a stack is orphaned if it isn't tracked by
+// the finalizer of any Ingress or Service, nor any TargetGroupBinding. Resources without stack tag are never orphaned.
+// orphaned stacks are deleted by deploying an empty stack, so that the deletion policy and adopted LoadBalancers are honored.
+type defaultOrphanedResourceCollector struct {
+	// k8sReader must be able to read objects from all namespaces, otherwise stacks of objects outside the cache
+	// would be deemed orphaned and deleted.
+	k8sReader                client.Reader
+	elbv2TaggingManager      elbv2.TaggingManager
+	ec2TaggingManager        ec2.TaggingManager
+	stackDeployerByTagPrefix map[string]deploy.StackDeployer
+	gcConfig                 config.OrphanedResourceGCConfig
+	clusterName              string
+	instruments              *instruments
+	clock                    clock.PassiveClock
+	logger                   logr.Logger
+
+	// orphanedSinceMap tracks the time since when stacks are orphaned.
+	orphanedSinceMap map[orphanedStackKey]time.Time
+}
+
+// Start runs the garbage collection periodically until ctx is done.
+func (c *defaultOrphanedResourceCollector) Start(ctx context.Context) error {
+	c.logger.Info("starting orphaned resource garbage collector",
+		"interval", c.gcConfig.Interval,
+		"deletionEnabled", c.gcConfig.EnableDeletion,
+		"deletionGracePeriod", c.gcConfig.DeletionGracePeriod)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Collect(ctx); err != nil {
+			c.logger.Error(err, "failed to collect orphaned resources")
+		}
+	}, c.gcConfig.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, the garbage collector only runs on the leader.
+func (c *defaultOrphanedResourceCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *defaultOrphanedResourceCollector) Collect(ctx context.Context) error {
+	// AWS resources are listed ahead of K8s objects, so that resources provisioned meanwhile are tracked by K8s objects.
+	resourcesByStack, err := c.listTrackedResources(ctx)
+	if err != nil {
+		return err
+	}
+	liveStacks, liveTGARNs, err := c.listLiveStacks(ctx)
+	if err != nil {
+		return err
+	}
+	orphanedResourcesByStack := make(map[orphanedStackKey][]orphanedResource)
+	for stackKey, resources := range resourcesByStack {
+		if liveStacks.Has(stackKey) || hasTargetGroupIn(resources, liveTGARNs) {
+			continue
+		}
+		orphanedResourcesByStack[stackKey] = resources
+	}
+	c.reportOrphanedResources(orphanedResourcesByStack)
+
+	now := c.clock.Now()
+	for stackKey := range c.orphanedSinceMap {
+		if _, orphaned := orphanedResourcesByStack[stackKey]; !orphaned {
+			delete(c.orphanedSinceMap, stackKey)
+		}
+	}
+	for stackKey := range orphanedResourcesByStack {
+		if _, exists := c.orphanedSinceMap[stackKey]; !exists {
+			c.orphanedSinceMap[stackKey] = now
+		}
+	}
+	if !c.gcConfig.EnableDeletion {
+		return nil
+	}
+	for stackKey, orphanedSince := range c.orphanedSinceMap {
+		if now.Sub(orphanedSince) < c.gcConfig.DeletionGracePeriod {
+			continue
+		}
+		if err := c.deleteOrphanedStack(ctx, stackKey); err != nil {
+			c.instruments.orphanedStackDeletions.WithLabelValues(resultError).Inc()
+			c.logger.Error(err, "failed to delete orphaned resources",
+				"tagPrefix", stackKey.tagPrefix,
+				"stackID", stackKey.stackID)
+			continue
+		}
+		c.instruments.orphanedStackDeletions.WithLabelValues(resultSuccess).Inc()
+		delete(c.orphanedSinceMap, stackKey)
+	}
+	return nil
+}
+
+// listTrackedResources lists the AWS resources with stack tag in our cluster, grouped by their stack.
+func (c *defaultOrphanedResourceCollector) listTrackedResources(ctx context.Context) (map[orphanedStackKey][]orphanedResource, error) {
+	var tagFilters []tracking.TagFilter
+	for tagPrefix := range c.stackDeployerByTagPrefix {
+		tagFilters = append(tagFilters, tracking.TagFilter{
+			clusterNameTagKey:      {c.clusterName},
+			stackTagKey(tagPrefix): nil,
+		})
+	}
+	resourcesByStack := make(map[orphanedStackKey][]orphanedResource)
+	addResource := func(resourceType string, id string, tags map[string]string) {
+		stackKey, ok := c.buildStackKey(tags)
+		if !ok {
+			return
+		}
+		resourcesByStack[stackKey] = append(resourcesByStack[stackKey], orphanedResource{
+			resourceType: resourceType,
+			id:           id,
+		})
+	}
+
+	sdkLBs, err := c.elbv2TaggingManager.ListLoadBalancers(ctx, tagFilters...)
+	if err != nil {
+		return nil, err
+	}
+	for _, sdkLB := range sdkLBs {
+		addResource(resourceTypeLoadBalancer, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), sdkLB.Tags)
+	}
+	sdkTGs, err := c.elbv2TaggingManager.ListTargetGroups(ctx, tagFilters...)
+	if err != nil {
+		return nil, err
+	}
+	for _, sdkTG := range sdkTGs {
+		addResource(resourceTypeTargetGroup, awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn), sdkTG.Tags)
+	}
+	sdkSGs, err := c.ec2TaggingManager.ListSecurityGroups(ctx, tagFilters...)
+	if err != nil {
+		return nil, err
+	}
+	for _, sdkSG := range sdkSGs {
+		addResource(resourceTypeSecurityGroup, sdkSG.SecurityGroupID, sdkSG.Tags)
+	}
+	return resourcesByStack, nil
+}
+
+// listLiveStacks lists the stacks that are still tracked by K8s objects, as well as the ARN of TargetGroups referenced by TargetGroupBindings.
+// * stacks of Ingress groups are tracked by the group finalizer on Ingresses.
+// * stacks of Services are tracked by the finalizer on Services.
+// * stacks are tracked by the stack labels on TargetGroupBindings.
+func (c *defaultOrphanedResourceCollector) listLiveStacks(ctx context.Context) (sets.Set[orphanedStackKey], sets.String, error) {
+	liveStacks := sets.New[orphanedStackKey]()
+	ingList := &networking.IngressList{}
+	if err := c.k8sReader.List(ctx, ingList); err != nil {
+		return nil, nil, err
+	}
+	for i := range ingList.Items {
+		for _, groupID := range ingress.GroupIDsFromFinalizers(&ingList.Items[i]) {
+			liveStacks.Insert(orphanedStackKey{tagPrefix: ingresscontroller.IngressTagPrefix, stackID: core.StackID(groupID)})
+		}
+	}
+	svcList := &corev1.ServiceList{}
+	if err := c.k8sReader.List(ctx, svcList); err != nil {
+		return nil, nil, err
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		if k8s.HasFinalizer(svc, servicecontroller.ServiceFinalizer) {
+			liveStacks.Insert(orphanedStackKey{tagPrefix: servicecontroller.ServiceTagPrefix, stackID: core.StackID(k8s.NamespacedName(svc))})
+		}
+	}
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := c.k8sReader.List(ctx, tgbList); err != nil {
+		return nil, nil, err
+	}
+	liveTGARNs := sets.NewString()
+	for _, tgb := range tgbList.Items {
+		liveTGARNs.Insert(tgb.Spec.TargetGroupARN)
+		for tagPrefix := range c.stackDeployerByTagPrefix {
+			if stackID, ok := parseStackLabels(tagPrefix, tgb.Labels); ok {
+				liveStacks.Insert(orphanedStackKey{tagPrefix: tagPrefix, stackID: stackID})
+			}
+		}
+	}
+	return liveStacks, liveTGARNs, nil
+}
+
+// reportOrphanedResources reports the orphaned resources via metrics and logs.
+func (c *defaultOrphanedResourceCollector) reportOrphanedResources(orphanedResourcesByStack map[orphanedStackKey][]orphanedResource) {
+	countByResourceType := map[string]int{
+		resourceTypeLoadBalancer:  0,
+		resourceTypeTargetGroup:   0,
+		resourceTypeSecurityGroup: 0,
+	}
+	for stackKey, resources := range orphanedResourcesByStack {
+		resourceIDs := make([]string, 0, len(resources))
+		for _, resource := range resources {
+			countByResourceType[resource.resourceType]++
+			resourceIDs = append(resourceIDs, resource.id)
+		}
+		c.logger.Info("found orphaned resources",
+			"tagPrefix", stackKey.tagPrefix,
+			"stackID", stackKey.stackID,
+			"resources", resourceIDs)
+	}
+	for resourceType, count := range countByResourceType {
+		c.instruments.orphanedResources.WithLabelValues(resourceType).Set(float64(count))
+	}
+}
+
+// deleteOrphanedStack deletes the AWS resources of an orphaned stack by deploying an empty stack.
+func (c *defaultOrphanedResourceCollector) deleteOrphanedStack(ctx context.Context, stackKey orphanedStackKey) error {
+	c.logger.Info("deleting orphaned resources",
+		"tagPrefix", stackKey.tagPrefix,
+		"stackID", stackKey.stackID)
+	stack := core.NewDefaultStack(stackKey.stackID)
+	if err := c.stackDeployerByTagPrefix[stackKey.tagPrefix].Deploy(ctx, stack); err != nil {
+		return err
+	}
+	c.logger.Info("deleted orphaned resources",
+		"tagPrefix", stackKey.tagPrefix,
+		"stackID", stackKey.stackID)
+	return nil
+}
+
+// buildStackKey builds the stack key of an AWS resource from its tags.
+func (c *defaultOrphanedResourceCollector) buildStackKey(tags map[string]string) (orphanedStackKey, bool) {
+	if tags[clusterNameTagKey] != c.clusterName {
+		return orphanedStackKey{}, false
+	}
+	for tagPrefix := range c.stackDeployerByTagPrefix {
+		if rawStackID, ok := tags[stackTagKey(tagPrefix)]; ok {
+			return orphanedStackKey{tagPrefix: tagPrefix, stackID: parseStackID(rawStackID)}, true
+		}
+	}
+	return orphanedStackKey{}, false
+}
+
+// hasTargetGroupIn checks whether any of the resources is a TargetGroup in tgARNs.
+func hasTargetGroupIn(resources []orphanedResource, tgARNs sets.String) bool {
+	for _, resource := range resources {
+		if resource.resourceType == resourceTypeTargetGroup && tgARNs.Has(resource.id) {
+			return true
+		}
+	}
+	return false
+}
+
+func stackTagKey(tagPrefix string) string {
+	return tagPrefix + "/stack"
+}
+
+// parseStackID parses the stackID from the value of stack tag, which is either `name` or `namespace/name`.
+func parseStackID(rawStackID string) core.StackID {
+	if namespace, name, found := strings.Cut(rawStackID, "/"); found {
+		return core.StackID{Namespace: namespace, Name: name}
+	}
+	return core.StackID{Name: rawStackID}
+}
+
+// parseStackLabels parses the stackID from the stack labels of K8s objects.
+func parseStackLabels(tagPrefix string, labels map[string]string) (core.StackID, bool) {
+	if name, ok := labels[tagPrefix+"/stack"]; ok {
+		return core.StackID{Name: name}, true
+	}
+	namespace, namespaceExists := labels[tagPrefix+"/stack-namespace"]
+	name, nameExists := labels[tagPrefix+"/stack-name"]
+	if namespaceExists && nameExists {
+		return core.StackID{Namespace: namespace, Name: name}, true
+	}
+	return core.StackID{}, false
+}
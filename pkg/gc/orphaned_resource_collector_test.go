@@ -0,0 +1,319 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	pkgnetworking "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeEC2TaggingManager is a fake ec2.TaggingManager that only lists SecurityGroups.
+type fakeEC2TaggingManager struct {
+	ec2.TaggingManager
+	sdkSGs []pkgnetworking.SecurityGroupInfo
+}
+
+func (m *fakeEC2TaggingManager) ListSecurityGroups(_ context.Context, _ ...tracking.TagFilter) ([]pkgnetworking.SecurityGroupInfo, error) {
+	return m.sdkSGs, nil
+}
+
+// fakeStackDeployer is a fake deploy.StackDeployer that records the deployed stacks.
+type fakeStackDeployer struct {
+	err              error
+	deployedStackIDs []core.StackID
+}
+
+func (d *fakeStackDeployer) Deploy(_ context.Context, stack core.Stack) error {
+	d.deployedStackIDs = append(d.deployedStackIDs, stack.StackID())
+	return d.err
+}
+
+func Test_defaultOrphanedResourceCollector_Collect(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ingressLBTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	}
+	serviceTGTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"service.k8s.aws/stack": "my-ns/my-svc",
+	}
+	serviceSGTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"service.k8s.aws/stack": "my-ns/my-svc",
+	}
+	serviceStackKey := orphanedStackKey{tagPrefix: "service.k8s.aws", stackID: core.StackID{Namespace: "my-ns", Name: "my-svc"}}
+	ingressStackKey := orphanedStackKey{tagPrefix: "ingress.k8s.aws", stackID: core.StackID{Name: "my-group"}}
+
+	type env struct {
+		ingresses []*networking.Ingress
+		services  []*corev1.Service
+		tgbs      []*elbv2api.TargetGroupBinding
+	}
+	tests := []struct {
+		name                        string
+		env                         env
+		gcConfig                    config.OrphanedResourceGCConfig
+		orphanedSinceMap            map[orphanedStackKey]time.Time
+		deployErr                   error
+		wantOrphanedSinceMap        map[orphanedStackKey]time.Time
+		wantIngressDeployedStackIDs []core.StackID
+		wantServiceDeployedStackIDs []core.StackID
+		wantOrphanedLBs             float64
+		wantOrphanedTGs             float64
+		wantOrphanedSGs             float64
+	}{
+		{
+			name: "stacks tracked by finalizers are not orphaned",
+			env: env{
+				ingresses: []*networking.Ingress{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:  "default",
+							Name:       "ing",
+							Finalizers: []string{"group.ingress.k8s.aws/my-group"},
+						},
+					},
+				},
+				services: []*corev1.Service{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:  "my-ns",
+							Name:       "my-svc",
+							Finalizers: []string{"service.k8s.aws/resources"},
+						},
+					},
+				},
+			},
+			gcConfig:             config.OrphanedResourceGCConfig{EnableDeletion: true},
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{},
+		},
+		{
+			name: "stack with TargetGroup referenced by TargetGroupBinding is not orphaned",
+			env: env{
+				ingresses: []*networking.Ingress{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:  "default",
+							Name:       "ing",
+							Finalizers: []string{"group.ingress.k8s.aws/my-group"},
+						},
+					},
+				},
+				tgbs: []*elbv2api.TargetGroupBinding{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "my-ns",
+							Name:      "my-tgb",
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupARN: "tg-arn",
+						},
+					},
+				},
+			},
+			gcConfig:             config.OrphanedResourceGCConfig{EnableDeletion: true},
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{},
+		},
+		{
+			name: "stack tracked by TargetGroupBinding labels is not orphaned",
+			env: env{
+				tgbs: []*elbv2api.TargetGroupBinding{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "my-ns",
+							Name:      "my-tgb",
+							Labels: map[string]string{
+								"service.k8s.aws/stack-namespace": "my-ns",
+								"service.k8s.aws/stack-name":      "my-svc",
+							},
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupARN: "another-tg-arn",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "ing-tgb",
+							Labels: map[string]string{
+								"ingress.k8s.aws/stack": "my-group",
+							},
+						},
+						Spec: elbv2api.TargetGroupBindingSpec{
+							TargetGroupARN: "yet-another-tg-arn",
+						},
+					},
+				},
+			},
+			gcConfig:             config.OrphanedResourceGCConfig{EnableDeletion: true},
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{},
+		},
+		{
+			name:     "orphaned stacks are only reported when deletion is disabled",
+			gcConfig: config.OrphanedResourceGCConfig{EnableDeletion: false},
+			orphanedSinceMap: map[orphanedStackKey]time.Time{
+				serviceStackKey: now.Add(-2 * time.Hour),
+			},
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{
+				ingressStackKey: now,
+				serviceStackKey: now.Add(-2 * time.Hour),
+			},
+			wantOrphanedLBs: 1,
+			wantOrphanedTGs: 1,
+			wantOrphanedSGs: 1,
+		},
+		{
+			name:     "orphaned stacks are deleted after the grace period",
+			gcConfig: config.OrphanedResourceGCConfig{EnableDeletion: true, DeletionGracePeriod: time.Hour},
+			orphanedSinceMap: map[orphanedStackKey]time.Time{
+				serviceStackKey: now.Add(-2 * time.Hour),
+			},
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{
+				ingressStackKey: now,
+			},
+			wantServiceDeployedStackIDs: []core.StackID{{Namespace: "my-ns", Name: "my-svc"}},
+			wantOrphanedLBs:             1,
+			wantOrphanedTGs:             1,
+			wantOrphanedSGs:             1,
+		},
+		{
+			name:     "orphaned stacks that failed deletion are retried",
+			gcConfig: config.OrphanedResourceGCConfig{EnableDeletion: true, DeletionGracePeriod: time.Hour},
+			orphanedSinceMap: map[orphanedStackKey]time.Time{
+				ingressStackKey: now.Add(-2 * time.Hour),
+				serviceStackKey: now.Add(-2 * time.Hour),
+			},
+			deployErr: errors.New("some error"),
+			wantOrphanedSinceMap: map[orphanedStackKey]time.Time{
+				ingressStackKey: now.Add(-2 * time.Hour),
+				serviceStackKey: now.Add(-2 * time.Hour),
+			},
+			wantIngressDeployedStackIDs: []core.StackID{{Name: "my-group"}},
+			wantServiceDeployedStackIDs: []core.StackID{{Namespace: "my-ns", Name: "my-svc"}},
+			wantOrphanedLBs:             1,
+			wantOrphanedTGs:             1,
+			wantOrphanedSGs:             1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]elbv2.LoadBalancerWithTags{
+				{
+					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")},
+					Tags:         ingressLBTags,
+				},
+			}, nil)
+			elbv2TaggingManager.EXPECT().ListTargetGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return([]elbv2.TargetGroupWithTags{
+				{
+					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-arn")},
+					Tags:        serviceTGTags,
+				},
+			}, nil)
+			ec2TaggingManager := &fakeEC2TaggingManager{
+				sdkSGs: []pkgnetworking.SecurityGroupInfo{
+					{
+						SecurityGroupID: "sg-a",
+						Tags:            serviceSGTags,
+					},
+					{
+						SecurityGroupID: "sg-backend",
+						Tags: map[string]string{
+							"elbv2.k8s.aws/cluster":  "cluster-name",
+							"elbv2.k8s.aws/resource": "backend-sg",
+						},
+					},
+				},
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := fake.NewClientBuilder().WithScheme(k8sSchema).Build()
+			var objs []client.Object
+			for _, ing := range tt.env.ingresses {
+				objs = append(objs, ing.DeepCopy())
+			}
+			for _, svc := range tt.env.services {
+				objs = append(objs, svc.DeepCopy())
+			}
+			for _, tgb := range tt.env.tgbs {
+				objs = append(objs, tgb.DeepCopy())
+			}
+			for _, obj := range objs {
+				assert.NoError(t, k8sClient.Create(context.Background(), obj))
+			}
+
+			ingressStackDeployer := &fakeStackDeployer{err: tt.deployErr}
+			serviceStackDeployer := &fakeStackDeployer{err: tt.deployErr}
+			c, err := NewDefaultOrphanedResourceCollector(k8sClient, elbv2TaggingManager, ec2TaggingManager,
+				ingressStackDeployer, serviceStackDeployer, tt.gcConfig, "cluster-name", prometheus.NewRegistry(), logr.Discard())
+			assert.NoError(t, err)
+			c.clock = clocktesting.NewFakePassiveClock(now)
+			for stackKey, orphanedSince := range tt.orphanedSinceMap {
+				c.orphanedSinceMap[stackKey] = orphanedSince
+			}
+
+			err = c.Collect(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOrphanedSinceMap, c.orphanedSinceMap)
+			assert.Equal(t, tt.wantIngressDeployedStackIDs, ingressStackDeployer.deployedStackIDs)
+			assert.Equal(t, tt.wantServiceDeployedStackIDs, serviceStackDeployer.deployedStackIDs)
+			assert.Equal(t, tt.wantOrphanedLBs, testutil.ToFloat64(c.instruments.orphanedResources.WithLabelValues(resourceTypeLoadBalancer)))
+			assert.Equal(t, tt.wantOrphanedTGs, testutil.ToFloat64(c.instruments.orphanedResources.WithLabelValues(resourceTypeTargetGroup)))
+			assert.Equal(t, tt.wantOrphanedSGs, testutil.ToFloat64(c.instruments.orphanedResources.WithLabelValues(resourceTypeSecurityGroup)))
+		})
+	}
+}
+
+func Test_parseStackID(t *testing.T) {
+	tests := []struct {
+		name       string
+		rawStackID string
+		want       core.StackID
+	}{
+		{
+			name:       "explicit stack",
+			rawStackID: "my-group",
+			want:       core.StackID{Name: "my-group"},
+		},
+		{
+			name:       "namespaced stack",
+			rawStackID: "my-ns/my-svc",
+			want:       core.StackID{Namespace: "my-ns", Name: "my-svc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseStackID(tt.rawStackID)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
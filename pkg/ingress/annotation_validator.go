@@ -0,0 +1,217 @@
+package ingress
+
+import (
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+const (
+	actionsAnnotationSuffixPrefix = "actions."
+)
+
+// AnnotationValidator validates ALB annotations on Ingress.
+type AnnotationValidator interface {
+	// Validate validates ALB annotations on Ingress.
+	// when oldIng is specified, annotations that are unchanged from oldIng are not validated,
+	// so that existing Ingresses can still be updated(e.g. finalizers) without fixing unrelated annotations.
+	Validate(ing *networking.Ingress, oldIng *networking.Ingress) error
+}
+
+// NewDefaultAnnotationValidator constructs new defaultAnnotationValidator.
+func NewDefaultAnnotationValidator(annotationParser annotations.Parser) *defaultAnnotationValidator {
+	return &defaultAnnotationValidator{
+		annotationParser: annotationParser,
+	}
+}
+
+var _ AnnotationValidator = &defaultAnnotationValidator{}
+
+// default implementation for AnnotationValidator.
+// it parses annotations with the same annotationParser and validations the model builder uses.
+type defaultAnnotationValidator struct {
+	annotationParser annotations.Parser
+}
+
+func (v *defaultAnnotationValidator) Validate(ing *networking.Ingress, oldIng *networking.Ingress) error {
+	annotationPrefix := annotations.AnnotationPrefixIngress + "/"
+	var annotationKeys []string
+	for key, value := range ing.Annotations {
+		if !strings.HasPrefix(key, annotationPrefix) {
+			continue
+		}
+		if oldIng != nil {
+			if oldValue, exists := oldIng.Annotations[key]; exists && oldValue == value {
+				continue
+			}
+		}
+		annotationKeys = append(annotationKeys, key)
+	}
+	sort.Strings(annotationKeys)
+
+	for _, key := range annotationKeys {
+		suffix := strings.TrimPrefix(key, annotationPrefix)
+		if err := v.validateAnnotation(suffix, ing.Annotations); err != nil {
+			return errors.Wrapf(err, "invalid %v annotation", key)
+		}
+	}
+	return nil
+}
+
+// validateAnnotation validates the annotation with specified suffix.
+// annotations without structured value(e.g. load-balancer-name, certificate-arn) are not validated.
+func (v *defaultAnnotationValidator) validateAnnotation(suffix string, ingAnnotations map[string]string) error {
+	switch suffix {
+	case annotations.IngressSuffixGroupOrder:
+		return v.validateGroupOrder(suffix, ingAnnotations)
+	case annotations.IngressSuffixTags, annotations.IngressSuffixLoadBalancerAttributes,
+		annotations.IngressSuffixTargetGroupAttributes, annotations.IngressSuffixTargetNodeLabels:
+		var value map[string]string
+		_, err := v.annotationParser.ParseStringMapAnnotation(suffix, &value, ingAnnotations)
+		return err
+	case annotations.IngressSuffixShieldAdvancedProtection, annotations.IngressSuffixManageSecurityGroupRules,
+		annotations.IngressSuffixUnmanagedListeners:
+		var value bool
+		_, err := v.annotationParser.ParseBoolAnnotation(suffix, &value, ingAnnotations)
+		return err
+	case annotations.IngressSuffixHealthCheckIntervalSeconds, annotations.IngressSuffixHealthCheckTimeoutSeconds,
+		annotations.IngressSuffixHealthyThresholdCount, annotations.IngressSuffixUnhealthyThresholdCount,
+		annotations.IngressSuffixAuthSessionTimeout:
+		var value int64
+		_, err := v.annotationParser.ParseInt64Annotation(suffix, &value, ingAnnotations)
+		return err
+	case annotations.IngressSuffixClaimedListenerPorts:
+		var rawClaimedPorts []string
+		_ = v.annotationParser.ParseStringSliceAnnotation(suffix, &rawClaimedPorts, ingAnnotations)
+		_, err := parseClaimedListenerPorts(rawClaimedPorts)
+		return err
+	case annotations.IngressSuffixSSLRedirect:
+		return v.validateSSLRedirect(suffix, ingAnnotations)
+	case annotations.IngressSuffixInboundCIDRs:
+		return v.validateInboundCIDRs(suffix, ingAnnotations)
+	case annotations.IngressSuffixListenPorts:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseListenPorts(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixMutualAuthentication:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			entries, err := parseMutualAuthenticationConfigs(rawValue)
+			if err != nil {
+				return err
+			}
+			_, err = parseMtlsConfigEntries(entries)
+			return err
+		})
+	case annotations.IngressSuffixScheme:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseLoadBalancerScheme(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixDeletionPolicy:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseLoadBalancerDeletionPolicy(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixIPAddressType:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseLoadBalancerIPAddressType(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixTargetType:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseTargetType(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixBackendProtocol:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseBackendProtocol(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixBackendProtocolVersion:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseBackendProtocolVersion(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixHealthCheckProtocol:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseHealthCheckProtocol(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixAuthType:
+		return v.validateStringAnnotation(suffix, ingAnnotations, func(rawValue string) error {
+			_, err := parseAuthType(rawValue)
+			return err
+		})
+	case annotations.IngressSuffixAuthOnUnauthenticatedRequest:
+		return v.validateStringAnnotation(suffix, ingAnnotations, validateAuthOnUnauthenticatedRequest)
+	case annotations.IngressSuffixAuthIDPCognito:
+		authIDP := AuthIDPConfigCognito{}
+		if _, err := v.annotationParser.ParseJSONAnnotation(suffix, &authIDP, ingAnnotations); err != nil {
+			return err
+		}
+		return authIDP.validate()
+	case annotations.IngressSuffixAuthIDPOIDC:
+		authIDP := AuthIDPConfigOIDC{}
+		if _, err := v.annotationParser.ParseJSONAnnotation(suffix, &authIDP, ingAnnotations); err != nil {
+			return err
+		}
+		return authIDP.validate()
+	}
+
+	if strings.HasPrefix(suffix, actionsAnnotationSuffixPrefix) {
+		action := Action{}
+		if _, err := v.annotationParser.ParseJSONAnnotation(suffix, &action, ingAnnotations); err != nil {
+			return err
+		}
+		return action.validate()
+	}
+	return nil
+}
+
+func (v *defaultAnnotationValidator) validateStringAnnotation(suffix string, ingAnnotations map[string]string, validateFunc func(rawValue string) error) error {
+	rawValue := ""
+	if exists := v.annotationParser.ParseStringAnnotation(suffix, &rawValue, ingAnnotations); !exists {
+		return nil
+	}
+	return validateFunc(rawValue)
+}
+
+func (v *defaultAnnotationValidator) validateGroupOrder(suffix string, ingAnnotations map[string]string) error {
+	var order int64
+	exists, err := v.annotationParser.ParseInt64Annotation(suffix, &order, ingAnnotations)
+	if err != nil {
+		return err
+	}
+	if exists && (order < minGroupOrder || order > maxGroupOder) {
+		return errors.Errorf("explicit Ingress group order must be within [%v:%v]: %v", minGroupOrder, maxGroupOder, order)
+	}
+	return nil
+}
+
+func (v *defaultAnnotationValidator) validateSSLRedirect(suffix string, ingAnnotations map[string]string) error {
+	var sslRedirectPort int64
+	exists, err := v.annotationParser.ParseInt64Annotation(suffix, &sslRedirectPort, ingAnnotations)
+	if err != nil {
+		return err
+	}
+	if exists && (sslRedirectPort < 1 || sslRedirectPort > 65535) {
+		return errors.Errorf("SSLRedirect port must be within [1, 65535]: %v", sslRedirectPort)
+	}
+	return nil
+}
+
+func (v *defaultAnnotationValidator) validateInboundCIDRs(suffix string, ingAnnotations map[string]string) error {
+	var rawInboundCIDRs []string
+	_ = v.annotationParser.ParseStringSliceAnnotation(suffix, &rawInboundCIDRs, ingAnnotations)
+	for _, cidr := range rawInboundCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Wrapf(err, "invalid CIDR %v", cidr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_defaultAnnotationValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		oldAnnotations map[string]string
+		isUpdate       bool
+		wantErr        string
+	}{
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				"kubernetes.io/ingress.class":                               "alb",
+				"alb.ingress.kubernetes.io/scheme":                          "internet-facing",
+				"alb.ingress.kubernetes.io/ip-address-type":                 "dualstack",
+				"alb.ingress.kubernetes.io/group.order":                     "10",
+				"alb.ingress.kubernetes.io/listen-ports":                    `[{"HTTP": 80}, {"HTTPS": 443}]`,
+				"alb.ingress.kubernetes.io/ssl-redirect":                    "443",
+				"alb.ingress.kubernetes.io/inbound-cidrs":                   "10.0.0.0/8, 2001:db8::/32",
+				"alb.ingress.kubernetes.io/load-balancer-attributes":        "idle_timeout.timeout_seconds=600",
+				"alb.ingress.kubernetes.io/target-group-attributes":         "deregistration_delay.timeout_seconds=30",
+				"alb.ingress.kubernetes.io/tags":                            "env=prod,team=web",
+				"alb.ingress.kubernetes.io/target-type":                     "ip",
+				"alb.ingress.kubernetes.io/backend-protocol":                "HTTPS",
+				"alb.ingress.kubernetes.io/backend-protocol-version":        "GRPC",
+				"alb.ingress.kubernetes.io/healthcheck-protocol":            "HTTP",
+				"alb.ingress.kubernetes.io/healthy-threshold-count":         "3",
+				"alb.ingress.kubernetes.io/shield-advanced-protection":      "true",
+				"alb.ingress.kubernetes.io/mutual-authentication":           `[{"port": 443, "mode": "verify", "trustStore": "my-trust-store"}]`,
+				"alb.ingress.kubernetes.io/auth-type":                       "oidc",
+				"alb.ingress.kubernetes.io/auth-idp-oidc":                   `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret"}`,
+				"alb.ingress.kubernetes.io/auth-on-unauthenticated-request": "deny",
+				"alb.ingress.kubernetes.io/actions.response-503":            `{"type":"fixed-response","fixedResponseConfig":{"contentType":"text/plain","statusCode":"503","messageBody":"503 error text"}}`,
+				"alb.ingress.kubernetes.io/load-balancer-name":              "my-lb",
+			},
+		},
+		{
+			name: "invalid listen-ports",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 80}, {"HTTPS": 99999}]`,
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/listen-ports annotation: listen port must be within [1, 65535]: 99999",
+		},
+		{
+			name: "malformed listen-ports",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 80}`,
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/listen-ports annotation: failed to parse listen-ports configuration: `[{\"HTTP\": 80}`: unexpected end of JSON input",
+		},
+		{
+			name: "invalid claimed-listener-ports",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/unmanaged-listeners":    "true",
+				"alb.ingress.kubernetes.io/claimed-listener-ports": "443, 8443x",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/claimed-listener-ports annotation: invalid claimed listener port: 8443x",
+		},
+		{
+			name: "invalid actions",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/actions.ssl-redirect": `{"type":"redirect"}`,
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/actions.ssl-redirect annotation: missing RedirectConfig",
+		},
+		{
+			name: "invalid load-balancer-attributes",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds:600",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/load-balancer-attributes annotation: failed to parse stringMap annotation, alb.ingress.kubernetes.io/load-balancer-attributes: idle_timeout.timeout_seconds:600",
+		},
+		{
+			name: "invalid target-group-attributes",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "stickiness.enabled",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/target-group-attributes annotation: failed to parse stringMap annotation, alb.ingress.kubernetes.io/target-group-attributes: stickiness.enabled",
+		},
+		{
+			name: "invalid auth-idp-oidc",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/auth-idp-oidc": `{"isuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret"}`,
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/auth-idp-oidc annotation: issuer is required",
+		},
+		{
+			name: "invalid scheme",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internet-facin",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/scheme annotation: unknown scheme: internet-facin",
+		},
+		{
+			name: "invalid deletion-policy",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/deletion-policy": "retain",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/deletion-policy annotation: unknown deletion policy: retain",
+		},
+		{
+			name: "invalid group.order",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "1001",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/group.order annotation: explicit Ingress group order must be within [-1000:1000]: 1001",
+		},
+		{
+			name: "invalid inbound-cidrs",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/inbound-cidrs": "10.0.0.0/33",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/inbound-cidrs annotation: invalid CIDR 10.0.0.0/33: invalid CIDR address: 10.0.0.0/33",
+		},
+		{
+			name: "invalid mutual-authentication",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify"}]`,
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/mutual-authentication annotation: trustStore is required when mutualAuthentication mode is verify for port 443",
+		},
+		{
+			name: "invalid auth-on-unauthenticated-request",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/auth-on-unauthenticated-request": "reject",
+			},
+			wantErr: "invalid alb.ingress.kubernetes.io/auth-on-unauthenticated-request annotation: onUnauthenticatedRequest must be within [authenticate, allow, deny]: reject",
+		},
+		{
+			name: "unchanged invalid annotation on update",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/backend-protocol": "TCP",
+			},
+			oldAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/backend-protocol": "TCP",
+			},
+			isUpdate: true,
+		},
+		{
+			name: "changed invalid annotation on update",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/backend-protocol": "TCP",
+			},
+			oldAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/backend-protocol": "HTTP",
+			},
+			isUpdate: true,
+			wantErr:  "invalid alb.ingress.kubernetes.io/backend-protocol annotation: backend protocol must be within [HTTP, HTTPS]: TCP",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewDefaultAnnotationValidator(annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress))
+			ing := &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1", Annotations: tt.annotations},
+			}
+			var oldIng *networking.Ingress
+			if tt.isUpdate {
+				oldIng = &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1", Annotations: tt.oldAnnotations},
+				}
+			}
+			err := v.Validate(ing, oldIng)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
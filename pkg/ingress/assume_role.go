@@ -0,0 +1,66 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// AssumeRole is the IAM role to assume for provisioning AWS resources of an IngressGroup.
+// It's empty if AWS resources are provisioned with the controller's own credentials.
+type AssumeRole struct {
+	RoleARN    string
+	ExternalID string
+}
+
+// ResolveGroupAssumeRole resolves the IAM role to assume for IngressGroup from the IngressClassParams of its members.
+// All members must use the same IAM role, since the AWS resources of IngressGroup are provisioned in a single AWS account.
+// If IngressGroup has no active members, the IAM role is resolved from its inactive members, so that AWS resources are deleted
+// from the AWS account they were provisioned in. It fails if the IngressClass of any inactive member cannot be loaded,
+// since the AWS account they were provisioned in is unknown then.
+func ResolveGroupAssumeRole(ctx context.Context, classLoader ClassLoader, ingGroup Group) (AssumeRole, error) {
+	var classParamsList []*elbv2api.IngressClassParams
+	if len(ingGroup.Members) != 0 {
+		for _, member := range ingGroup.Members {
+			classParamsList = append(classParamsList, member.IngClassConfig.IngClassParams)
+		}
+	} else {
+		for _, ing := range ingGroup.InactiveMembers {
+			ingClassConfig, err := classLoader.Load(ctx, ing.DeepCopy())
+			if err != nil {
+				return AssumeRole{}, errors.Wrapf(err, "failed to resolve IAM role to assume for Ingress %v", k8s.NamespacedName(ing))
+			}
+			classParamsList = append(classParamsList, ingClassConfig.IngClassParams)
+		}
+	}
+
+	var assumeRole AssumeRole
+	for i, classParams := range classParamsList {
+		var memberAssumeRole AssumeRole
+		if classParams != nil {
+			memberAssumeRole = AssumeRole{
+				RoleARN:    classParams.Spec.IAMRoleARNToAssume,
+				ExternalID: classParams.Spec.AssumeRoleExternalID,
+			}
+		}
+		if i == 0 {
+			assumeRole = memberAssumeRole
+			continue
+		}
+		if memberAssumeRole != assumeRole {
+			return AssumeRole{}, errors.Errorf("conflicting IAM roles to assume within IngressGroup %v: %v | %v",
+				ingGroup.ID, displayRoleARN(assumeRole), displayRoleARN(memberAssumeRole))
+		}
+	}
+	return assumeRole, nil
+}
+
+// displayRoleARN returns the IAM role ARN of assumeRole for messages, without revealing the external ID.
+func displayRoleARN(assumeRole AssumeRole) string {
+	if len(assumeRole.RoleARN) == 0 {
+		return "<none>"
+	}
+	return assumeRole.RoleARN
+}
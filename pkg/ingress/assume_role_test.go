@@ -0,0 +1,175 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_ResolveGroupAssumeRole(t *testing.T) {
+	crossAccountParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cross-account",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			IAMRoleARNToAssume:   "arn:aws:iam::123456789012:role/lbc-networking",
+			AssumeRoleExternalID: "my-cluster",
+		},
+	}
+	otherAccountParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "other-account",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			IAMRoleARNToAssume: "arn:aws:iam::210987654321:role/lbc-networking",
+		},
+	}
+	crossAccountIngClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cross-account",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: aws.String("elbv2.k8s.aws"),
+				Kind:     "IngressClassParams",
+				Name:     "cross-account",
+			},
+		},
+	}
+	buildIngress := func(name string, ingClassName string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: aws.String(ingClassName),
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     AssumeRole
+		wantErr  error
+	}{
+		{
+			name: "members without IngressClassParams",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []ClassifiedIngress{
+					{Ing: buildIngress("ing-1", "alb")},
+				},
+			},
+			want: AssumeRole{},
+		},
+		{
+			name: "members with same IAM role",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []ClassifiedIngress{
+					{
+						Ing:            buildIngress("ing-1", "cross-account"),
+						IngClassConfig: ClassConfiguration{IngClassParams: crossAccountParams},
+					},
+					{
+						Ing:            buildIngress("ing-2", "cross-account"),
+						IngClassConfig: ClassConfiguration{IngClassParams: crossAccountParams},
+					},
+				},
+			},
+			want: AssumeRole{
+				RoleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+				ExternalID: "my-cluster",
+			},
+		},
+		{
+			name: "members with conflicting IAM roles",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []ClassifiedIngress{
+					{
+						Ing:            buildIngress("ing-1", "cross-account"),
+						IngClassConfig: ClassConfiguration{IngClassParams: crossAccountParams},
+					},
+					{
+						Ing:            buildIngress("ing-2", "other-account"),
+						IngClassConfig: ClassConfiguration{IngClassParams: otherAccountParams},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting IAM roles to assume within IngressGroup awesome-group: arn:aws:iam::123456789012:role/lbc-networking | arn:aws:iam::210987654321:role/lbc-networking"),
+		},
+		{
+			name: "members with and without IAM role",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []ClassifiedIngress{
+					{
+						Ing: buildIngress("ing-1", "alb"),
+					},
+					{
+						Ing:            buildIngress("ing-2", "cross-account"),
+						IngClassConfig: ClassConfiguration{IngClassParams: crossAccountParams},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting IAM roles to assume within IngressGroup awesome-group: <none> | arn:aws:iam::123456789012:role/lbc-networking"),
+		},
+		{
+			name: "inactive members only",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				InactiveMembers: []*networking.Ingress{
+					buildIngress("ing-1", "cross-account"),
+					buildIngress("ing-2", "cross-account"),
+				},
+			},
+			want: AssumeRole{
+				RoleARN:    "arn:aws:iam::123456789012:role/lbc-networking",
+				ExternalID: "my-cluster",
+			},
+		},
+		{
+			name: "inactive members with deleted IngressClass",
+			ingGroup: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				InactiveMembers: []*networking.Ingress{
+					buildIngress("ing-1", "cross-account"),
+					buildIngress("ing-2", "deleted-class"),
+				},
+			},
+			wantErr: errors.New("failed to resolve IAM role to assume for Ingress awesome-ns/ing-2: invalid ingress class: ingressclasses.networking.k8s.io \"deleted-class\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, crossAccountIngClass.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, crossAccountParams.DeepCopy()))
+
+			classLoader := NewDefaultClassLoader(k8sClient, true)
+			got, err := ResolveGroupAssumeRole(ctx, classLoader, tt.ingGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -49,7 +49,10 @@ func (b *defaultAuthConfigBuilder) Build(ctx context.Context, svcAndIngAnnotatio
 	if err != nil {
 		return AuthConfig{}, err
 	}
-	authOnUnauthenticatedRequest := b.buildAuthOnUnauthenticatedRequest(ctx, svcAndIngAnnotations)
+	authOnUnauthenticatedRequest, err := b.buildAuthOnUnauthenticatedRequest(ctx, svcAndIngAnnotations)
+	if err != nil {
+		return AuthConfig{}, err
+	}
 	authScope := b.buildAuthScope(ctx, svcAndIngAnnotations)
 	authSessionCookieName := b.buildAuthSessionCookieName(ctx, svcAndIngAnnotations)
 	authSessionTimeout, err := b.buildAuthSessionTimeout(ctx, svcAndIngAnnotations)
@@ -81,6 +84,11 @@ func (b *defaultAuthConfigBuilder) Build(ctx context.Context, svcAndIngAnnotatio
 func (b *defaultAuthConfigBuilder) buildAuthType(_ context.Context, svcAndIngAnnotations map[string]string) (AuthType, error) {
 	rawAuthType := string(defaultAuthType)
 	_ = b.annotationParser.ParseStringAnnotation(annotations.IngressSuffixAuthType, &rawAuthType, svcAndIngAnnotations)
+	return parseAuthType(rawAuthType)
+}
+
+// parseAuthType parses the AuthType from its raw value.
+func parseAuthType(rawAuthType string) (AuthType, error) {
 	switch rawAuthType {
 	case string(AuthTypeCognito):
 		return AuthTypeCognito, nil
@@ -102,6 +110,9 @@ func (b *defaultAuthConfigBuilder) buildAuthIDPConfigCognito(_ context.Context,
 	if !exists {
 		return nil, nil
 	}
+	if err := authIDP.validate(); err != nil {
+		return nil, err
+	}
 	return &authIDP, nil
 }
 
@@ -114,13 +125,29 @@ func (b *defaultAuthConfigBuilder) buildAuthIDPConfigOIDC(_ context.Context, svc
 	if !exists {
 		return nil, nil
 	}
+	if err := authIDP.validate(); err != nil {
+		return nil, err
+	}
 	return &authIDP, nil
 }
 
-func (b *defaultAuthConfigBuilder) buildAuthOnUnauthenticatedRequest(_ context.Context, svcAndIngAnnotations map[string]string) string {
+func (b *defaultAuthConfigBuilder) buildAuthOnUnauthenticatedRequest(_ context.Context, svcAndIngAnnotations map[string]string) (string, error) {
 	rawOnUnauthenticatedRequest := defaultAuthOnUnauthenticatedRequest
 	_ = b.annotationParser.ParseStringAnnotation(annotations.IngressSuffixAuthOnUnauthenticatedRequest, &rawOnUnauthenticatedRequest, svcAndIngAnnotations)
-	return rawOnUnauthenticatedRequest
+	if err := validateAuthOnUnauthenticatedRequest(rawOnUnauthenticatedRequest); err != nil {
+		return "", err
+	}
+	return rawOnUnauthenticatedRequest, nil
+}
+
+// validateAuthOnUnauthenticatedRequest validates the behavior on unauthenticated request.
+func validateAuthOnUnauthenticatedRequest(rawOnUnauthenticatedRequest string) error {
+	switch rawOnUnauthenticatedRequest {
+	case "authenticate", "allow", "deny":
+		return nil
+	default:
+		return errors.Errorf("onUnauthenticatedRequest must be within [authenticate, allow, deny]: %v", rawOnUnauthenticatedRequest)
+	}
 }
 
 func (b *defaultAuthConfigBuilder) buildAuthScope(_ context.Context, svcAndIngAnnotations map[string]string) string {
@@ -0,0 +1,67 @@
+package ingress
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BackendGrantChecker checks whether cross-namespace Service references are permitted by BackendGrants.
+type BackendGrantChecker interface {
+	// IsServiceReferencePermitted checks whether objects in fromNamespace are permitted to reference the Service identified by svcKey.
+	// references within the same namespace are always permitted.
+	IsServiceReferencePermitted(ctx context.Context, fromNamespace string, svcKey types.NamespacedName) (bool, error)
+}
+
+// NewDefaultBackendGrantChecker constructs new defaultBackendGrantChecker.
+func NewDefaultBackendGrantChecker(k8sClient client.Client) *defaultBackendGrantChecker {
+	return &defaultBackendGrantChecker{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ BackendGrantChecker = &defaultBackendGrantChecker{}
+
+// default implementation for BackendGrantChecker
+type defaultBackendGrantChecker struct {
+	k8sClient client.Client
+}
+
+func (c *defaultBackendGrantChecker) IsServiceReferencePermitted(ctx context.Context, fromNamespace string, svcKey types.NamespacedName) (bool, error) {
+	if fromNamespace == svcKey.Namespace {
+		return true, nil
+	}
+	grantList := &elbv2api.BackendGrantList{}
+	if err := c.k8sClient.List(ctx, grantList, client.InNamespace(svcKey.Namespace)); err != nil {
+		return false, err
+	}
+	for _, grant := range grantList.Items {
+		if isServiceReferencePermittedByGrant(grant, fromNamespace, svcKey.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isServiceReferencePermittedByGrant checks whether specific BackendGrant permits reference to Service from namespace.
+func isServiceReferencePermittedByGrant(grant elbv2api.BackendGrant, fromNamespace string, svcName string) bool {
+	fromMatches := false
+	for _, from := range grant.Spec.From {
+		if from.Namespace == fromNamespace {
+			fromMatches = true
+			break
+		}
+	}
+	if !fromMatches {
+		return false
+	}
+	for _, to := range grant.Spec.To {
+		if to.Name == nil || awssdk.StringValue(to.Name) == svcName {
+			return true
+		}
+	}
+	return false
+}
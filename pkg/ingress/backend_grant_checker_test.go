@@ -0,0 +1,132 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultBackendGrantChecker_IsServiceReferencePermitted(t *testing.T) {
+	grantAuthProxy := &elbv2api.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "allow-auth-proxy",
+		},
+		Spec: elbv2api.BackendGrantSpec{
+			From: []elbv2api.BackendGrantFrom{
+				{
+					Namespace: "awesome-ns",
+				},
+			},
+			To: []elbv2api.BackendGrantTo{
+				{
+					Name: awssdk.String("auth-proxy"),
+				},
+			},
+		},
+	}
+	grantAllServices := &elbv2api.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "allow-all",
+		},
+		Spec: elbv2api.BackendGrantSpec{
+			From: []elbv2api.BackendGrantFrom{
+				{
+					Namespace: "other-ns",
+				},
+			},
+			To: []elbv2api.BackendGrantTo{
+				{},
+			},
+		},
+	}
+	type env struct {
+		grants []*elbv2api.BackendGrant
+	}
+	type args struct {
+		fromNamespace string
+		svcKey        types.NamespacedName
+	}
+	tests := []struct {
+		name string
+		env  env
+		args args
+		want bool
+	}{
+		{
+			name: "same namespace is always permitted",
+			args: args{
+				fromNamespace: "awesome-ns",
+				svcKey:        types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"},
+			},
+			want: true,
+		},
+		{
+			name: "no BackendGrant in target namespace",
+			args: args{
+				fromNamespace: "awesome-ns",
+				svcKey:        types.NamespacedName{Namespace: "shared-ns", Name: "auth-proxy"},
+			},
+			want: false,
+		},
+		{
+			name: "permitted by BackendGrant for specific service",
+			env: env{
+				grants: []*elbv2api.BackendGrant{grantAuthProxy, grantAllServices},
+			},
+			args: args{
+				fromNamespace: "awesome-ns",
+				svcKey:        types.NamespacedName{Namespace: "shared-ns", Name: "auth-proxy"},
+			},
+			want: true,
+		},
+		{
+			name: "BackendGrant doesn't cover the service",
+			env: env{
+				grants: []*elbv2api.BackendGrant{grantAuthProxy, grantAllServices},
+			},
+			args: args{
+				fromNamespace: "awesome-ns",
+				svcKey:        types.NamespacedName{Namespace: "shared-ns", Name: "metrics"},
+			},
+			want: false,
+		},
+		{
+			name: "permitted by BackendGrant for all services",
+			env: env{
+				grants: []*elbv2api.BackendGrant{grantAuthProxy, grantAllServices},
+			},
+			args: args{
+				fromNamespace: "other-ns",
+				svcKey:        types.NamespacedName{Namespace: "shared-ns", Name: "metrics"},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, grant := range tt.env.grants {
+				assert.NoError(t, k8sClient.Create(ctx, grant.DeepCopy()))
+			}
+
+			c := NewDefaultBackendGrantChecker(k8sClient)
+			got, err := c.IsServiceReferencePermitted(ctx, tt.args.fromNamespace, tt.args.svcKey)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
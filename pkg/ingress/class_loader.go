@@ -3,6 +3,7 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -100,16 +101,20 @@ func (l *defaultClassLoader) Load(ctx context.Context, ing *networking.Ingress)
 	}
 
 	if ingClass.Spec.Parameters.APIGroup == nil ||
-		(*ingClass.Spec.Parameters.APIGroup) != elbv2api.GroupVersion.Group ||
-		ingClass.Spec.Parameters.Kind != ingressClassParamsKind {
+		(*ingClass.Spec.Parameters.APIGroup) != elbv2api.GroupVersion.Group {
 		return ClassConfiguration{}, fmt.Errorf("%w: IngressClass %v references unknown parameters", ErrInvalidIngressClass, ingClass.Name)
 	}
-	ingClassParamsKey := types.NamespacedName{Name: ingClass.Spec.Parameters.Name}
-	ingClassParams := &elbv2api.IngressClassParams{}
-	if err := l.client.Get(ctx, ingClassParamsKey, ingClassParams); err != nil {
-		if apierrors.IsNotFound(err) {
-			return ClassConfiguration{}, fmt.Errorf("%w: %v", ErrInvalidIngressClass, err.Error())
-		}
+	var ingClassParams *elbv2api.IngressClassParams
+	var err error
+	switch ingClass.Spec.Parameters.Kind {
+	case ingressClassParamsKind:
+		ingClassParams, err = l.loadIngressClassParams(ctx, ingClass)
+	case namespacedIngressClassParamsKind:
+		ingClassParams, err = l.loadNamespacedIngressClassParams(ctx, ingClass)
+	default:
+		return ClassConfiguration{}, fmt.Errorf("%w: IngressClass %v references unknown parameters", ErrInvalidIngressClass, ingClass.Name)
+	}
+	if err != nil {
 		return ClassConfiguration{}, err
 	}
 	if err := l.validateIngressClassParamsNamespaceRestriction(ctx, ing, ingClassParams); err != nil {
@@ -122,6 +127,48 @@ func (l *defaultClassLoader) Load(ctx context.Context, ing *networking.Ingress)
 	}, nil
 }
 
+// loadIngressClassParams loads the cluster-scoped IngressClassParams referenced by IngressClass.
+func (l *defaultClassLoader) loadIngressClassParams(ctx context.Context, ingClass *networking.IngressClass) (*elbv2api.IngressClassParams, error) {
+	ingClassParamsKey := types.NamespacedName{Name: ingClass.Spec.Parameters.Name}
+	ingClassParams := &elbv2api.IngressClassParams{}
+	if err := l.client.Get(ctx, ingClassParamsKey, ingClassParams); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidIngressClass, err.Error())
+		}
+		return nil, err
+	}
+	return ingClassParams, nil
+}
+
+// loadNamespacedIngressClassParams loads the namespace-scoped NamespacedIngressClassParams referenced by IngressClass,
+// and merges it into the IngressClassParams whose tenantPolicy covers IngressClass.
+func (l *defaultClassLoader) loadNamespacedIngressClassParams(ctx context.Context, ingClass *networking.IngressClass) (*elbv2api.IngressClassParams, error) {
+	paramsRef := ingClass.Spec.Parameters
+	if paramsRef.Scope == nil || *paramsRef.Scope != networking.IngressClassParametersReferenceScopeNamespace || paramsRef.Namespace == nil {
+		return nil, fmt.Errorf("%w: IngressClass %v must reference %v with Namespace scope", ErrInvalidIngressClass, ingClass.Name, namespacedIngressClassParamsKind)
+	}
+	nsParamsKey := types.NamespacedName{Namespace: *paramsRef.Namespace, Name: paramsRef.Name}
+	nsParams := &elbv2api.NamespacedIngressClassParams{}
+	if err := l.client.Get(ctx, nsParamsKey, nsParams); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidIngressClass, err.Error())
+		}
+		return nil, err
+	}
+	baseParams, err := FindTenantPolicyIngressClassParams(ctx, l.client, ingClass.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIngressClass, err.Error())
+	}
+	if baseParams == nil {
+		return nil, fmt.Errorf("%w: no IngressClassParams defines tenantPolicy for IngressClass %v", ErrInvalidIngressClass, ingClass.Name)
+	}
+	if violations := FindTenantPolicyViolations(*baseParams.Spec.TenantPolicy, nsParams.Spec); len(violations) != 0 {
+		return nil, fmt.Errorf("%w: NamespacedIngressClassParams %v violates tenantPolicy of IngressClassParams %v: %v",
+			ErrInvalidIngressClass, nsParamsKey, baseParams.Name, strings.Join(violations, "; "))
+	}
+	return mergeNamespacedIngressClassParams(baseParams, nsParams), nil
+}
+
 func (l *defaultClassLoader) validateIngressClassParamsNamespaceRestriction(ctx context.Context, ing *networking.Ingress, ingClassParams *elbv2api.IngressClassParams) error {
 	// when namespaceSelector is empty, it matches every namespace
 	if ingClassParams.Spec.NamespaceSelector == nil {
@@ -27,10 +27,57 @@ func Test_defaultClassLoader_Load(t *testing.T) {
 		nsList             []*corev1.Namespace
 		ingClassList       []*networking.IngressClass
 		ingClassParamsList []*elbv2api.IngressClassParams
+		nsParamsList       []*elbv2api.NamespacedIngressClassParams
 	}
 	type args struct {
 		ing *networking.Ingress
 	}
+	namespaceScope := networking.IngressClassParametersReferenceScopeNamespace
+	tenantIngClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup:  aws.String("elbv2.k8s.aws"),
+				Kind:      "NamespacedIngressClassParams",
+				Name:      "tenant-params",
+				Scope:     &namespaceScope,
+				Namespace: aws.String("tenant-ns"),
+			},
+		},
+	}
+	tenantPolicyParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-policy",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			Scheme:    (*elbv2api.LoadBalancerScheme)(aws.String("internal")),
+			SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+			TenantPolicy: &elbv2api.TenantPolicy{
+				IngressClassNames: []string{"tenant-class"},
+				AllowedOverrides: []elbv2api.TenantOverride{
+					{
+						Field:         elbv2api.TenantOverrideFieldScheme,
+						AllowedValues: []string{"internal", "internet-facing"},
+					},
+					{
+						Field: elbv2api.TenantOverrideFieldTags,
+					},
+				},
+			},
+		},
+	}
+	tenantIng := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "tenant-ns",
+			Name:      "awesome-ing",
+		},
+		Spec: networking.IngressSpec{
+			IngressClassName: aws.String("tenant-class"),
+		},
+	}
 	tests := []struct {
 		name    string
 		env     env
@@ -637,6 +684,109 @@ func Test_defaultClassLoader_Load(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "when IngressClass is ALB - with valid NamespacedIngressClassParams",
+			env: env{
+				ingClassList:       []*networking.IngressClass{tenantIngClass},
+				ingClassParamsList: []*elbv2api.IngressClassParams{tenantPolicyParams},
+				nsParamsList: []*elbv2api.NamespacedIngressClassParams{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "tenant-ns",
+							Name:      "tenant-params",
+						},
+						Spec: elbv2api.NamespacedIngressClassParamsSpec{
+							Scheme: (*elbv2api.LoadBalancerScheme)(aws.String("internet-facing")),
+							Tags: []elbv2api.Tag{
+								{Key: "team", Value: "tenant"},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ing: tenantIng,
+			},
+			want: ClassConfiguration{
+				IngClass: tenantIngClass,
+				IngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "tenant-policy",
+					},
+					Spec: elbv2api.IngressClassParamsSpec{
+						Scheme:    (*elbv2api.LoadBalancerScheme)(aws.String("internet-facing")),
+						SSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						Tags: []elbv2api.Tag{
+							{Key: "team", Value: "tenant"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "when IngressClass is ALB - with NamespacedIngressClassParams violating tenantPolicy",
+			env: env{
+				ingClassList:       []*networking.IngressClass{tenantIngClass},
+				ingClassParamsList: []*elbv2api.IngressClassParams{tenantPolicyParams},
+				nsParamsList: []*elbv2api.NamespacedIngressClassParams{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "tenant-ns",
+							Name:      "tenant-params",
+						},
+						Spec: elbv2api.NamespacedIngressClassParamsSpec{
+							SSLPolicy: "ELBSecurityPolicy-2016-08",
+						},
+					},
+				},
+			},
+			args: args{
+				ing: tenantIng,
+			},
+			wantErr: errors.New("invalid ingress class: NamespacedIngressClassParams tenant-ns/tenant-params violates tenantPolicy of IngressClassParams tenant-policy: field sslPolicy is not allowed to be overridden"),
+		},
+		{
+			name: "when IngressClass is ALB - with NamespacedIngressClassParams without tenantPolicy",
+			env: env{
+				ingClassList: []*networking.IngressClass{tenantIngClass},
+				nsParamsList: []*elbv2api.NamespacedIngressClassParams{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "tenant-ns",
+							Name:      "tenant-params",
+						},
+					},
+				},
+			},
+			args: args{
+				ing: tenantIng,
+			},
+			wantErr: errors.New("invalid ingress class: no IngressClassParams defines tenantPolicy for IngressClass tenant-class"),
+		},
+		{
+			name: "when IngressClass is ALB - with NamespacedIngressClassParams of Cluster scope",
+			env: env{
+				ingClassList: []*networking.IngressClass{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "tenant-class",
+						},
+						Spec: networking.IngressClassSpec{
+							Controller: "ingress.k8s.aws/alb",
+							Parameters: &networking.IngressClassParametersReference{
+								APIGroup: aws.String("elbv2.k8s.aws"),
+								Kind:     "NamespacedIngressClassParams",
+								Name:     "tenant-params",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ing: tenantIng,
+			},
+			wantErr: errors.New("invalid ingress class: IngressClass tenant-class must reference NamespacedIngressClassParams with Namespace scope"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -658,6 +808,9 @@ func Test_defaultClassLoader_Load(t *testing.T) {
 			for _, ingClassParams := range tt.env.ingClassParamsList {
 				assert.NoError(t, k8sClient.Create(ctx, ingClassParams.DeepCopy()))
 			}
+			for _, nsParams := range tt.env.nsParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, nsParams.DeepCopy()))
+			}
 
 			l := &defaultClassLoader{
 				client:     k8sClient,
@@ -1,8 +1,10 @@
 package ingress
 
 import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -72,6 +74,11 @@ type TargetGroupTuple struct {
 	// the K8s service Name
 	ServiceName *string `json:"serviceName"`
 
+	// the K8s service Namespace, defaults to the namespace of the referencing object.
+	// Services in another namespace must be permitted by a BackendGrant in that namespace.
+	// +optional
+	ServiceNamespace *string `json:"serviceNamespace,omitempty"`
+
 	// the K8s service port
 	ServicePort *intstr.IntOrString `json:"servicePort"`
 
@@ -88,9 +95,21 @@ func (t *TargetGroupTuple) validate() error {
 	if t.ServiceName != nil && t.ServicePort == nil {
 		return errors.New("missing servicePort")
 	}
+	if t.ServiceNamespace != nil && t.ServiceName == nil {
+		return errors.New("serviceNamespace can only be specified with serviceName")
+	}
 	return nil
 }
 
+// serviceKey returns the key of referenced K8s service, where defaultNamespace is the namespace of the referencing object.
+func (t *TargetGroupTuple) serviceKey(defaultNamespace string) types.NamespacedName {
+	namespace := defaultNamespace
+	if t.ServiceNamespace != nil {
+		namespace = *t.ServiceNamespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: awssdk.StringValue(t.ServiceName)}
+}
+
 // Information about the target group stickiness for a rule.
 type TargetGroupStickinessConfig struct {
 	// Indicates whether target group stickiness is enabled.
@@ -393,6 +412,19 @@ type AuthIDPConfigCognito struct {
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
 }
 
+func (c *AuthIDPConfigCognito) validate() error {
+	if len(c.UserPoolARN) == 0 {
+		return errors.New("userPoolARN is required")
+	}
+	if len(c.UserPoolClientID) == 0 {
+		return errors.New("userPoolClientID is required")
+	}
+	if len(c.UserPoolDomain) == 0 {
+		return errors.New("userPoolDomain is required")
+	}
+	return nil
+}
+
 // configuration for IDP of OIDC
 type AuthIDPConfigOIDC struct {
 	// The OIDC issuer identifier of the IdP.
@@ -414,3 +446,22 @@ type AuthIDPConfigOIDC struct {
 	// +optional
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
 }
+
+func (c *AuthIDPConfigOIDC) validate() error {
+	if len(c.Issuer) == 0 {
+		return errors.New("issuer is required")
+	}
+	if len(c.AuthorizationEndpoint) == 0 {
+		return errors.New("authorizationEndpoint is required")
+	}
+	if len(c.TokenEndpoint) == 0 {
+		return errors.New("tokenEndpoint is required")
+	}
+	if len(c.UserInfoEndpoint) == 0 {
+		return errors.New("userInfoEndpoint is required")
+	}
+	if len(c.SecretName) == 0 {
+		return errors.New("secretName is required")
+	}
+	return nil
+}
@@ -11,7 +11,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +19,8 @@ import (
 
 const (
 	magicServicePortUseAnnotation = "use-annotation"
+	// the Kind for ListenerAction CRD.
+	listenerActionKind = "ListenerAction"
 
 	// the message body of fixed 503 response used when referencing a non-existent Kubernetes service as backend.
 	nonExistentBackendServiceMessageBody = "Backend service does not exist"
@@ -29,6 +31,7 @@ const (
 // EnhancedBackend is an enhanced version of Ingress backend.
 // It contains additional routing conditions and authentication configurations we parsed from annotations.
 // Also, when magic string `use-annotation` is specified as backend, the actions will be parsed from annotations as well.
+// When a ListenerAction resource is specified as backend, the actions will be loaded from that ListenerAction.
 type EnhancedBackend struct {
 	Conditions []RuleCondition
 	Action     Action
@@ -76,11 +79,12 @@ type EnhancedBackendBuilder interface {
 }
 
 // NewDefaultEnhancedBackendBuilder constructs new defaultEnhancedBackendBuilder.
-func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder, tolerateNonExistentBackendService bool, tolerateNonExistentBackendAction bool) *defaultEnhancedBackendBuilder {
+func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder, backendGrantChecker BackendGrantChecker, tolerateNonExistentBackendService bool, tolerateNonExistentBackendAction bool) *defaultEnhancedBackendBuilder {
 	return &defaultEnhancedBackendBuilder{
 		k8sClient:                         k8sClient,
 		annotationParser:                  annotationParser,
 		authConfigBuilder:                 authConfigBuilder,
+		backendGrantChecker:               backendGrantChecker,
 		tolerateNonExistentBackendService: tolerateNonExistentBackendService,
 		tolerateNonExistentBackendAction:  tolerateNonExistentBackendAction,
 	}
@@ -90,9 +94,10 @@ var _ EnhancedBackendBuilder = &defaultEnhancedBackendBuilder{}
 
 // default implementation for defaultEnhancedBackendBuilder
 type defaultEnhancedBackendBuilder struct {
-	k8sClient         client.Client
-	annotationParser  annotations.Parser
-	authConfigBuilder AuthConfigBuilder
+	k8sClient           client.Client
+	annotationParser    annotations.Parser
+	authConfigBuilder   AuthConfigBuilder
+	backendGrantChecker BackendGrantChecker
 
 	// whether to tolerate misconfiguration that used a non-existent backend service.
 	// when tolerate, If a single backend service is used and it's non-existent, a fixed 503 response will be used instead.
@@ -110,25 +115,35 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 	}
 	buildOpts.ApplyOptions(opts...)
 
-	if backend.Service == nil {
-		return EnhancedBackend{}, errors.New("missing required \"service\" field")
-	}
-
-	conditions, err := b.buildConditions(ctx, ing.Annotations, backend.Service.Name)
-	if err != nil {
-		return EnhancedBackend{}, err
-	}
-
+	var backendName string
 	var action Action
-	if backend.Service.Port.Name == magicServicePortUseAnnotation {
-		action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.Service.Name)
+	var listenerActionAuthCfg *AuthConfig
+	var err error
+	if isListenerActionBackend(backend) {
+		backendName = backend.Resource.Name
+		action, listenerActionAuthCfg, err = b.buildActionViaListenerAction(ctx, ing.Namespace, backendName)
 		if err != nil {
 			return EnhancedBackend{}, err
 		}
-	} else if backend.Service.Port.Name != "" {
-		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, intstr.FromString(backend.Service.Port.Name))
+	} else if backend.Service != nil {
+		backendName = backend.Service.Name
+		if backend.Service.Port.Name == magicServicePortUseAnnotation {
+			action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backendName)
+			if err != nil {
+				return EnhancedBackend{}, err
+			}
+		} else if backend.Service.Port.Name != "" {
+			action = b.buildActionViaServiceAndServicePort(ctx, backendName, intstr.FromString(backend.Service.Port.Name))
+		} else {
+			action = b.buildActionViaServiceAndServicePort(ctx, backendName, intstr.FromInt(int(backend.Service.Port.Number)))
+		}
 	} else {
-		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, intstr.FromInt(int(backend.Service.Port.Number)))
+		return EnhancedBackend{}, errors.New("missing required \"service\" field")
+	}
+
+	conditions, err := b.buildConditions(ctx, ing.Annotations, backendName)
+	if err != nil {
+		return EnhancedBackend{}, err
 	}
 
 	var authCfg AuthConfig
@@ -138,9 +153,13 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 		}
 
 		if buildOpts.LoadAuthConfig {
-			authCfg, err = b.buildAuthConfig(ctx, action, ing.Namespace, ing.Annotations, buildOpts.BackendServices)
-			if err != nil {
-				return EnhancedBackend{}, err
+			if listenerActionAuthCfg != nil {
+				authCfg = *listenerActionAuthCfg
+			} else {
+				authCfg, err = b.buildAuthConfig(ctx, action, ing.Namespace, ing.Annotations, buildOpts.BackendServices)
+				if err != nil {
+					return EnhancedBackend{}, err
+				}
 			}
 		}
 	}
@@ -189,6 +208,35 @@ func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Con
 	return action, nil
 }
 
+// buildActionViaListenerAction will build the backend action and optional auth configuration specified via ListenerAction resource.
+func (b *defaultEnhancedBackendBuilder) buildActionViaListenerAction(ctx context.Context, namespace string, name string) (Action, *AuthConfig, error) {
+	listenerActionKey := types.NamespacedName{Namespace: namespace, Name: name}
+	listenerAction := &elbv2api.ListenerAction{}
+	if err := b.k8sClient.Get(ctx, listenerActionKey, listenerAction); err != nil {
+		if apierrors.IsNotFound(err) && b.tolerateNonExistentBackendAction {
+			return b.build503ResponseAction(nonExistentBackendActionMessageBody), nil, nil
+		}
+		return Action{}, nil, err
+	}
+
+	if err := validateListenerActionSpec(listenerAction.Spec); err != nil {
+		return Action{}, nil, errors.Wrapf(err, "invalid ListenerAction: %v", listenerActionKey)
+	}
+	action := convertListenerActionSpecToAction(listenerAction.Spec)
+	if err := action.validate(); err != nil {
+		return Action{}, nil, errors.Wrapf(err, "invalid ListenerAction: %v", listenerActionKey)
+	}
+	b.normalizeServicePortForBackwardsCompatibility(ctx, &action)
+	if listenerAction.Spec.AuthenticateConfig == nil {
+		return action, nil, nil
+	}
+	authCfg, err := convertAuthenticateActionConfigToAuthConfig(*listenerAction.Spec.AuthenticateConfig)
+	if err != nil {
+		return Action{}, nil, errors.Wrapf(err, "invalid ListenerAction: %v", listenerActionKey)
+	}
+	return action, &authCfg, nil
+}
+
 // buildActionViaServiceAndServicePort will build the backend Action that forward to specified Kubernetes Service.
 func (b *defaultEnhancedBackendBuilder) buildActionViaServiceAndServicePort(_ context.Context, svcName string, svcPort intstr.IntOrString) Action {
 	action := Action{
@@ -237,23 +285,26 @@ func (b *defaultEnhancedBackendBuilder) normalizeServicePortForBackwardsCompatib
 
 // loadBackendServices will load referenced backend services into backendServices.
 // when tolerateNonExistentBackendService==true, and forward to a single non-existent Kubernetes Service, a fixed 503 response instead.
+// Services in another namespace will only be loaded when permitted by a BackendGrant.
 func (b *defaultEnhancedBackendBuilder) loadBackendServices(ctx context.Context, action *Action, namespace string,
 	backendServices map[types.NamespacedName]*corev1.Service) error {
 	if action.Type == ActionTypeForward && action.ForwardConfig != nil {
-		svcNames := sets.NewString()
+		svcKeys := make(map[types.NamespacedName]struct{})
 		for _, tgt := range action.ForwardConfig.TargetGroups {
 			if tgt.ServiceName != nil {
-				svcNames.Insert(awssdk.StringValue(tgt.ServiceName))
+				svcKeys[tgt.serviceKey(namespace)] = struct{}{}
 			}
 		}
-		forwardToSingleSvc := (len(action.ForwardConfig.TargetGroups) == 1) && (svcNames.Len() == 1)
+		forwardToSingleSvc := (len(action.ForwardConfig.TargetGroups) == 1) && (len(svcKeys) == 1)
 		tolerateNonExistentBackendService := b.tolerateNonExistentBackendService && forwardToSingleSvc
-		for svcName := range svcNames {
-			svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
+		// backendServices might be shared by Ingresses in different namespaces, so references are checked even for loaded Services.
+		if err := CheckBackendServiceReferences(ctx, b.backendGrantChecker, namespace, *action); err != nil {
+			return err
+		}
+		for svcKey := range svcKeys {
 			if _, ok := backendServices[svcKey]; ok {
 				continue
 			}
-
 			svc := &corev1.Service{}
 			if err := b.k8sClient.Get(ctx, svcKey, svc); err != nil {
 				if apierrors.IsNotFound(err) && tolerateNonExistentBackendService {
@@ -268,6 +319,28 @@ func (b *defaultEnhancedBackendBuilder) loadBackendServices(ctx context.Context,
 	return nil
 }
 
+// CheckBackendServiceReferences checks the Services referenced by action from namespace are permitted,
+// Services in another namespace must be permitted by a BackendGrant.
+func CheckBackendServiceReferences(ctx context.Context, backendGrantChecker BackendGrantChecker, namespace string, action Action) error {
+	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
+		return nil
+	}
+	for _, tgt := range action.ForwardConfig.TargetGroups {
+		if tgt.ServiceName == nil {
+			continue
+		}
+		svcKey := tgt.serviceKey(namespace)
+		permitted, err := backendGrantChecker.IsServiceReferencePermitted(ctx, namespace, svcKey)
+		if err != nil {
+			return err
+		}
+		if !permitted {
+			return errors.Errorf("reference to service %v from namespace %v is not permitted by any BackendGrant", svcKey, namespace)
+		}
+	}
+	return nil
+}
+
 func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, action Action, namespace string, ingAnnotation map[string]string, backendServices map[types.NamespacedName]*corev1.Service) (AuthConfig, error) {
 	svcAndIngAnnotations := ingAnnotation
 	// when forward to a single Service, the auth annotations on that Service will be merged in.
@@ -275,8 +348,7 @@ func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, act
 		action.ForwardConfig != nil &&
 		len(action.ForwardConfig.TargetGroups) == 1 &&
 		action.ForwardConfig.TargetGroups[0].ServiceName != nil {
-		svcName := awssdk.StringValue(action.ForwardConfig.TargetGroups[0].ServiceName)
-		svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
+		svcKey := action.ForwardConfig.TargetGroups[0].serviceKey(namespace)
 		svc := backendServices[svcKey]
 		svcAndIngAnnotations = algorithm.MergeStringMap(svc.Annotations, svcAndIngAnnotations)
 	}
@@ -295,3 +367,133 @@ func (b *defaultEnhancedBackendBuilder) build503ResponseAction(messageBody strin
 		},
 	}
 }
+
+// isListenerActionBackend checks whether the Ingress backend references a ListenerAction resource.
+func isListenerActionBackend(backend networking.IngressBackend) bool {
+	return backend.Resource != nil &&
+		backend.Resource.APIGroup != nil &&
+		(*backend.Resource.APIGroup) == elbv2api.GroupVersion.Group &&
+		backend.Resource.Kind == listenerActionKind
+}
+
+// validateListenerActionSpec rejects the combinations of ListenerAction configurations that are not supported.
+// they're rejected by the CRD validation as well, but ListenerActions might be created before it's in place.
+func validateListenerActionSpec(spec elbv2api.ListenerActionSpec) error {
+	if spec.Type != elbv2api.ListenerActionTypeForward && spec.ForwardConfig != nil {
+		return errors.Errorf("forwardConfig cannot be specified for %v action", spec.Type)
+	}
+	if spec.Type != elbv2api.ListenerActionTypeRedirect && spec.RedirectConfig != nil {
+		return errors.Errorf("redirectConfig cannot be specified for %v action", spec.Type)
+	}
+	if spec.Type != elbv2api.ListenerActionTypeFixedResponse && spec.FixedResponseConfig != nil {
+		return errors.Errorf("fixedResponseConfig cannot be specified for %v action", spec.Type)
+	}
+	if authCfg := spec.AuthenticateConfig; authCfg != nil {
+		if authCfg.Type != elbv2api.AuthenticateTypeCognito && authCfg.Cognito != nil {
+			return errors.Errorf("cognito cannot be specified for %v authentication", authCfg.Type)
+		}
+		if authCfg.Type != elbv2api.AuthenticateTypeOIDC && authCfg.OIDC != nil {
+			return errors.Errorf("oidc cannot be specified for %v authentication", authCfg.Type)
+		}
+	}
+	return nil
+}
+
+// convertListenerActionSpecToAction converts the ListenerAction spec into Action.
+func convertListenerActionSpecToAction(spec elbv2api.ListenerActionSpec) Action {
+	action := Action{
+		Type: ActionType(spec.Type),
+	}
+	if spec.FixedResponseConfig != nil {
+		action.FixedResponseConfig = &FixedResponseActionConfig{
+			ContentType: spec.FixedResponseConfig.ContentType,
+			MessageBody: spec.FixedResponseConfig.MessageBody,
+			StatusCode:  spec.FixedResponseConfig.StatusCode,
+		}
+	}
+	if spec.RedirectConfig != nil {
+		action.RedirectConfig = &RedirectActionConfig{
+			Host:       spec.RedirectConfig.Host,
+			Path:       spec.RedirectConfig.Path,
+			Port:       spec.RedirectConfig.Port,
+			Protocol:   spec.RedirectConfig.Protocol,
+			Query:      spec.RedirectConfig.Query,
+			StatusCode: spec.RedirectConfig.StatusCode,
+		}
+	}
+	if spec.ForwardConfig != nil {
+		targetGroups := make([]TargetGroupTuple, 0, len(spec.ForwardConfig.TargetGroups))
+		for _, tgt := range spec.ForwardConfig.TargetGroups {
+			targetGroups = append(targetGroups, TargetGroupTuple{
+				TargetGroupARN:   tgt.TargetGroupARN,
+				ServiceName:      tgt.ServiceName,
+				ServiceNamespace: tgt.ServiceNamespace,
+				ServicePort:      tgt.ServicePort,
+				Weight:           tgt.Weight,
+			})
+		}
+		var stickinessCfg *TargetGroupStickinessConfig
+		if spec.ForwardConfig.TargetGroupStickinessConfig != nil {
+			stickinessCfg = &TargetGroupStickinessConfig{
+				Enabled:         spec.ForwardConfig.TargetGroupStickinessConfig.Enabled,
+				DurationSeconds: spec.ForwardConfig.TargetGroupStickinessConfig.DurationSeconds,
+			}
+		}
+		action.ForwardConfig = &ForwardActionConfig{
+			TargetGroups:                targetGroups,
+			TargetGroupStickinessConfig: stickinessCfg,
+		}
+	}
+	return action
+}
+
+// convertAuthenticateActionConfigToAuthConfig converts the ListenerAction authenticate configuration into AuthConfig.
+func convertAuthenticateActionConfigToAuthConfig(cfg elbv2api.AuthenticateActionConfig) (AuthConfig, error) {
+	authCfg := AuthConfig{
+		OnUnauthenticatedRequest: defaultAuthOnUnauthenticatedRequest,
+		Scope:                    defaultAuthScope,
+		SessionCookieName:        defaultAuthSessionCookieName,
+		SessionTimeout:           defaultAuthSessionTimeout,
+	}
+	switch cfg.Type {
+	case elbv2api.AuthenticateTypeCognito:
+		if cfg.Cognito == nil {
+			return AuthConfig{}, errors.New("missing cognito configuration")
+		}
+		authCfg.Type = AuthTypeCognito
+		authCfg.IDPConfigCognito = &AuthIDPConfigCognito{
+			UserPoolARN:                      cfg.Cognito.UserPoolARN,
+			UserPoolClientID:                 cfg.Cognito.UserPoolClientID,
+			UserPoolDomain:                   cfg.Cognito.UserPoolDomain,
+			AuthenticationRequestExtraParams: cfg.Cognito.AuthenticationRequestExtraParams,
+		}
+	case elbv2api.AuthenticateTypeOIDC:
+		if cfg.OIDC == nil {
+			return AuthConfig{}, errors.New("missing oidc configuration")
+		}
+		authCfg.Type = AuthTypeOIDC
+		authCfg.IDPConfigOIDC = &AuthIDPConfigOIDC{
+			Issuer:                           cfg.OIDC.Issuer,
+			AuthorizationEndpoint:            cfg.OIDC.AuthorizationEndpoint,
+			TokenEndpoint:                    cfg.OIDC.TokenEndpoint,
+			UserInfoEndpoint:                 cfg.OIDC.UserInfoEndpoint,
+			SecretName:                       cfg.OIDC.SecretName,
+			AuthenticationRequestExtraParams: cfg.OIDC.AuthenticationRequestExtraParams,
+		}
+	default:
+		return AuthConfig{}, errors.Errorf("unknown authenticate type: %v", cfg.Type)
+	}
+	if cfg.OnUnauthenticatedRequest != nil {
+		authCfg.OnUnauthenticatedRequest = string(*cfg.OnUnauthenticatedRequest)
+	}
+	if cfg.Scope != nil {
+		authCfg.Scope = awssdk.StringValue(cfg.Scope)
+	}
+	if cfg.SessionCookieName != nil {
+		authCfg.SessionCookieName = awssdk.StringValue(cfg.SessionCookieName)
+	}
+	if cfg.SessionTimeout != nil {
+		authCfg.SessionTimeout = awssdk.Int64Value(cfg.SessionTimeout)
+	}
+	return authCfg, nil
+}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -22,7 +23,8 @@ import (
 
 func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 	type env struct {
-		svcs []*corev1.Service
+		svcs            []*corev1.Service
+		listenerActions []*elbv2api.ListenerAction
 	}
 	type fields struct {
 		tolerateNonExistentBackendService bool
@@ -45,6 +47,46 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 	}
 	portHTTP := intstr.FromString("http")
 	backendPortHTTP := networking.ServiceBackendPort{Name: "http"}
+	listenerActionForward := &elbv2api.ListenerAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "forward-action",
+		},
+		Spec: elbv2api.ListenerActionSpec{
+			Type: elbv2api.ListenerActionTypeForward,
+			ForwardConfig: &elbv2api.ForwardActionConfig{
+				TargetGroups: []elbv2api.TargetGroupTuple{
+					{
+						ServiceName: awssdk.String("svc-1"),
+						ServicePort: &portHTTP,
+					},
+				},
+			},
+		},
+	}
+	listenerActionFixedResponseWithAuth := &elbv2api.ListenerAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "fixed-response-action",
+		},
+		Spec: elbv2api.ListenerActionSpec{
+			Type: elbv2api.ListenerActionTypeFixedResponse,
+			FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+				ContentType: awssdk.String("text/plain"),
+				StatusCode:  "404",
+			},
+			AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+				Type: elbv2api.AuthenticateTypeCognito,
+				Cognito: &elbv2api.AuthenticateCognitoConfig{
+					UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abcdefg",
+					UserPoolClientID: "client-id",
+					UserPoolDomain:   "my-domain",
+				},
+				Scope: awssdk.String("email"),
+			},
+		},
+	}
+	listenerActionGroup := awssdk.String("elbv2.k8s.aws")
 	tests := []struct {
 		name                string
 		env                 env
@@ -622,16 +664,194 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 			},
 			wantErr: errors.New("missing required \"service\" field"),
 		},
+		{
+			name: "listenerAction backend with forward action",
+			env: env{
+				svcs:            []*corev1.Service{svc1},
+				listenerActions: []*elbv2api.ListenerAction{listenerActionForward},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: listenerActionGroup,
+						Kind:     "ListenerAction",
+						Name:     "forward-action",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &portHTTP,
+							},
+						},
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}: svc1,
+			},
+		},
+		{
+			name: "listenerAction backend with fixed-response action and authenticate config",
+			env: env{
+				listenerActions: []*elbv2api.ListenerAction{listenerActionFixedResponseWithAuth},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/conditions.fixed-response-action": `[{"field":"source-ip","sourceIpConfig":{"values":["192.168.0.0/16"]}}]`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: listenerActionGroup,
+						Kind:     "ListenerAction",
+						Name:     "fixed-response-action",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Conditions: []RuleCondition{
+					{
+						Field: RuleConditionFieldSourceIP,
+						SourceIPConfig: &SourceIPConditionConfig{
+							Values: []string{"192.168.0.0/16"},
+						},
+					},
+				},
+				Action: Action{
+					Type: ActionTypeFixedResponse,
+					FixedResponseConfig: &FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "404",
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type: AuthTypeCognito,
+					IDPConfigCognito: &AuthIDPConfigCognito{
+						UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abcdefg",
+						UserPoolClientID: "client-id",
+						UserPoolDomain:   "my-domain",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+		{
+			name: "non-existent listenerAction backend - tolerate",
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: listenerActionGroup,
+						Kind:     "ListenerAction",
+						Name:     "non-existent-action",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      false,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeFixedResponse,
+					FixedResponseConfig: &FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "503",
+						MessageBody: awssdk.String("Backend action does not exist"),
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+		{
+			name: "non-existent listenerAction backend - not tolerate",
+			fields: fields{
+				tolerateNonExistentBackendService: false,
+				tolerateNonExistentBackendAction:  false,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Annotations: map[string]string{},
+					},
+				},
+				backend: networking.IngressBackend{
+					Resource: &corev1.TypedLocalObjectReference{
+						APIGroup: listenerActionGroup,
+						Kind:     "ListenerAction",
+						Name:     "non-existent-action",
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("listeneractions.elbv2.k8s.aws \"non-existent-action\" not found"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
 			k8sSchema := runtime.NewScheme()
 			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
 			for _, svc := range tt.env.svcs {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
+			for _, listenerAction := range tt.env.listenerActions {
+				assert.NoError(t, k8sClient.Create(ctx, listenerAction.DeepCopy()))
+			}
 
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
@@ -639,6 +859,7 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 				k8sClient:                         k8sClient,
 				annotationParser:                  annotationParser,
 				authConfigBuilder:                 authConfigBuilder,
+				backendGrantChecker:               NewDefaultBackendGrantChecker(k8sClient),
 				tolerateNonExistentBackendService: tt.fields.tolerateNonExistentBackendService,
 				tolerateNonExistentBackendAction:  tt.fields.tolerateNonExistentBackendAction,
 			}
@@ -1325,9 +1546,50 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 			Name:      "svc-2",
 		},
 	}
+	sharedSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "auth-proxy",
+		},
+	}
+	grantForAwesomeNS := &elbv2api.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "allow-awesome-ns",
+		},
+		Spec: elbv2api.BackendGrantSpec{
+			From: []elbv2api.BackendGrantFrom{
+				{
+					Namespace: "awesome-ns",
+				},
+			},
+			To: []elbv2api.BackendGrantTo{
+				{
+					Name: awssdk.String("auth-proxy"),
+				},
+			},
+		},
+	}
+	grantForOtherNS := &elbv2api.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "allow-other-ns",
+		},
+		Spec: elbv2api.BackendGrantSpec{
+			From: []elbv2api.BackendGrantFrom{
+				{
+					Namespace: "other-ns",
+				},
+			},
+			To: []elbv2api.BackendGrantTo{
+				{},
+			},
+		},
+	}
 
 	type env struct {
-		svcs []*corev1.Service
+		svcs   []*corev1.Service
+		grants []*elbv2api.BackendGrant
 	}
 	type fields struct {
 		tolerateNonExistentBackendService bool
@@ -1592,19 +1854,92 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 			},
 			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
 		},
+		{
+			name: "forward to a service in another namespace - permitted by BackendGrant",
+			env: env{
+				svcs:   []*corev1.Service{sharedSvc},
+				grants: []*elbv2api.BackendGrant{grantForOtherNS, grantForAwesomeNS},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+			},
+			args: args{
+				action: &Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName:      awssdk.String("auth-proxy"),
+								ServiceNamespace: awssdk.String("shared-ns"),
+								ServicePort:      &port80,
+							},
+						},
+					},
+				},
+				namespace:       "awesome-ns",
+				backendServices: map[types.NamespacedName]*corev1.Service{},
+			},
+			wantAction: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName:      awssdk.String("auth-proxy"),
+							ServiceNamespace: awssdk.String("shared-ns"),
+							ServicePort:      &port80,
+						},
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "shared-ns", Name: "auth-proxy"}: sharedSvc,
+			},
+		},
+		{
+			name: "forward to a service in another namespace - not permitted by BackendGrant",
+			env: env{
+				svcs:   []*corev1.Service{sharedSvc},
+				grants: []*elbv2api.BackendGrant{grantForOtherNS},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+			},
+			args: args{
+				action: &Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName:      awssdk.String("auth-proxy"),
+								ServiceNamespace: awssdk.String("shared-ns"),
+								ServicePort:      &port80,
+							},
+						},
+					},
+				},
+				namespace:       "awesome-ns",
+				backendServices: map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("reference to service shared-ns/auth-proxy from namespace awesome-ns is not permitted by any BackendGrant"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
 			k8sSchema := runtime.NewScheme()
 			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
 			for _, svc := range tt.env.svcs {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
+			for _, grant := range tt.env.grants {
+				assert.NoError(t, k8sClient.Create(ctx, grant.DeepCopy()))
+			}
 
 			b := &defaultEnhancedBackendBuilder{
 				k8sClient:                         k8sClient,
+				backendGrantChecker:               NewDefaultBackendGrantChecker(k8sClient),
 				tolerateNonExistentBackendService: tt.fields.tolerateNonExistentBackendService,
 			}
 			err := b.loadBackendServices(ctx, tt.args.action, tt.args.namespace, tt.args.backendServices)
@@ -1804,3 +2139,98 @@ func Test_defaultEnhancedBackendBuilder_build503ResponseAction(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateListenerActionSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    elbv2api.ListenerActionSpec
+		wantErr error
+	}{
+		{
+			name: "forward action with authenticateConfig",
+			spec: elbv2api.ListenerActionSpec{
+				Type: elbv2api.ListenerActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{
+					TargetGroups: []elbv2api.TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("svc-1"),
+							ServicePort: &intstr.IntOrString{Type: intstr.String, StrVal: "http"},
+						},
+					},
+				},
+				AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+					Type: elbv2api.AuthenticateTypeOIDC,
+					OIDC: &elbv2api.AuthenticateOIDCConfig{
+						Issuer:     "https://example.com",
+						SecretName: "oidc-secret",
+					},
+				},
+			},
+		},
+		{
+			name: "redirect action with forwardConfig",
+			spec: elbv2api.ListenerActionSpec{
+				Type: elbv2api.ListenerActionTypeRedirect,
+				RedirectConfig: &elbv2api.RedirectActionConfig{
+					StatusCode: "HTTP_301",
+				},
+				ForwardConfig: &elbv2api.ForwardActionConfig{},
+			},
+			wantErr: errors.New("forwardConfig cannot be specified for redirect action"),
+		},
+		{
+			name: "forward action with redirectConfig",
+			spec: elbv2api.ListenerActionSpec{
+				Type:           elbv2api.ListenerActionTypeForward,
+				ForwardConfig:  &elbv2api.ForwardActionConfig{},
+				RedirectConfig: &elbv2api.RedirectActionConfig{},
+			},
+			wantErr: errors.New("redirectConfig cannot be specified for forward action"),
+		},
+		{
+			name: "forward action with fixedResponseConfig",
+			spec: elbv2api.ListenerActionSpec{
+				Type:                elbv2api.ListenerActionTypeForward,
+				ForwardConfig:       &elbv2api.ForwardActionConfig{},
+				FixedResponseConfig: &elbv2api.FixedResponseActionConfig{},
+			},
+			wantErr: errors.New("fixedResponseConfig cannot be specified for forward action"),
+		},
+		{
+			name: "oidc authentication with cognito",
+			spec: elbv2api.ListenerActionSpec{
+				Type:          elbv2api.ListenerActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{},
+				AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+					Type:    elbv2api.AuthenticateTypeOIDC,
+					OIDC:    &elbv2api.AuthenticateOIDCConfig{},
+					Cognito: &elbv2api.AuthenticateCognitoConfig{},
+				},
+			},
+			wantErr: errors.New("cognito cannot be specified for oidc authentication"),
+		},
+		{
+			name: "cognito authentication with oidc",
+			spec: elbv2api.ListenerActionSpec{
+				Type:          elbv2api.ListenerActionTypeForward,
+				ForwardConfig: &elbv2api.ForwardActionConfig{},
+				AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+					Type:    elbv2api.AuthenticateTypeCognito,
+					Cognito: &elbv2api.AuthenticateCognitoConfig{},
+					OIDC:    &elbv2api.AuthenticateOIDCConfig{},
+				},
+			},
+			wantErr: errors.New("oidc cannot be specified for cognito authentication"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateListenerActionSpec(tt.spec)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	networking "k8s.io/api/networking/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"strings"
 )
 
 const (
@@ -66,3 +67,17 @@ func buildGroupFinalizer(groupID GroupID) string {
 	}
 	return implicitGroupFinalizer
 }
+
+// GroupIDsFromFinalizers returns groupIDs that have associated finalizer on Ingress.
+func GroupIDsFromFinalizers(ing *networking.Ingress) []GroupID {
+	var groupIDs []GroupID
+	for _, finalizer := range ing.GetFinalizers() {
+		if finalizer == implicitGroupFinalizer {
+			groupIDs = append(groupIDs, NewGroupIDForImplicitGroup(k8s.NamespacedName(ing)))
+		} else if strings.HasPrefix(finalizer, explicitGroupFinalizerPrefix) {
+			groupName := finalizer[len(explicitGroupFinalizerPrefix):]
+			groupIDs = append(groupIDs, NewGroupIDForExplicitGroup(groupName))
+		}
+	}
+	return groupIDs
+}
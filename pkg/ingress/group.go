@@ -5,6 +5,7 @@ import (
 
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -65,3 +66,12 @@ type Group struct {
 	// InactiveMembers are Ingresses that no longer belong to this group, but still hold the finalizers.
 	InactiveMembers []*networking.Ingress
 }
+
+// MemberNamespaces returns the sorted namespaces of members in this group.
+func (group Group) MemberNamespaces() []string {
+	namespaces := sets.NewString()
+	for _, member := range group.Members {
+		namespaces.Insert(member.Ing.Namespace)
+	}
+	return namespaces.List()
+}
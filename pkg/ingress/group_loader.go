@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
-	"strings"
 
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1"
@@ -42,6 +41,11 @@ type GroupLoader interface {
 
 	// LoadGroupIDsPendingFinalization returns groupIDs that have associated finalizer on Ingress.
 	LoadGroupIDsPendingFinalization(ctx context.Context, ing *networking.Ingress) []GroupID
+
+	// LoadWithIngress returns the Ingress group that Ingress belongs to, with Ingress in its current state as a member.
+	// It's used to evaluate an Ingress group before changes of Ingress are persisted.
+	// Ingresses that is not managed by this controller or in deletion state won't have a group.
+	LoadWithIngress(ctx context.Context, ing *networking.Ingress) (*Group, error)
 }
 
 // NewDefaultGroupLoader constructs new GroupLoader instance.
@@ -114,17 +118,32 @@ func (m *defaultGroupLoader) LoadGroupIDIfAny(ctx context.Context, ing *networki
 	return groupID, err
 }
 
-func (m *defaultGroupLoader) LoadGroupIDsPendingFinalization(_ context.Context, ing *networking.Ingress) []GroupID {
-	var groupIDs []GroupID
-	for _, finalizer := range ing.GetFinalizers() {
-		if finalizer == implicitGroupFinalizer {
-			groupIDs = append(groupIDs, NewGroupIDForImplicitGroup(k8s.NamespacedName(ing)))
-		} else if strings.HasPrefix(finalizer, explicitGroupFinalizerPrefix) {
-			groupName := finalizer[len(explicitGroupFinalizerPrefix):]
-			groupIDs = append(groupIDs, NewGroupIDForExplicitGroup(groupName))
+func (m *defaultGroupLoader) LoadWithIngress(ctx context.Context, ing *networking.Ingress) (*Group, error) {
+	classifiedIng, groupID, err := m.loadGroupIDIfAnyHelper(ctx, ing)
+	if err != nil || groupID == nil {
+		return nil, err
+	}
+	group, err := m.Load(ctx, *groupID)
+	if err != nil {
+		return nil, err
+	}
+	ingKey := k8s.NamespacedName(ing)
+	members := []ClassifiedIngress{classifiedIng}
+	for _, member := range group.Members {
+		if k8s.NamespacedName(member.Ing) != ingKey {
+			members = append(members, member)
 		}
 	}
-	return groupIDs
+	sortedMembers, err := m.sortGroupMembers(members)
+	if err != nil {
+		return nil, err
+	}
+	group.Members = sortedMembers
+	return &group, nil
+}
+
+func (m *defaultGroupLoader) LoadGroupIDsPendingFinalization(_ context.Context, ing *networking.Ingress) []GroupID {
+	return GroupIDsFromFinalizers(ing)
 }
 
 type groupMembershipType int
@@ -18,6 +18,7 @@ import (
 	mock_client "sigs.k8s.io/aws-load-balancer-controller/mocks/controller-runtime/client"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -3065,3 +3066,107 @@ func Test_validateGroupName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultGroupLoader_LoadWithIngress(t *testing.T) {
+	ing1 := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ing-1",
+			Annotations: map[string]string{
+				"kubernetes.io/ingress.class":          "alb",
+				"alb.ingress.kubernetes.io/group.name": "awesome-group",
+			},
+		},
+	}
+	ing2 := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-2",
+			Name:      "ing-2",
+			Annotations: map[string]string{
+				"kubernetes.io/ingress.class":          "alb",
+				"alb.ingress.kubernetes.io/group.name": "awesome-group",
+			},
+		},
+	}
+	tests := []struct {
+		name            string
+		ingList         []*networking.Ingress
+		ing             *networking.Ingress
+		wantGroupID     *GroupID
+		wantMembers     []string
+		wantMemberOrder string
+	}{
+		{
+			name:        "new Ingress joins existing group",
+			ingList:     []*networking.Ingress{ing2},
+			ing:         ing1,
+			wantGroupID: &GroupID{Name: "awesome-group"},
+			wantMembers: []string{"ns-1/ing-1", "ns-2/ing-2"},
+		},
+		{
+			name:    "updated Ingress replaces its persisted state",
+			ingList: []*networking.Ingress{ing1, ing2},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-2",
+					Name:      "ing-2",
+					Annotations: map[string]string{
+						"kubernetes.io/ingress.class":           "alb",
+						"alb.ingress.kubernetes.io/group.name":  "awesome-group",
+						"alb.ingress.kubernetes.io/group.order": "-1",
+					},
+				},
+			},
+			wantGroupID:     &GroupID{Name: "awesome-group"},
+			wantMembers:     []string{"ns-2/ing-2", "ns-1/ing-1"},
+			wantMemberOrder: "-1",
+		},
+		{
+			name:    "Ingress not managed by this controller",
+			ingList: []*networking.Ingress{ing1},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-3",
+					Name:      "ing-3",
+					Annotations: map[string]string{
+						"kubernetes.io/ingress.class": "nginx",
+					},
+				},
+			},
+			wantGroupID: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ing := range tt.ingList {
+				assert.NoError(t, k8sClient.Create(context.Background(), ing.DeepCopy()))
+			}
+			m := &defaultGroupLoader{
+				client:                             k8sClient,
+				annotationParser:                   annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classLoader:                        NewDefaultClassLoader(k8sClient, true),
+				classAnnotationMatcher:             NewDefaultClassAnnotationMatcher("alb"),
+				manageIngressesWithoutIngressClass: false,
+			}
+			got, err := m.LoadWithIngress(context.Background(), tt.ing)
+			assert.NoError(t, err)
+			if tt.wantGroupID == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, *tt.wantGroupID, got.ID)
+			var gotMembers []string
+			for _, member := range got.Members {
+				gotMembers = append(gotMembers, k8s.NamespacedName(member.Ing).String())
+			}
+			assert.Equal(t, tt.wantMembers, gotMembers)
+			if tt.wantMemberOrder != "" {
+				assert.Equal(t, tt.wantMemberOrder, got.Members[0].Ing.Annotations["alb.ingress.kubernetes.io/group.order"])
+			}
+		})
+	}
+}
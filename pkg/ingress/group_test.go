@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -192,3 +194,35 @@ func TestDecodeGroupIDFromReconcileRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGroup_MemberNamespaces(t *testing.T) {
+	tests := []struct {
+		name  string
+		group Group
+		want  []string
+	}{
+		{
+			name:  "group without members",
+			group: Group{ID: NewGroupIDForExplicitGroup("awesome-group")},
+			want:  []string{},
+		},
+		{
+			name: "group with members across namespaces",
+			group: Group{
+				ID: NewGroupIDForExplicitGroup("awesome-group"),
+				Members: []ClassifiedIngress{
+					{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-b", Name: "ing-1"}}},
+					{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "ing-2"}}},
+					{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-b", Name: "ing-3"}}},
+				},
+			},
+			want: []string{"ns-a", "ns-b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.group.MemberNamespaces()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package ingress
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// QuarantinedMember is a member Ingress that is isolated from model building of its IngressGroup.
+type QuarantinedMember struct {
+	// Ing is the quarantined Ingress.
+	Ing *networking.Ingress
+	// Reason is the error that failed model building for the Ingress.
+	Reason error
+	// LastDeployedPreserved indicates whether the last deployed version of the Ingress is used in place of it,
+	// otherwise the Ingress is excluded from its IngressGroup.
+	LastDeployedPreserved bool
+}
+
+// QuarantineBuildResult is the result of model building with quarantine.
+type QuarantineBuildResult struct {
+	Stack             core.Stack
+	LoadBalancer      *elbv2model.LoadBalancer
+	Secrets           []types.NamespacedName
+	BackendSGRequired bool
+	// EffectiveGroup is the IngressGroup that the model is built for, where quarantined members are replaced or excluded.
+	EffectiveGroup Group
+	// QuarantinedMembers are the members quarantined during model building.
+	QuarantinedMembers []QuarantinedMember
+}
+
+// MemberQuarantiner builds model for IngressGroup, while isolating members that fail model building,
+// so that a single faulty Ingress won't block changes of the whole IngressGroup.
+type MemberQuarantiner interface {
+	// Build builds model stack for IngressGroup.
+	// a member that fails model building is replaced by its last deployed version if known, or excluded if it has never been deployed.
+	// model building fails as a whole if the failure cannot be isolated to a member.
+	Build(ctx context.Context, ingGroup Group) (QuarantineBuildResult, error)
+
+	// MarkDeployed records the members of the effective IngressGroup as deployed, excluding quarantined ones.
+	MarkDeployed(result QuarantineBuildResult)
+
+	// Forget forgets the last deployed version of Ingresses.
+	Forget(ingKeys []types.NamespacedName)
+}
+
+// NewDefaultMemberQuarantiner constructs new defaultMemberQuarantiner.
+func NewDefaultMemberQuarantiner(modelBuilder ModelBuilder, logger logr.Logger) *defaultMemberQuarantiner {
+	return &defaultMemberQuarantiner{
+		modelBuilder:        modelBuilder,
+		lastDeployedMembers: make(map[types.NamespacedName]ClassifiedIngress),
+		logger:              logger,
+	}
+}
+
+var _ MemberQuarantiner = &defaultMemberQuarantiner{}
+
+// default implementation for MemberQuarantiner.
+// the last deployed version of members are kept in memory, so they are unknown after controller restarts.
+type defaultMemberQuarantiner struct {
+	modelBuilder ModelBuilder
+
+	lastDeployedMembersMutex sync.RWMutex
+	lastDeployedMembers      map[types.NamespacedName]ClassifiedIngress
+
+	logger logr.Logger
+}
+
+func (q *defaultMemberQuarantiner) Build(ctx context.Context, ingGroup Group) (QuarantineBuildResult, error) {
+	effectiveGroup := Group{
+		ID:              ingGroup.ID,
+		Members:         append([]ClassifiedIngress(nil), ingGroup.Members...),
+		InactiveMembers: ingGroup.InactiveMembers,
+	}
+	var quarantinedMembers []QuarantinedMember
+	quarantinedIngKeys := make(map[types.NamespacedName]bool)
+	for {
+		stack, lb, secrets, backendSGRequired, err := q.modelBuilder.Build(ctx, effectiveGroup)
+		if err == nil {
+			return QuarantineBuildResult{
+				Stack:              stack,
+				LoadBalancer:       lb,
+				Secrets:            secrets,
+				BackendSGRequired:  backendSGRequired,
+				EffectiveGroup:     effectiveGroup,
+				QuarantinedMembers: quarantinedMembers,
+			}, nil
+		}
+		var memberErr *MemberBuildError
+		if !errors.As(err, &memberErr) {
+			return QuarantineBuildResult{}, err
+		}
+		// the last deployed version of member also fails, e.g. its backend Service has been deleted since.
+		if quarantinedIngKeys[memberErr.IngKey] {
+			return QuarantineBuildResult{}, err
+		}
+		memberIdx := -1
+		for idx, member := range effectiveGroup.Members {
+			if k8s.NamespacedName(member.Ing) == memberErr.IngKey {
+				memberIdx = idx
+				break
+			}
+		}
+		if memberIdx == -1 {
+			return QuarantineBuildResult{}, err
+		}
+
+		member := effectiveGroup.Members[memberIdx]
+		quarantinedMember := QuarantinedMember{
+			Ing:    member.Ing,
+			Reason: memberErr.Unwrap(),
+		}
+		if lastDeployedMember, exists := q.getLastDeployedMember(memberErr.IngKey); exists {
+			effectiveGroup.Members[memberIdx] = buildMemberWithLastDeployedVersion(member, lastDeployedMember)
+			quarantinedMember.LastDeployedPreserved = true
+		} else if len(member.Ing.Status.LoadBalancer.Ingress) == 0 {
+			effectiveGroup.Members = append(effectiveGroup.Members[:memberIdx], effectiveGroup.Members[memberIdx+1:]...)
+		} else {
+			// the Ingress has been deployed, but the version deployed is unknown, e.g. controller restarted since.
+			// excluding it would delete its rules, thus we fail the IngressGroup instead.
+			return QuarantineBuildResult{}, err
+		}
+		// never exclude all members, which would delete the load balancer.
+		if len(effectiveGroup.Members) == 0 {
+			return QuarantineBuildResult{}, err
+		}
+		q.logger.Info("quarantined ingress", "ingressGroup", ingGroup.ID, "ingress", memberErr.IngKey,
+			"lastDeployedPreserved", quarantinedMember.LastDeployedPreserved, "reason", quarantinedMember.Reason.Error())
+		quarantinedIngKeys[memberErr.IngKey] = true
+		quarantinedMembers = append(quarantinedMembers, quarantinedMember)
+	}
+}
+
+func (q *defaultMemberQuarantiner) MarkDeployed(result QuarantineBuildResult) {
+	quarantinedIngKeys := make(map[types.NamespacedName]bool, len(result.QuarantinedMembers))
+	for _, member := range result.QuarantinedMembers {
+		quarantinedIngKeys[k8s.NamespacedName(member.Ing)] = true
+	}
+
+	q.lastDeployedMembersMutex.Lock()
+	defer q.lastDeployedMembersMutex.Unlock()
+	for _, member := range result.EffectiveGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		if quarantinedIngKeys[ingKey] {
+			continue
+		}
+		q.lastDeployedMembers[ingKey] = ClassifiedIngress{
+			Ing:            member.Ing.DeepCopy(),
+			IngClassConfig: member.IngClassConfig,
+		}
+	}
+}
+
+func (q *defaultMemberQuarantiner) Forget(ingKeys []types.NamespacedName) {
+	q.lastDeployedMembersMutex.Lock()
+	defer q.lastDeployedMembersMutex.Unlock()
+	for _, ingKey := range ingKeys {
+		delete(q.lastDeployedMembers, ingKey)
+	}
+}
+
+func (q *defaultMemberQuarantiner) getLastDeployedMember(ingKey types.NamespacedName) (ClassifiedIngress, bool) {
+	q.lastDeployedMembersMutex.RLock()
+	defer q.lastDeployedMembersMutex.RUnlock()
+	member, exists := q.lastDeployedMembers[ingKey]
+	return member, exists
+}
+
+// buildMemberWithLastDeployedVersion builds the member with annotations and spec from its last deployed version.
+// other fields like finalizers and status are kept from the current version.
+func buildMemberWithLastDeployedVersion(member ClassifiedIngress, lastDeployedMember ClassifiedIngress) ClassifiedIngress {
+	ing := member.Ing.DeepCopy()
+	ing.Annotations = lastDeployedMember.Ing.Annotations
+	ing.Spec = lastDeployedMember.Ing.Spec
+	return ClassifiedIngress{
+		Ing:            ing,
+		IngClassConfig: lastDeployedMember.IngClassConfig,
+	}
+}
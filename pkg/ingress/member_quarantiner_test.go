@@ -0,0 +1,142 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// stubQuarantineModelBuilder fails model building due to the first member with the faulty annotation.
+type stubQuarantineModelBuilder struct {
+	groupErr error
+}
+
+func (b *stubQuarantineModelBuilder) Build(_ context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error) {
+	if b.groupErr != nil {
+		return nil, nil, nil, false, b.groupErr
+	}
+	for _, member := range ingGroup.Members {
+		if member.Ing.Annotations["alb.ingress.kubernetes.io/faulty"] == "true" {
+			return nil, nil, nil, false, NewMemberBuildError(k8s.NamespacedName(member.Ing), errors.New("faulty annotation"))
+		}
+	}
+	return core.NewDefaultStack(core.StackID(ingGroup.ID)), nil, nil, false, nil
+}
+
+func Test_defaultMemberQuarantiner_Build(t *testing.T) {
+	newMember := func(name string, faulty bool, deployed bool) ClassifiedIngress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        name,
+				Annotations: map[string]string{},
+			},
+		}
+		if faulty {
+			ing.Annotations["alb.ingress.kubernetes.io/faulty"] = "true"
+		}
+		if deployed {
+			ing.Status.LoadBalancer.Ingress = []networking.IngressLoadBalancerIngress{{Hostname: "lb.example.com"}}
+		}
+		return ClassifiedIngress{Ing: ing}
+	}
+	type quarantinedMember struct {
+		name                  string
+		lastDeployedPreserved bool
+	}
+	tests := []struct {
+		name                   string
+		groupErr               error
+		lastDeployedMembers    []ClassifiedIngress
+		members                []ClassifiedIngress
+		wantEffectiveMembers   []string
+		wantQuarantinedMembers []quarantinedMember
+		wantErr                error
+	}{
+		{
+			name:                 "no faulty members",
+			members:              []ClassifiedIngress{newMember("ing-1", false, true), newMember("ing-2", false, false)},
+			wantEffectiveMembers: []string{"ing-1", "ing-2"},
+		},
+		{
+			name:                   "faulty member that has never been deployed is excluded",
+			members:                []ClassifiedIngress{newMember("ing-1", false, true), newMember("ing-2", true, false)},
+			wantEffectiveMembers:   []string{"ing-1"},
+			wantQuarantinedMembers: []quarantinedMember{{name: "ing-2"}},
+		},
+		{
+			name:                   "faulty member is replaced with its last deployed version",
+			lastDeployedMembers:    []ClassifiedIngress{newMember("ing-1", false, true)},
+			members:                []ClassifiedIngress{newMember("ing-1", true, true), newMember("ing-2", false, true)},
+			wantEffectiveMembers:   []string{"ing-1", "ing-2"},
+			wantQuarantinedMembers: []quarantinedMember{{name: "ing-1", lastDeployedPreserved: true}},
+		},
+		{
+			name:    "faulty member that has been deployed with unknown version fails the group",
+			members: []ClassifiedIngress{newMember("ing-1", true, true), newMember("ing-2", false, true)},
+			wantErr: errors.New("ingress: awesome-ns/ing-1: faulty annotation"),
+		},
+		{
+			name:                "faulty member whose last deployed version also fails fails the group",
+			lastDeployedMembers: []ClassifiedIngress{newMember("ing-1", true, true)},
+			members:             []ClassifiedIngress{newMember("ing-1", true, true), newMember("ing-2", false, true)},
+			wantErr:             errors.New("ingress: awesome-ns/ing-1: faulty annotation"),
+		},
+		{
+			name:    "all members faulty fails the group",
+			members: []ClassifiedIngress{newMember("ing-1", true, false), newMember("ing-2", true, false)},
+			wantErr: errors.New("ingress: awesome-ns/ing-2: faulty annotation"),
+		},
+		{
+			name:     "failure that cannot be isolated to member fails the group",
+			groupErr: errors.New("conflicting scheme"),
+			members:  []ClassifiedIngress{newMember("ing-1", false, true)},
+			wantErr:  errors.New("conflicting scheme"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewDefaultMemberQuarantiner(&stubQuarantineModelBuilder{groupErr: tt.groupErr}, logr.Discard())
+			groupID := NewGroupIDForExplicitGroup("awesome-group")
+			if len(tt.lastDeployedMembers) != 0 {
+				q.MarkDeployed(QuarantineBuildResult{EffectiveGroup: Group{ID: groupID, Members: tt.lastDeployedMembers}})
+			}
+			got, err := q.Build(context.Background(), Group{ID: groupID, Members: tt.members})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotEffectiveMembers []string
+			for _, member := range got.EffectiveGroup.Members {
+				gotEffectiveMembers = append(gotEffectiveMembers, member.Ing.Name)
+			}
+			assert.Equal(t, tt.wantEffectiveMembers, gotEffectiveMembers)
+			var gotQuarantinedMembers []quarantinedMember
+			for _, member := range got.QuarantinedMembers {
+				gotQuarantinedMembers = append(gotQuarantinedMembers, quarantinedMember{
+					name:                  member.Ing.Name,
+					lastDeployedPreserved: member.LastDeployedPreserved,
+				})
+			}
+			assert.Equal(t, tt.wantQuarantinedMembers, gotQuarantinedMembers)
+			// quarantined members are not recorded as deployed.
+			q.MarkDeployed(got)
+			for _, member := range got.QuarantinedMembers {
+				if !member.LastDeployedPreserved {
+					_, exists := q.getLastDeployedMember(k8s.NamespacedName(member.Ing))
+					assert.False(t, exists)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
 	"unicode"
 )
@@ -106,10 +107,7 @@ func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, ing Clas
 		if tgt.TargetGroupARN != nil {
 			tgARN = core.LiteralStringToken(*tgt.TargetGroupARN)
 		} else {
-			svcKey := types.NamespacedName{
-				Namespace: ing.Ing.Namespace,
-				Name:      awssdk.StringValue(tgt.ServiceName),
-			}
+			svcKey := tgt.serviceKey(ing.Ing.Namespace)
 			svc := t.backendServices[svcKey]
 			tg, err := t.buildTargetGroup(ctx, ing, svc, *tgt.ServicePort)
 			if err != nil {
@@ -140,6 +138,41 @@ func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, ing Clas
 }
 
 func (t *defaultModelBuildTask) buildAuthenticateCognitoAction(_ context.Context, authCfg AuthConfig) (elbv2model.Action, error) {
+	return BuildAuthenticateCognitoAction(authCfg)
+}
+
+func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context, namespace string, authCfg AuthConfig) (elbv2model.Action, error) {
+	action, secretKey, err := BuildAuthenticateOIDCAction(ctx, t.k8sClient, namespace, authCfg)
+	if err != nil {
+		return elbv2model.Action{}, err
+	}
+	t.secretKeys = append(t.secretKeys, secretKey)
+	return action, nil
+}
+
+// BuildAuthAction builds the authenticate action for authCfg, or nil if authentication isn't configured.
+// the secret that holds OIDC client credentials is loaded from namespace, and its key is returned as well.
+func BuildAuthAction(ctx context.Context, k8sClient client.Client, namespace string, authCfg AuthConfig) (*elbv2model.Action, *types.NamespacedName, error) {
+	switch authCfg.Type {
+	case AuthTypeCognito:
+		action, err := BuildAuthenticateCognitoAction(authCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &action, nil, nil
+	case AuthTypeOIDC:
+		action, secretKey, err := BuildAuthenticateOIDCAction(ctx, k8sClient, namespace, authCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &action, &secretKey, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// BuildAuthenticateCognitoAction builds the authenticate-cognito action for authCfg.
+func BuildAuthenticateCognitoAction(authCfg AuthConfig) (elbv2model.Action, error) {
 	if authCfg.IDPConfigCognito == nil {
 		return elbv2model.Action{}, errors.New("missing IDPConfigCognito")
 	}
@@ -159,9 +192,11 @@ func (t *defaultModelBuildTask) buildAuthenticateCognitoAction(_ context.Context
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context, namespace string, authCfg AuthConfig) (elbv2model.Action, error) {
+// BuildAuthenticateOIDCAction builds the authenticate-oidc action for authCfg, with client credentials loaded from secret within namespace.
+// the key of secret is returned as well, so that callers can watch it for changes.
+func BuildAuthenticateOIDCAction(ctx context.Context, k8sClient client.Client, namespace string, authCfg AuthConfig) (elbv2model.Action, types.NamespacedName, error) {
 	if authCfg.IDPConfigOIDC == nil {
-		return elbv2model.Action{}, errors.New("missing IDPConfigOIDC")
+		return elbv2model.Action{}, types.NamespacedName{}, errors.New("missing IDPConfigOIDC")
 	}
 	onUnauthenticatedRequest := elbv2model.AuthenticateOIDCActionConditionalBehavior(authCfg.OnUnauthenticatedRequest)
 	secretKey := types.NamespacedName{
@@ -169,8 +204,8 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		Name:      authCfg.IDPConfigOIDC.SecretName,
 	}
 	secret := &corev1.Secret{}
-	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
-		return elbv2model.Action{}, err
+	if err := k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return elbv2model.Action{}, types.NamespacedName{}, err
 	}
 	rawClientID, ok := secret.Data["clientID"]
 	// AWSALBIngressController looks for clientId, we should be backwards-compatible here.
@@ -178,14 +213,13 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		rawClientID, ok = secret.Data["clientId"]
 	}
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientID, secret: %v", secretKey)
+		return elbv2model.Action{}, types.NamespacedName{}, errors.Errorf("missing clientID, secret: %v", secretKey)
 	}
 	rawClientSecret, ok := secret.Data["clientSecret"]
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientSecret, secret: %v", secretKey)
+		return elbv2model.Action{}, types.NamespacedName{}, errors.Errorf("missing clientSecret, secret: %v", secretKey)
 	}
 
-	t.secretKeys = append(t.secretKeys, secretKey)
 	clientID := strings.TrimRightFunc(string(rawClientID), unicode.IsSpace)
 	clientSecret := string(rawClientSecret)
 	return elbv2model.Action{
@@ -203,7 +237,7 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 			SessionCookieName:                &authCfg.SessionCookieName,
 			SessionTimeout:                   &authCfg.SessionTimeout,
 		},
-	}, nil
+	}, secretKey, nil
 }
 
 func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
@@ -7,6 +7,7 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"k8s.io/utils/strings/slices"
 	"net"
+	"reflect"
 	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -63,6 +65,18 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 		return []elbv2model.Action{t.buildSSLRedirectAction(ctx, *t.sslRedirectConfig)}, nil
 	}
 
+	classDefaultAction, err := t.computeIngressClassParamsDefaultAction(ctx, ingList)
+	if err != nil {
+		return nil, err
+	}
+	if classDefaultAction != nil {
+		action, err := t.buildBackendAction(ctx, ClassifiedIngress{}, *classDefaultAction)
+		if err != nil {
+			return nil, err
+		}
+		return []elbv2model.Action{action}, nil
+	}
+
 	ingsWithDefaultBackend := make([]ClassifiedIngress, 0, len(ingList))
 	for _, ing := range ingList {
 		if ing.Ing.Spec.DefaultBackend != nil {
@@ -90,6 +104,43 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 	return t.buildActions(ctx, protocol, ing, enhancedBackend)
 }
 
+// computeIngressClassParamsDefaultAction computes the default action configured via IngressClassParams for Ingresses on listener.
+// Returns nil if there is no default action configured.
+func (t *defaultModelBuildTask) computeIngressClassParamsDefaultAction(_ context.Context, ingList []ClassifiedIngress) (*Action, error) {
+	var mergedDefaultAction *elbv2api.DefaultAction
+	var mergedDefaultActionProvider string
+	for _, ing := range ingList {
+		ingClassParams := ing.IngClassConfig.IngClassParams
+		if ingClassParams == nil || ingClassParams.Spec.DefaultAction == nil {
+			continue
+		}
+		if mergedDefaultAction == nil {
+			mergedDefaultAction = ingClassParams.Spec.DefaultAction
+			mergedDefaultActionProvider = ingClassParams.Name
+		} else if !reflect.DeepEqual(mergedDefaultAction, ingClassParams.Spec.DefaultAction) {
+			return nil, errors.Errorf("conflicting default action, IngressClassParams %v | IngressClassParams %v",
+				mergedDefaultActionProvider, ingClassParams.Name)
+		}
+	}
+	if mergedDefaultAction == nil {
+		return nil, nil
+	}
+
+	actionSpec := elbv2api.ListenerActionSpec{}
+	switch {
+	case mergedDefaultAction.FixedResponse != nil && mergedDefaultAction.Redirect == nil:
+		actionSpec.Type = elbv2api.ListenerActionTypeFixedResponse
+		actionSpec.FixedResponseConfig = mergedDefaultAction.FixedResponse
+	case mergedDefaultAction.Redirect != nil && mergedDefaultAction.FixedResponse == nil:
+		actionSpec.Type = elbv2api.ListenerActionTypeRedirect
+		actionSpec.RedirectConfig = mergedDefaultAction.Redirect
+	default:
+		return nil, errors.Errorf("exactly one of fixedResponse or redirect must be specified in defaultAction of IngressClassParams %v", mergedDefaultActionProvider)
+	}
+	action := convertListenerActionSpecToAction(actionSpec)
+	return &action, nil
+}
+
 func (t *defaultModelBuildTask) buildListenerTags(_ context.Context, ingList []ClassifiedIngress) (map[string]string, error) {
 	ingGroupTags, err := t.buildIngressGroupResourceTags(ingList)
 	if err != nil {
@@ -110,7 +161,10 @@ type listenPortConfig struct {
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *ClassifiedIngress) (map[int64]listenPortConfig, error) {
-	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing.Ing)
+	explicitTLSCertARNs, err := t.computeIngressExplicitTLSCertARNs(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
 	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
 	var prefixListIDs []string
 	t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroupPrefixLists, &prefixListIDs, ing.Ing.Annotations)
@@ -123,7 +177,7 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 		return nil, err
 	}
 	preferTLS := len(explicitTLSCertARNs) != 0
-	listenPorts, err := t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
+	listenPorts, err := t.computeIngressListenPorts(ctx, ing, preferTLS)
 	if err != nil {
 		return nil, err
 	}
@@ -166,10 +220,26 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 	return listenPortConfigByPort, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *networking.Ingress) []string {
+func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(ctx context.Context, ing *ClassifiedIngress) ([]string, error) {
+	if ing.IngClassConfig.IngClassParams != nil && ing.IngClassConfig.IngClassParams.Spec.Certificates != nil {
+		certSelector := ing.IngClassConfig.IngClassParams.Spec.Certificates
+		tlsCertARNs := append([]string(nil), certSelector.ARNs...)
+		if len(certSelector.Hostnames) != 0 {
+			discoveredTLSCertARNs, err := t.certDiscovery.Discover(ctx, certSelector.Hostnames)
+			if err != nil {
+				return nil, err
+			}
+			for _, certARN := range discoveredTLSCertARNs {
+				if !slices.Contains(tlsCertARNs, certARN) {
+					tlsCertARNs = append(tlsCertARNs, certARN)
+				}
+			}
+		}
+		return tlsCertARNs, nil
+	}
 	var rawTLSCertARNs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Annotations)
-	return rawTLSCertARNs
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
+	return rawTLSCertARNs, nil
 }
 
 func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Context, ing *networking.Ingress) ([]string, error) {
@@ -185,15 +255,43 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 	return t.certDiscovery.Discover(ctx, hosts.List())
 }
 
-func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *networking.Ingress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
+func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *ClassifiedIngress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
+	var allowedListenPorts map[int64]elbv2model.Protocol
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.ListenPorts) != 0 {
+		allowedListenPorts = make(map[int64]elbv2model.Protocol, len(ing.IngClassConfig.IngClassParams.Spec.ListenPorts))
+		for _, listenPort := range ing.IngClassConfig.IngClassParams.Spec.ListenPorts {
+			allowedListenPorts[int64(listenPort.Port)] = elbv2model.Protocol(listenPort.Protocol)
+		}
+	}
+
 	rawListenPorts := ""
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Ing.Annotations); !exists {
+		if allowedListenPorts != nil {
+			return allowedListenPorts, nil
+		}
 		if preferTLS {
 			return map[int64]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS}, nil
 		}
 		return map[int64]elbv2model.Protocol{80: elbv2model.ProtocolHTTP}, nil
 	}
 
+	portAndProtocols, err := parseListenPorts(rawListenPorts)
+	if err != nil {
+		return nil, err
+	}
+	if allowedListenPorts != nil {
+		for port, protocol := range portAndProtocols {
+			if allowedProtocol, ok := allowedListenPorts[port]; !ok || allowedProtocol != protocol {
+				return nil, errors.Errorf("listen port %v:%v is not allowed by IngressClassParams %v",
+					protocol, port, ing.IngClassConfig.IngClassParams.Name)
+			}
+		}
+	}
+	return portAndProtocols, nil
+}
+
+// parseListenPorts parses the protocol by port from listen-ports configuration.
+func parseListenPorts(rawListenPorts string) (map[int64]elbv2model.Protocol, error) {
 	var entries []map[string]int64
 	if err := json.Unmarshal([]byte(rawListenPorts), &entries); err != nil {
 		return nil, errors.Wrapf(err, "failed to parse listen-ports configuration: `%s`", rawListenPorts)
@@ -269,6 +367,24 @@ type MutualAuthenticationConfig struct {
 }
 
 func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.Context, ing *ClassifiedIngress) (map[int64]*elbv2model.MutualAuthenticationAttributes, error) {
+	// If IngressClassParams specifies mutualAuthentication, it takes precedence over Ingress annotation
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication) != 0 {
+		ingClassParamsEntries := make([]MutualAuthenticationConfig, 0, len(ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication))
+		for _, entry := range ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication {
+			ingClassParamsEntries = append(ingClassParamsEntries, MutualAuthenticationConfig{
+				Port:                          int64(entry.Port),
+				Mode:                          string(entry.Mode),
+				TrustStore:                    entry.TrustStore,
+				IgnoreClientCertificateExpiry: entry.IgnoreClientCertificateExpiry,
+			})
+		}
+		portAndMtlsAttributesMap, err := parseMtlsConfigEntries(ingClassParamsEntries)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid mutualAuthentication in IngressClassParams %v", ing.IngClassConfig.IngClassParams.Name)
+		}
+		return t.parseMtlsAttributesForTrustStoreNames(ctx, portAndMtlsAttributesMap)
+	}
+
 	var rawMtlsConfigString string
 
 	// If both Ingress annotation is missing mutual-authentication config, return default mutualAuthentication mode
@@ -278,15 +394,11 @@ func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.C
 		}}, nil
 
 	}
-	var ingressAnnotationEntries []MutualAuthenticationConfig
-
-	if err := json.Unmarshal([]byte(rawMtlsConfigString), &ingressAnnotationEntries); err != nil {
-		return nil, errors.Wrapf(err, "failed to parse mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
-	}
-	if len(ingressAnnotationEntries) == 0 {
-		return nil, errors.Errorf("empty mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+	ingressAnnotationEntries, err := parseMutualAuthenticationConfigs(rawMtlsConfigString)
+	if err != nil {
+		return nil, err
 	}
-	portAndMtlsAttributesMap, err := t.parseMtlsConfigEntries(ctx, ingressAnnotationEntries)
+	portAndMtlsAttributesMap, err := parseMtlsConfigEntries(ingressAnnotationEntries)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +410,19 @@ func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.C
 	return parsedPortAndMtlsAttributes, nil
 }
 
-func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entries []MutualAuthenticationConfig) (map[int64]*elbv2model.MutualAuthenticationAttributes, error) {
+// parseMutualAuthenticationConfigs parses the mutualAuthentication configuration from ingress annotation.
+func parseMutualAuthenticationConfigs(rawMtlsConfigString string) ([]MutualAuthenticationConfig, error) {
+	var entries []MutualAuthenticationConfig
+	if err := json.Unmarshal([]byte(rawMtlsConfigString), &entries); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+	}
+	if len(entries) == 0 {
+		return nil, errors.Errorf("empty mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+	}
+	return entries, nil
+}
+
+func parseMtlsConfigEntries(entries []MutualAuthenticationConfig) (map[int64]*elbv2model.MutualAuthenticationAttributes, error) {
 	portAndMtlsAttributes := make(map[int64]*elbv2model.MutualAuthenticationAttributes, len(entries))
 
 	for _, mutualAuthenticationConfig := range entries {
@@ -307,7 +431,7 @@ func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entrie
 		truststoreNameOrArn := awssdk.StringValue(mutualAuthenticationConfig.TrustStore)
 		ignoreClientCert := mutualAuthenticationConfig.IgnoreClientCertificateExpiry
 
-		err := t.validateMutualAuthenticationConfig(port, mode, truststoreNameOrArn, ignoreClientCert)
+		err := validateMutualAuthenticationConfig(port, mode, truststoreNameOrArn, ignoreClientCert)
 		if err != nil {
 			return nil, err
 		}
@@ -320,7 +444,7 @@ func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entrie
 	return portAndMtlsAttributes, nil
 }
 
-func (t *defaultModelBuildTask) validateMutualAuthenticationConfig(port int64, mode string, truststoreNameOrArn string, ignoreClientCert *bool) error {
+func validateMutualAuthenticationConfig(port int64, mode string, truststoreNameOrArn string, ignoreClientCert *bool) error {
 	// Verify port value is valid for ALB: [1, 65535]
 	if port < 1 || port > 65535 {
 		return errors.Errorf("listen port must be within [1, 65535]: %v", port)
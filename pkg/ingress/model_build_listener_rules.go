@@ -27,26 +27,26 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 			}
 			paths, err := t.sortIngressPaths(rule.HTTP.Paths)
 			if err != nil {
-				return err
+				return NewMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 			}
 			for _, path := range paths {
 				enhancedBackend, err := t.enhancedBackendBuilder.Build(ctx, ing.Ing, path.Backend,
 					WithLoadBackendServices(true, t.backendServices),
 					WithLoadAuthConfig(true))
 				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+					return NewMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 				}
 				conditions, err := t.buildRuleConditions(ctx, rule, path, enhancedBackend)
 				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+					return NewMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 				}
 				actions, err := t.buildActions(ctx, protocol, ing, enhancedBackend)
 				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+					return NewMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 				}
 				tags, err := t.buildListenerRuleTags(ctx, ing)
 				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+					return NewMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 				}
 				rules = append(rules, Rule{
 					Conditions: conditions,
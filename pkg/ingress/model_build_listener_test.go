@@ -0,0 +1,481 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_computeIngressListenPorts(t *testing.T) {
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			ListenPorts: []elbv2api.ListenPort{
+				{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+				{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+			},
+		},
+	}
+	type args struct {
+		ing       *ClassifiedIngress
+		preferTLS bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[int64]elbv2model.Protocol
+		wantErr error
+	}{
+		{
+			name: "no IngressClassParams and no annotation",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{},
+				},
+			},
+			want: map[int64]elbv2model.Protocol{80: elbv2model.ProtocolHTTP},
+		},
+		{
+			name: "no IngressClassParams and no annotation - preferTLS",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{},
+				},
+				preferTLS: true,
+			},
+			want: map[int64]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS},
+		},
+		{
+			name: "IngressClassParams listenPorts and no annotation",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{},
+					IngClassConfig: ClassConfiguration{
+						IngClassParams: ingClassParams,
+					},
+				},
+			},
+			want: map[int64]elbv2model.Protocol{
+				80:  elbv2model.ProtocolHTTP,
+				443: elbv2model.ProtocolHTTPS,
+			},
+		},
+		{
+			name: "IngressClassParams listenPorts and annotation within allowed ports",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listen-ports": `[{"HTTPS": 443}]`,
+							},
+						},
+					},
+					IngClassConfig: ClassConfiguration{
+						IngClassParams: ingClassParams,
+					},
+				},
+			},
+			want: map[int64]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS},
+		},
+		{
+			name: "IngressClassParams listenPorts and annotation with disallowed port",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 8080}]`,
+							},
+						},
+					},
+					IngClassConfig: ClassConfiguration{
+						IngClassParams: ingClassParams,
+					},
+				},
+			},
+			wantErr: errors.New("listen port HTTP:8080 is not allowed by IngressClassParams awesome-class"),
+		},
+		{
+			name: "IngressClassParams listenPorts and annotation with disallowed protocol",
+			args: args{
+				ing: &ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 443}]`,
+							},
+						},
+					},
+					IngClassConfig: ClassConfiguration{
+						IngClassParams: ingClassParams,
+					},
+				},
+			},
+			wantErr: errors.New("listen port HTTP:443 is not allowed by IngressClassParams awesome-class"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.computeIngressListenPorts(context.Background(), tt.args.ing, tt.args.preferTLS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeIngressExplicitTLSCertARNs(t *testing.T) {
+	type discoverCall struct {
+		hosts []string
+		certs []string
+		err   error
+	}
+	tests := []struct {
+		name          string
+		ing           *ClassifiedIngress
+		discoverCalls []discoverCall
+		want          []string
+		wantErr       error
+	}{
+		{
+			name: "certificate-arn annotation",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/annotation",
+						},
+					},
+				},
+			},
+			want: []string{"arn:aws:acm:us-west-2:123456789012:certificate/annotation"},
+		},
+		{
+			name: "IngressClassParams certificates take precedence over annotation",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/annotation",
+						},
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							Certificates: &elbv2api.CertificateSelector{
+								ARNs:      []string{"arn:aws:acm:us-west-2:123456789012:certificate/class"},
+								Hostnames: []string{"*.example.com"},
+							},
+						},
+					},
+				},
+			},
+			discoverCalls: []discoverCall{
+				{
+					hosts: []string{"*.example.com"},
+					certs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/class", "arn:aws:acm:us-west-2:123456789012:certificate/wildcard"},
+				},
+			},
+			want: []string{"arn:aws:acm:us-west-2:123456789012:certificate/class", "arn:aws:acm:us-west-2:123456789012:certificate/wildcard"},
+		},
+		{
+			name: "IngressClassParams certificates discovery failed",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							Certificates: &elbv2api.CertificateSelector{
+								Hostnames: []string{"app.example.com"},
+							},
+						},
+					},
+				},
+			},
+			discoverCalls: []discoverCall{
+				{
+					hosts: []string{"app.example.com"},
+					err:   errors.New("none certificate found for host: app.example.com"),
+				},
+			},
+			wantErr: errors.New("none certificate found for host: app.example.com"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			certDiscovery := NewMockCertDiscovery(ctrl)
+			for _, call := range tt.discoverCalls {
+				certDiscovery.EXPECT().Discover(gomock.Any(), call.hosts).Return(call.certs, call.err)
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				certDiscovery:    certDiscovery,
+			}
+			got, err := task.computeIngressExplicitTLSCertARNs(context.Background(), tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeIngressMutualAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		ing     *ClassifiedIngress
+		want    map[int64]*elbv2model.MutualAuthenticationAttributes
+		wantErr error
+	}{
+		{
+			name: "no IngressClassParams and no annotation",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{},
+			},
+			want: map[int64]*elbv2model.MutualAuthenticationAttributes{
+				443: {Mode: "off"},
+			},
+		},
+		{
+			name: "IngressClassParams mutualAuthentication takes precedence over annotation",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough"}]`,
+						},
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+								{
+									Port:       443,
+									Mode:       elbv2api.MutualAuthenticationModeVerify,
+									TrustStore: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[int64]*elbv2model.MutualAuthenticationAttributes{
+				443: {
+					Mode:                          "verify",
+					TrustStoreArn:                 awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				},
+			},
+		},
+		{
+			name: "IngressClassParams mutualAuthentication is invalid",
+			ing: &ClassifiedIngress{
+				Ing: &networking.Ingress{},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+						Spec: elbv2api.IngressClassParamsSpec{
+							MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+								{Port: 443, Mode: elbv2api.MutualAuthenticationModeVerify},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid mutualAuthentication in IngressClassParams awesome-class: trustStore is required when mutualAuthentication mode is verify for port 443"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.computeIngressMutualAuthentication(context.Background(), tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerDefaultActions(t *testing.T) {
+	fixedResponseParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "fixed-response-class"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			DefaultAction: &elbv2api.DefaultAction{
+				FixedResponse: &elbv2api.FixedResponseActionConfig{
+					ContentType: awssdk.String("text/plain"),
+					MessageBody: awssdk.String("not here"),
+					StatusCode:  "418",
+				},
+			},
+		},
+	}
+	redirectParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "redirect-class"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			DefaultAction: &elbv2api.DefaultAction{
+				Redirect: &elbv2api.RedirectActionConfig{
+					Host:       awssdk.String("www.example.com"),
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+	}
+	type args struct {
+		protocol elbv2model.Protocol
+		ingList  []ClassifiedIngress
+	}
+	tests := []struct {
+		name              string
+		sslRedirectConfig *SSLRedirectConfig
+		args              args
+		want              []elbv2model.Action
+		wantErr           error
+	}{
+		{
+			name: "without IngressClassParams defaultAction",
+			args: args{
+				protocol: elbv2model.ProtocolHTTP,
+				ingList: []ClassifiedIngress{
+					{Ing: &networking.Ingress{}},
+				},
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "404",
+					},
+				},
+			},
+		},
+		{
+			name: "IngressClassParams fixedResponse defaultAction",
+			args: args{
+				protocol: elbv2model.ProtocolHTTPS,
+				ingList: []ClassifiedIngress{
+					{
+						Ing:            &networking.Ingress{},
+						IngClassConfig: ClassConfiguration{IngClassParams: fixedResponseParams},
+					},
+				},
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						MessageBody: awssdk.String("not here"),
+						StatusCode:  "418",
+					},
+				},
+			},
+		},
+		{
+			name: "IngressClassParams redirect defaultAction",
+			args: args{
+				protocol: elbv2model.ProtocolHTTPS,
+				ingList: []ClassifiedIngress{
+					{
+						Ing:            &networking.Ingress{},
+						IngClassConfig: ClassConfiguration{IngClassParams: redirectParams},
+					},
+				},
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Host:       awssdk.String("www.example.com"),
+						StatusCode: "HTTP_302",
+					},
+				},
+			},
+		},
+		{
+			name: "sslRedirect takes precedence over IngressClassParams defaultAction on HTTP listener",
+			sslRedirectConfig: &SSLRedirectConfig{
+				SSLPort:    443,
+				StatusCode: "HTTP_301",
+			},
+			args: args{
+				protocol: elbv2model.ProtocolHTTP,
+				ingList: []ClassifiedIngress{
+					{
+						Ing:            &networking.Ingress{},
+						IngClassConfig: ClassConfiguration{IngClassParams: fixedResponseParams},
+					},
+				},
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Port:       awssdk.String("443"),
+						Protocol:   awssdk.String("HTTPS"),
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+		},
+		{
+			name: "conflicting IngressClassParams defaultAction",
+			args: args{
+				protocol: elbv2model.ProtocolHTTPS,
+				ingList: []ClassifiedIngress{
+					{
+						Ing:            &networking.Ingress{},
+						IngClassConfig: ClassConfiguration{IngClassParams: fixedResponseParams},
+					},
+					{
+						Ing:            &networking.Ingress{},
+						IngClassConfig: ClassConfiguration{IngClassParams: redirectParams},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting default action, IngressClassParams fixed-response-class | IngressClassParams redirect-class"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				sslRedirectConfig: tt.sslRedirectConfig,
+			}
+			got, err := task.buildListenerDefaultActions(context.Background(), tt.args.protocol, tt.args.ingList)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -40,6 +40,13 @@ func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPor
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (elbv2model.LoadBalancerSpec, error) {
+	adoption, adoptedLB, err := t.buildLoadBalancerAdoption(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	if adoption != nil {
+		return t.buildAdoptedLoadBalancerSpec(ctx, adoption, *adoptedLB)
+	}
 	scheme, err := t.buildLoadBalancerScheme(ctx)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
@@ -72,6 +79,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	deletionPolicy, err := t.buildLoadBalancerDeletionPolicy(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	return elbv2model.LoadBalancerSpec{
 		Name:                   name,
 		Type:                   elbv2model.LoadBalancerTypeApplication,
@@ -82,6 +93,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 		CustomerOwnedIPv4Pool:  coIPv4Pool,
 		LoadBalancerAttributes: loadBalancerAttributes,
 		Tags:                   tags,
+		DeletionPolicy:         deletionPolicy,
 	}, nil
 }
 
@@ -123,7 +135,20 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid), nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv2model.LoadBalancerScheme, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
+	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerExplicitScheme(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !explicitSchemeSpecified {
+		return t.defaultScheme, nil
+	}
+	return scheme, nil
+}
+
+// buildLoadBalancerExplicitScheme builds the scheme configured via IngressClassParams or annotations across the IngressGroup.
+// the boolean result is false if scheme isn't configured explicitly.
+func (t *defaultModelBuildTask) buildLoadBalancerExplicitScheme(_ context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
 	explicitSchemes := sets.String{}
 	for _, member := range t.ingGroup.Members {
 		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.Scheme != nil {
@@ -138,20 +163,42 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv
 		explicitSchemes.Insert(rawSchema)
 	}
 	if len(explicitSchemes) == 0 {
-		return t.defaultScheme, nil
+		return "", false, nil
 	}
 	if len(explicitSchemes) > 1 {
-		return "", errors.Errorf("conflicting scheme: %v", explicitSchemes)
+		return "", false, errors.Errorf("conflicting scheme: %v", explicitSchemes)
 	}
 	rawScheme, _ := explicitSchemes.PopAny()
-	switch rawScheme {
-	case string(elbv2model.LoadBalancerSchemeInternetFacing):
-		return elbv2model.LoadBalancerSchemeInternetFacing, nil
-	case string(elbv2model.LoadBalancerSchemeInternal):
-		return elbv2model.LoadBalancerSchemeInternal, nil
-	default:
-		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	scheme, err := parseLoadBalancerScheme(rawScheme)
+	if err != nil {
+		return "", false, err
+	}
+	return scheme, true, nil
+}
+
+// buildLoadBalancerDeletionPolicy builds the LoadBalancer deletion policy, it's empty if not explicitly specified.
+func (t *defaultModelBuildTask) buildLoadBalancerDeletionPolicy(_ context.Context) (elbv2model.LoadBalancerDeletionPolicy, error) {
+	explicitDeletionPolicies := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.DeletionPolicy != nil {
+			deletionPolicy := string(*member.IngClassConfig.IngClassParams.Spec.DeletionPolicy)
+			explicitDeletionPolicies.Insert(deletionPolicy)
+			continue
+		}
+		rawDeletionPolicy := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixDeletionPolicy, &rawDeletionPolicy, member.Ing.Annotations); !exists {
+			continue
+		}
+		explicitDeletionPolicies.Insert(rawDeletionPolicy)
 	}
+	if len(explicitDeletionPolicies) == 0 {
+		return "", nil
+	}
+	if len(explicitDeletionPolicies) > 1 {
+		return "", errors.Errorf("conflicting deletion policy: %v", explicitDeletionPolicies.List())
+	}
+	rawDeletionPolicy, _ := explicitDeletionPolicies.PopAny()
+	return parseLoadBalancerDeletionPolicy(rawDeletionPolicy)
 }
 
 // buildLoadBalancerIPAddressType builds the LoadBalancer IPAddressType.
@@ -176,6 +223,37 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 		return "", errors.Errorf("conflicting IPAddressType: %v", explicitIPAddressTypes.List())
 	}
 	rawIPAddressType, _ := explicitIPAddressTypes.PopAny()
+	return parseLoadBalancerIPAddressType(rawIPAddressType)
+}
+
+// parseLoadBalancerScheme parses the LoadBalancer scheme from its raw value.
+func parseLoadBalancerScheme(rawScheme string) (elbv2model.LoadBalancerScheme, error) {
+	switch rawScheme {
+	case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		return elbv2model.LoadBalancerSchemeInternetFacing, nil
+	case string(elbv2model.LoadBalancerSchemeInternal):
+		return elbv2model.LoadBalancerSchemeInternal, nil
+	default:
+		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	}
+}
+
+// parseLoadBalancerDeletionPolicy parses the LoadBalancer deletion policy from its raw value.
+func parseLoadBalancerDeletionPolicy(rawDeletionPolicy string) (elbv2model.LoadBalancerDeletionPolicy, error) {
+	switch rawDeletionPolicy {
+	case string(elbv2model.LoadBalancerDeletionPolicyDelete):
+		return elbv2model.LoadBalancerDeletionPolicyDelete, nil
+	case string(elbv2model.LoadBalancerDeletionPolicyRetain):
+		return elbv2model.LoadBalancerDeletionPolicyRetain, nil
+	case string(elbv2model.LoadBalancerDeletionPolicyOrphan):
+		return elbv2model.LoadBalancerDeletionPolicyOrphan, nil
+	default:
+		return "", errors.Errorf("unknown deletion policy: %v", rawDeletionPolicy)
+	}
+}
+
+// parseLoadBalancerIPAddressType parses the LoadBalancer IPAddressType from its raw value.
+func parseLoadBalancerIPAddressType(rawIPAddressType string) (elbv2model.IPAddressType, error) {
 	switch rawIPAddressType {
 	case string(elbv2model.IPAddressTypeIPV4):
 		return elbv2model.IPAddressTypeIPV4, nil
@@ -0,0 +1,219 @@
+package ingress
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// buildLoadBalancerAdoption builds the adoption of an existing load balancer, and validates the load balancer can be adopted.
+// it returns nil if no load balancer is adopted.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoption(ctx context.Context) (*elbv2model.LoadBalancerAdoption, *elbv2deploy.LoadBalancerWithTags, error) {
+	adoption, err := t.buildLoadBalancerAdoptionConfig(ctx)
+	if err != nil || adoption == nil {
+		return nil, nil, err
+	}
+	if !t.featureGates.Enabled(config.ListenerRulesTagging) {
+		return nil, nil, errors.Errorf("%v feature gate is required to adopt load balancer", config.ListenerRulesTagging)
+	}
+	sdkLB, err := t.elbv2TaggingManager.GetLoadBalancer(ctx, adoption.LoadBalancerARN)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := elbv2deploy.ValidateLoadBalancerAdoption(sdkLB, elbv2model.LoadBalancerTypeApplication, t.vpcID, t.trackingProvider.StackTags(t.stack)); err != nil {
+		return nil, nil, err
+	}
+	return adoption, &sdkLB, nil
+}
+
+// buildLoadBalancerAdoptionConfig builds the adoption of an existing load balancer configured via annotations across the IngressGroup.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoptionConfig(_ context.Context) (*elbv2model.LoadBalancerAdoption, error) {
+	explicitLBARNs := sets.NewString()
+	explicitUnmanagedListeners := sets.NewString()
+	var explicitClaimedPortsList [][]int64
+	for _, member := range t.ingGroup.Members {
+		rawLBARN := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerARN, &rawLBARN, member.Ing.Annotations); exists {
+			if len(rawLBARN) == 0 {
+				return nil, errors.Errorf("cannot use empty value for %s annotation, ingress: %v",
+					annotations.IngressSuffixLoadBalancerARN, k8s.NamespacedName(member.Ing))
+			}
+			if err := checkLoadBalancerAdoptable(member.IngClassConfig, rawLBARN); err != nil {
+				return nil, errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(member.Ing))
+			}
+			explicitLBARNs.Insert(rawLBARN)
+		}
+		var unmanagedListeners bool
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixUnmanagedListeners, &unmanagedListeners, member.Ing.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			explicitUnmanagedListeners.Insert(strconv.FormatBool(unmanagedListeners))
+		}
+		var rawClaimedPorts []string
+		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixClaimedListenerPorts, &rawClaimedPorts, member.Ing.Annotations); exists {
+			claimedPorts, err := parseClaimedListenerPorts(rawClaimedPorts)
+			if err != nil {
+				return nil, err
+			}
+			explicitClaimedPortsList = append(explicitClaimedPortsList, claimedPorts)
+		}
+	}
+
+	if len(explicitLBARNs) == 0 {
+		if len(explicitUnmanagedListeners) != 0 || len(explicitClaimedPortsList) != 0 {
+			return nil, errors.Errorf("%v annotation is required to adopt load balancer", annotations.IngressSuffixLoadBalancerARN)
+		}
+		return nil, nil
+	}
+	if len(explicitLBARNs) > 1 {
+		return nil, errors.Errorf("conflicting load balancer ARNs: %v", explicitLBARNs.List())
+	}
+	if len(explicitUnmanagedListeners) > 1 {
+		return nil, errors.Errorf("conflicting unmanaged listeners: %v", explicitUnmanagedListeners.List())
+	}
+	var claimedPorts []int64
+	if len(explicitClaimedPortsList) != 0 {
+		claimedPorts = explicitClaimedPortsList[0]
+		for _, ports := range explicitClaimedPortsList[1:] {
+			if !sets.NewInt64(claimedPorts...).Equal(sets.NewInt64(ports...)) {
+				return nil, errors.Errorf("conflicting claimed listener ports: %v | %v", claimedPorts, ports)
+			}
+		}
+	}
+	unmanagedListeners := explicitUnmanagedListeners.Has(strconv.FormatBool(true))
+	if len(claimedPorts) != 0 && !unmanagedListeners {
+		return nil, errors.Errorf("%v annotation is only supported with unmanaged listeners", annotations.IngressSuffixClaimedListenerPorts)
+	}
+	lbARN, _ := explicitLBARNs.PopAny()
+	return &elbv2model.LoadBalancerAdoption{
+		LoadBalancerARN:      lbARN,
+		UnmanagedListeners:   unmanagedListeners,
+		ClaimedListenerPorts: claimedPorts,
+	}, nil
+}
+
+// checkLoadBalancerAdoptable checks the load balancer is adoptable per IngressClassParams of the Ingress.
+// Ingresses are namespace-scoped, so adopting by annotation alone would let any tenant take over load balancers.
+func checkLoadBalancerAdoptable(ingClassConfig ClassConfiguration, lbARN string) error {
+	ingClassParams := ingClassConfig.IngClassParams
+	if ingClassParams == nil || !sets.NewString(ingClassParams.Spec.AdoptableLoadBalancers...).Has(lbARN) {
+		return errors.Errorf("loadBalancer %v is not in adoptableLoadBalancers of IngressClassParams", lbARN)
+	}
+	return nil
+}
+
+// buildAdoptedLoadBalancerSpec builds the spec of the adopted load balancer.
+// the scheme, IPAddressType, subnets, security groups and CoIP pool of the load balancer are kept as is.
+func (t *defaultModelBuildTask) buildAdoptedLoadBalancerSpec(ctx context.Context, adoption *elbv2model.LoadBalancerAdoption,
+	adoptedLB elbv2deploy.LoadBalancerWithTags) (elbv2model.LoadBalancerSpec, error) {
+	scheme, err := t.buildAdoptedLoadBalancerScheme(ctx, adoptedLB)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType := elbv2model.IPAddressType(awssdk.StringValue(adoptedLB.LoadBalancer.IpAddressType))
+	securityGroups, err := t.buildAdoptedLoadBalancerSecurityGroups(ctx, adoptedLB)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetIDs := make([]string, 0, len(adoptedLB.LoadBalancer.AvailabilityZones))
+	for _, availabilityZone := range adoptedLB.LoadBalancer.AvailabilityZones {
+		subnetIDs = append(subnetIDs, awssdk.StringValue(availabilityZone.SubnetId))
+	}
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	deletionPolicy, err := t.buildLoadBalancerDeletionPolicy(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	return elbv2model.LoadBalancerSpec{
+		Name:                   awssdk.StringValue(adoptedLB.LoadBalancer.LoadBalancerName),
+		Type:                   elbv2model.LoadBalancerTypeApplication,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SubnetMappings:         buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs),
+		SecurityGroups:         securityGroups,
+		CustomerOwnedIPv4Pool:  adoptedLB.LoadBalancer.CustomerOwnedIpv4Pool,
+		LoadBalancerAttributes: loadBalancerAttributes,
+		Tags:                   tags,
+		DeletionPolicy:         deletionPolicy,
+		Adoption:               adoption,
+	}, nil
+}
+
+// buildAdoptedLoadBalancerScheme returns the scheme of the adopted load balancer, which must match the explicit scheme if specified.
+func (t *defaultModelBuildTask) buildAdoptedLoadBalancerScheme(ctx context.Context, adoptedLB elbv2deploy.LoadBalancerWithTags) (elbv2model.LoadBalancerScheme, error) {
+	scheme := elbv2model.LoadBalancerScheme(awssdk.StringValue(adoptedLB.LoadBalancer.Scheme))
+	explicitScheme, explicitSchemeSpecified, err := t.buildLoadBalancerExplicitScheme(ctx)
+	if err != nil {
+		return "", err
+	}
+	if explicitSchemeSpecified && explicitScheme != scheme {
+		return "", errors.Errorf("cannot adopt loadBalancer %v: its scheme is %v instead of %v",
+			awssdk.StringValue(adoptedLB.LoadBalancer.LoadBalancerArn), scheme, explicitScheme)
+	}
+	return scheme, nil
+}
+
+// buildAdoptedLoadBalancerSecurityGroups builds the security groups of the adopted load balancer.
+// the backend security group is added to existing security groups if backend security group rules are managed.
+func (t *defaultModelBuildTask) buildAdoptedLoadBalancerSecurityGroups(ctx context.Context, adoptedLB elbv2deploy.LoadBalancerWithTags) ([]core.StringToken, error) {
+	manageBackendSGRules, err := t.buildManageSecurityGroupRulesFlag(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backendSGID := ""
+	if manageBackendSGRules {
+		if !t.enableBackendSG {
+			return nil, errors.New("backendSG feature is required to manage worker node SG rules for adopted load balancer")
+		}
+		backendSGID, err = t.backendSGProvider.Get(ctx, networking.ResourceTypeIngress, k8s.ToSliceOfNamespacedNames(t.ingGroup.Members))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var lbSGTokens []core.StringToken
+	for _, sgID := range awssdk.StringValueSlice(adoptedLB.LoadBalancer.SecurityGroups) {
+		if sgID == backendSGID {
+			continue
+		}
+		lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+	}
+	if manageBackendSGRules {
+		t.backendSGIDToken = core.LiteralStringToken(backendSGID)
+		t.backendSGAllocated = true
+		lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
+	}
+	t.logger.Info("SG of adopted load balancer", "LB SGs", lbSGTokens, "backend SG", t.backendSGIDToken)
+	return lbSGTokens, nil
+}
+
+// parseClaimedListenerPorts parses the ports of claimed listeners.
+func parseClaimedListenerPorts(rawClaimedPorts []string) ([]int64, error) {
+	var claimedPorts []int64
+	for _, rawPort := range rawClaimedPorts {
+		port, err := strconv.ParseInt(rawPort, 10, 64)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, errors.Errorf("invalid claimed listener port: %v", rawPort)
+		}
+		claimedPorts = append(claimedPorts, port)
+	}
+	return claimedPorts, nil
+}
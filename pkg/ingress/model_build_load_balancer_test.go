@@ -1295,3 +1295,233 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerAdoptionConfig(t *testing.T) {
+	adoptableIngClassParams := &v1beta1.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: v1beta1.IngressClassParamsSpec{
+			AdoptableLoadBalancers: []string{"my-arn", "my-another-arn"},
+		},
+	}
+	newIngressWithClassParams := func(name string, annotations map[string]string, ingClassParams *v1beta1.IngressClassParams) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        name,
+					Annotations: annotations,
+				},
+			},
+			IngClassConfig: ClassConfiguration{
+				IngClassParams: ingClassParams,
+			},
+		}
+	}
+	newIngress := func(name string, annotations map[string]string) ClassifiedIngress {
+		return newIngressWithClassParams(name, annotations, adoptableIngClassParams)
+	}
+	tests := []struct {
+		name    string
+		members []ClassifiedIngress
+		want    *elbv2.LoadBalancerAdoption
+		wantErr error
+	}{
+		{
+			name: "load balancer not adopted",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{}),
+			},
+			want: nil,
+		},
+		{
+			name: "load balancer adopted with unmanaged listeners",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn":      "my-arn",
+					"alb.ingress.kubernetes.io/unmanaged-listeners":    "true",
+					"alb.ingress.kubernetes.io/claimed-listener-ports": "80, 443",
+				}),
+				newIngress("ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn":      "my-arn",
+					"alb.ingress.kubernetes.io/claimed-listener-ports": "443, 80",
+				}),
+			},
+			want: &elbv2.LoadBalancerAdoption{
+				LoadBalancerARN:      "my-arn",
+				UnmanagedListeners:   true,
+				ClaimedListenerPorts: []int64{80, 443},
+			},
+		},
+		{
+			name: "conflicting load balancer ARNs",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn": "my-arn",
+				}),
+				newIngress("ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn": "my-another-arn",
+				}),
+			},
+			wantErr: errors.New("conflicting load balancer ARNs: [my-another-arn my-arn]"),
+		},
+		{
+			name: "conflicting claimed listener ports",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn":      "my-arn",
+					"alb.ingress.kubernetes.io/unmanaged-listeners":    "true",
+					"alb.ingress.kubernetes.io/claimed-listener-ports": "80",
+				}),
+				newIngress("ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/claimed-listener-ports": "443",
+				}),
+			},
+			wantErr: errors.New("conflicting claimed listener ports: [80] | [443]"),
+		},
+		{
+			name: "claimed listener ports without unmanaged listeners",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn":      "my-arn",
+					"alb.ingress.kubernetes.io/claimed-listener-ports": "80",
+				}),
+			},
+			wantErr: errors.New("claimed-listener-ports annotation is only supported with unmanaged listeners"),
+		},
+		{
+			name: "load balancer not in adoptableLoadBalancers",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn": "other-arn",
+				}),
+			},
+			wantErr: errors.New("ingress: awesome-ns/ing-1: loadBalancer other-arn is not in adoptableLoadBalancers of IngressClassParams"),
+		},
+		{
+			name: "load balancer adopted without IngressClassParams",
+			members: []ClassifiedIngress{
+				newIngressWithClassParams("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/load-balancer-arn": "my-arn",
+				}, nil),
+			},
+			wantErr: errors.New("ingress: awesome-ns/ing-1: loadBalancer my-arn is not in adoptableLoadBalancers of IngressClassParams"),
+		},
+		{
+			name: "unmanaged listeners without load balancer ARN",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/unmanaged-listeners": "true",
+				}),
+			},
+			wantErr: errors.New("load-balancer-arn annotation is required to adopt load balancer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup:         Group{Members: tt.members},
+			}
+			got, err := task.buildLoadBalancerAdoptionConfig(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerDeletionPolicy(t *testing.T) {
+	retainPolicy := v1beta1.LoadBalancerDeletionPolicyRetain
+	newIngress := func(name string, annotations map[string]string, ingClassParams *v1beta1.IngressClassParams) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        name,
+					Annotations: annotations,
+				},
+			},
+			IngClassConfig: ClassConfiguration{
+				IngClassParams: ingClassParams,
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		members []ClassifiedIngress
+		want    elbv2.LoadBalancerDeletionPolicy
+		wantErr error
+	}{
+		{
+			name: "deletion policy not specified",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{}, nil),
+			},
+			want: "",
+		},
+		{
+			name: "deletion policy specified via annotation",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/deletion-policy": "Orphan",
+				}, nil),
+				newIngress("ing-2", map[string]string{}, nil),
+			},
+			want: elbv2.LoadBalancerDeletionPolicyOrphan,
+		},
+		{
+			name: "deletion policy specified via IngressClassParams takes priority",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/deletion-policy": "Delete",
+				}, &v1beta1.IngressClassParams{
+					Spec: v1beta1.IngressClassParamsSpec{
+						DeletionPolicy: &retainPolicy,
+					},
+				}),
+			},
+			want: elbv2.LoadBalancerDeletionPolicyRetain,
+		},
+		{
+			name: "conflicting deletion policy",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/deletion-policy": "Retain",
+				}, nil),
+				newIngress("ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/deletion-policy": "Delete",
+				}, nil),
+			},
+			wantErr: errors.New("conflicting deletion policy: [Delete Retain]"),
+		},
+		{
+			name: "unknown deletion policy",
+			members: []ClassifiedIngress{
+				newIngress("ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/deletion-policy": "Keep",
+				}, nil),
+			},
+			wantErr: errors.New("unknown deletion policy: Keep"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup:         Group{Members: tt.members},
+			}
+			got, err := task.buildLoadBalancerDeletionPolicy(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -141,23 +141,24 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) (elbv2model.TargetGroupSpec, error) {
 	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
-	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations)
+	tgParams := t.buildTargetGroupParams(ing)
+	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svcAndIngAnnotations)
+	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, svcAndIngAnnotations)
+	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, targetType, tgProtocol, tgProtocolVersion)
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, tgParams, targetType, tgProtocol, tgProtocolVersion)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations)
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -208,16 +209,25 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.TargetType, error) {
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (elbv2model.TargetType, error) {
 	rawTargetType := string(t.defaultTargetType)
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixTargetType, tgParams.TargetType, &rawTargetType, svcAndIngAnnotations)
+	targetType, err := parseTargetType(rawTargetType)
+	if err != nil {
+		return "", err
+	}
+	if targetType == elbv2model.TargetTypeIP && !t.enableIPTargetType {
+		return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", rawTargetType, t.enableIPTargetType)
+	}
+	return targetType, nil
+}
+
+// parseTargetType parses the TargetGroup's targetType from its raw value.
+func parseTargetType(rawTargetType string) (elbv2model.TargetType, error) {
 	switch rawTargetType {
 	case string(elbv2model.TargetTypeInstance):
 		return elbv2model.TargetTypeInstance, nil
 	case string(elbv2model.TargetTypeIP):
-		if !t.enableIPTargetType {
-			return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", rawTargetType, t.enableIPTargetType)
-		}
 		return elbv2model.TargetTypeIP, nil
 	default:
 		return "", errors.Errorf("unknown targetType: %v", rawTargetType)
@@ -257,9 +267,14 @@ func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetTy
 	return 1
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.Protocol, error) {
+func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (elbv2model.Protocol, error) {
 	rawBackendProtocol := string(t.defaultBackendProtocol)
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocol, &rawBackendProtocol, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixBackendProtocol, tgParams.BackendProtocol, &rawBackendProtocol, svcAndIngAnnotations)
+	return parseBackendProtocol(rawBackendProtocol)
+}
+
+// parseBackendProtocol parses the TargetGroup's protocol from its raw value.
+func parseBackendProtocol(rawBackendProtocol string) (elbv2model.Protocol, error) {
 	switch rawBackendProtocol {
 	case string(elbv2model.ProtocolHTTP):
 		return elbv2model.ProtocolHTTP, nil
@@ -270,9 +285,14 @@ func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcA
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.ProtocolVersion, error) {
+func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (elbv2model.ProtocolVersion, error) {
 	rawBackendProtocolVersion := string(t.defaultBackendProtocolVersion)
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocolVersion, &rawBackendProtocolVersion, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixBackendProtocolVersion, tgParams.BackendProtocolVersion, &rawBackendProtocolVersion, svcAndIngAnnotations)
+	return parseBackendProtocolVersion(rawBackendProtocolVersion)
+}
+
+// parseBackendProtocolVersion parses the TargetGroup's protocolVersion from its raw value.
+func parseBackendProtocolVersion(rawBackendProtocolVersion string) (elbv2model.ProtocolVersion, error) {
 	switch rawBackendProtocolVersion {
 	case string(elbv2model.ProtocolVersionHTTP1):
 		return elbv2model.ProtocolVersionHTTP1, nil
@@ -285,30 +305,30 @@ func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Contex
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
-	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndIngAnnotations, targetType)
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndIngAnnotations, tgParams, targetType)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, svcAndIngAnnotations, tgProtocol)
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, svcAndIngAnnotations, tgParams, tgProtocol)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckPath := t.buildTargetGroupHealthCheckPath(ctx, svcAndIngAnnotations, tgProtocolVersion)
-	healthCheckMatcher := t.buildTargetGroupHealthCheckMatcher(ctx, svcAndIngAnnotations, tgProtocolVersion)
-	healthCheckIntervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, svcAndIngAnnotations)
+	healthCheckPath := t.buildTargetGroupHealthCheckPath(ctx, svcAndIngAnnotations, tgParams, tgProtocolVersion)
+	healthCheckMatcher := t.buildTargetGroupHealthCheckMatcher(ctx, svcAndIngAnnotations, tgParams, tgProtocolVersion)
+	healthCheckIntervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckTimeoutSeconds, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, svcAndIngAnnotations)
+	healthCheckTimeoutSeconds, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckHealthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, svcAndIngAnnotations)
+	healthCheckHealthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckUnhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, svcAndIngAnnotations)
+	healthCheckUnhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, svcAndIngAnnotations, tgParams)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
@@ -324,9 +344,9 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Co
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
 	rawHealthCheckPort := ""
-	if exist := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAndIngAnnotations); !exist {
+	if exist := t.parseTargetGroupStringParam(annotations.IngressSuffixHealthCheckPort, tgParams.HealthCheckPort, &rawHealthCheckPort, svcAndIngAnnotations); !exist {
 		return intstr.FromString(healthCheckPortTrafficPort), nil
 	}
 	if rawHealthCheckPort == healthCheckPortTrafficPort {
@@ -350,9 +370,14 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Contex
 	return intstr.IntOrString{}, errors.New("cannot use named healthCheckPort for IP TargetType when service's targetPort is a named port")
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocol elbv2model.Protocol) (elbv2model.Protocol, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams, tgProtocol elbv2model.Protocol) (elbv2model.Protocol, error) {
 	rawHealthCheckProtocol := string(tgProtocol)
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckProtocol, &rawHealthCheckProtocol, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixHealthCheckProtocol, tgParams.HealthCheckProtocol, &rawHealthCheckProtocol, svcAndIngAnnotations)
+	return parseHealthCheckProtocol(rawHealthCheckProtocol)
+}
+
+// parseHealthCheckProtocol parses the TargetGroup's healthCheck protocol from its raw value.
+func parseHealthCheckProtocol(rawHealthCheckProtocol string) (elbv2model.Protocol, error) {
 	switch rawHealthCheckProtocol {
 	case string(elbv2model.ProtocolHTTP):
 		return elbv2model.ProtocolHTTP, nil
@@ -363,7 +388,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Co
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocolVersion elbv2model.ProtocolVersion) string {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams, tgProtocolVersion elbv2model.ProtocolVersion) string {
 	var rawHealthCheckPath string
 	switch tgProtocolVersion {
 	case elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2:
@@ -371,11 +396,11 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Contex
 	case elbv2model.ProtocolVersionGRPC:
 		rawHealthCheckPath = t.defaultHealthCheckPathGRPC
 	}
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPath, &rawHealthCheckPath, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixHealthCheckPath, tgParams.HealthCheckPath, &rawHealthCheckPath, svcAndIngAnnotations)
 	return rawHealthCheckPath
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocolVersion elbv2model.ProtocolVersion) elbv2model.HealthCheckMatcher {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams, tgProtocolVersion elbv2model.ProtocolVersion) elbv2model.HealthCheckMatcher {
 	var rawHealthCheckMatcherHTTPCode string
 	switch tgProtocolVersion {
 	case elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2:
@@ -384,7 +409,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Con
 		rawHealthCheckMatcherHTTPCode = t.defaultHealthCheckMatcherGRPCCode
 	}
 
-	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSuccessCodes, &rawHealthCheckMatcherHTTPCode, svcAndIngAnnotations)
+	_ = t.parseTargetGroupStringParam(annotations.IngressSuffixSuccessCodes, tgParams.SuccessCodes, &rawHealthCheckMatcherHTTPCode, svcAndIngAnnotations)
 	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
 		return elbv2model.HealthCheckMatcher{
 			GRPCCode: &rawHealthCheckMatcherHTTPCode,
@@ -395,47 +420,57 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Con
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (int64, error) {
 	rawHealthCheckIntervalSeconds := t.defaultHealthCheckIntervalSeconds
-	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckIntervalSeconds,
+	if _, err := t.parseTargetGroupInt64Param(annotations.IngressSuffixHealthCheckIntervalSeconds, tgParams.HealthCheckIntervalSeconds,
 		&rawHealthCheckIntervalSeconds, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
 	return rawHealthCheckIntervalSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (int64, error) {
 	rawHealthCheckTimeoutSeconds := t.defaultHealthCheckTimeoutSeconds
-	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckTimeoutSeconds,
+	if _, err := t.parseTargetGroupInt64Param(annotations.IngressSuffixHealthCheckTimeoutSeconds, tgParams.HealthCheckTimeoutSeconds,
 		&rawHealthCheckTimeoutSeconds, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
 	return rawHealthCheckTimeoutSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (int64, error) {
 	rawHealthCheckHealthyThresholdCount := t.defaultHealthCheckHealthyThresholdCount
-	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthyThresholdCount,
+	if _, err := t.parseTargetGroupInt64Param(annotations.IngressSuffixHealthyThresholdCount, tgParams.HealthyThresholdCount,
 		&rawHealthCheckHealthyThresholdCount, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
 	return rawHealthCheckHealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) (int64, error) {
 	rawHealthCheckUnhealthyThresholdCount := t.defaultHealthCheckUnhealthyThresholdCount
-	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixUnhealthyThresholdCount,
+	if _, err := t.parseTargetGroupInt64Param(annotations.IngressSuffixUnhealthyThresholdCount, tgParams.UnhealthyThresholdCount,
 		&rawHealthCheckUnhealthyThresholdCount, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
 	return rawHealthCheckUnhealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
-	var rawAttributes map[string]string
-	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndIngAnnotations); err != nil {
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string, tgParams elbv2api.TargetGroupParams) ([]elbv2model.TargetGroupAttribute, error) {
+	var annotationAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &annotationAttributes, svcAndIngAnnotations); err != nil {
 		return nil, err
 	}
+	enforcedAttributes := make(map[string]string)
+	defaultAttributes := make(map[string]string)
+	for _, attr := range tgParams.Attributes {
+		if attr.Enforced {
+			enforcedAttributes[attr.Key] = attr.Value
+		} else {
+			defaultAttributes[attr.Key] = attr.Value
+		}
+	}
+	rawAttributes := algorithm.MergeStringMap(enforcedAttributes, annotationAttributes, defaultAttributes)
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
@@ -446,6 +481,49 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, sv
 	return attributes, nil
 }
 
+// buildTargetGroupParams returns the target group settings from IngressClassParams of Ingress if any.
+func (t *defaultModelBuildTask) buildTargetGroupParams(ing ClassifiedIngress) elbv2api.TargetGroupParams {
+	if ing.IngClassConfig.IngClassParams == nil || ing.IngClassConfig.IngClassParams.Spec.TargetGroup == nil {
+		return elbv2api.TargetGroupParams{}
+	}
+	return *ing.IngClassConfig.IngClassParams.Spec.TargetGroup
+}
+
+// parseTargetGroupStringParam parses a string target group setting into value, in the order of
+// enforced IngressClassParams value, Service/Ingress annotation and IngressClassParams default value.
+// value is left untouched and false is returned when none of them is set.
+func (t *defaultModelBuildTask) parseTargetGroupStringParam(annotation string, param *elbv2api.TargetGroupStringParam, value *string, svcAndIngAnnotations map[string]string) bool {
+	if param != nil && param.Enforced {
+		*value = param.Value
+		return true
+	}
+	if exists := t.annotationParser.ParseStringAnnotation(annotation, value, svcAndIngAnnotations); exists {
+		return true
+	}
+	if param != nil {
+		*value = param.Value
+		return true
+	}
+	return false
+}
+
+// parseTargetGroupInt64Param parses an integer target group setting into value, in the same order as parseTargetGroupStringParam.
+func (t *defaultModelBuildTask) parseTargetGroupInt64Param(annotation string, param *elbv2api.TargetGroupInt64Param, value *int64, svcAndIngAnnotations map[string]string) (bool, error) {
+	if param != nil && param.Enforced {
+		*value = param.Value
+		return true, nil
+	}
+	exists, err := t.annotationParser.ParseInt64Annotation(annotation, value, svcAndIngAnnotations)
+	if err != nil || exists {
+		return exists, err
+	}
+	if param != nil {
+		*value = param.Value
+		return true, nil
+	}
+	return false, nil
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing ClassifiedIngress, svc *corev1.Service) (map[string]string, error) {
 	ingSvcTags, err := t.buildIngressBackendResourceTags(ing, svc)
 	if err != nil {
@@ -455,6 +533,10 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing Clas
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
+	// Services in another namespace are qualified with their namespace to avoid collisions with Services in Ingress's namespace.
+	if svcKey.Namespace != ingKey.Namespace {
+		return fmt.Sprintf("%s/%s-%s/%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Namespace, svcKey.Name, port.String())
+	}
 	return fmt.Sprintf("%s/%s-%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Name, port.String())
 }
 
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -381,6 +382,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 	}
 	type args struct {
 		svcAndIngAnnotations map[string]string
+		tgParams             elbv2api.TargetGroupParams
 		tgProtocolVersion    elbv2model.ProtocolVersion
 	}
 	tests := []struct {
@@ -467,6 +469,54 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 			},
 			want: "/package.service/method",
 		},
+		{
+			name: "HTTP1, with IngressClassParams default and annotation configured",
+			fields: fields{
+				defaultHealthCheckPathHTTP: "/",
+				defaultHealthCheckPathGRPC: "/AWS.ALB/healthcheck",
+			},
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/healthcheck-path": "/ping",
+				},
+				tgParams: elbv2api.TargetGroupParams{
+					HealthCheckPath: &elbv2api.TargetGroupStringParam{Value: "/healthz"},
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: "/ping",
+		},
+		{
+			name: "HTTP1, with IngressClassParams default and no annotation configured",
+			fields: fields{
+				defaultHealthCheckPathHTTP: "/",
+				defaultHealthCheckPathGRPC: "/AWS.ALB/healthcheck",
+			},
+			args: args{
+				tgParams: elbv2api.TargetGroupParams{
+					HealthCheckPath: &elbv2api.TargetGroupStringParam{Value: "/healthz"},
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: "/healthz",
+		},
+		{
+			name: "HTTP1, with IngressClassParams enforced and annotation configured",
+			fields: fields{
+				defaultHealthCheckPathHTTP: "/",
+				defaultHealthCheckPathGRPC: "/AWS.ALB/healthcheck",
+			},
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/healthcheck-path": "/ping",
+				},
+				tgParams: elbv2api.TargetGroupParams{
+					HealthCheckPath: &elbv2api.TargetGroupStringParam{Value: "/healthz", Enforced: true},
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: "/healthz",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -475,7 +525,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 				defaultHealthCheckPathHTTP: tt.fields.defaultHealthCheckPathHTTP,
 				defaultHealthCheckPathGRPC: tt.fields.defaultHealthCheckPathGRPC,
 			}
-			got := task.buildTargetGroupHealthCheckPath(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupHealthCheckPath(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgParams, tt.args.tgProtocolVersion)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -488,6 +538,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 	}
 	type args struct {
 		svcAndIngAnnotations map[string]string
+		tgParams             elbv2api.TargetGroupParams
 		tgProtocolVersion    elbv2model.ProtocolVersion
 	}
 	tests := []struct {
@@ -594,7 +645,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 				defaultHealthCheckMatcherHTTPCode: tt.fields.defaultHealthCheckMatcherHTTPCode,
 				defaultHealthCheckMatcherGRPCCode: tt.fields.defaultHealthCheckMatcherGRPCCode,
 			}
-			got := task.buildTargetGroupHealthCheckMatcher(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupHealthCheckMatcher(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgParams, tt.args.tgProtocolVersion)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -732,3 +783,112 @@ func Test_defaultModelBuildTask_buildTargetGroupBindingNodeSelector(t *testing.T
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildTargetGroupHealthCheckIntervalSeconds(t *testing.T) {
+	tests := []struct {
+		name                 string
+		svcAndIngAnnotations map[string]string
+		tgParams             elbv2api.TargetGroupParams
+		want                 int64
+		wantErr              error
+	}{
+		{
+			name: "without annotation and IngressClassParams",
+			want: 15,
+		},
+		{
+			name: "with IngressClassParams default",
+			tgParams: elbv2api.TargetGroupParams{
+				HealthCheckIntervalSeconds: &elbv2api.TargetGroupInt64Param{Value: 10},
+			},
+			want: 10,
+		},
+		{
+			name: "with IngressClassParams default and annotation",
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/healthcheck-interval-seconds": "30",
+			},
+			tgParams: elbv2api.TargetGroupParams{
+				HealthCheckIntervalSeconds: &elbv2api.TargetGroupInt64Param{Value: 10},
+			},
+			want: 30,
+		},
+		{
+			name: "with IngressClassParams enforced and annotation",
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/healthcheck-interval-seconds": "30",
+			},
+			tgParams: elbv2api.TargetGroupParams{
+				HealthCheckIntervalSeconds: &elbv2api.TargetGroupInt64Param{Value: 10, Enforced: true},
+			},
+			want: 10,
+		},
+		{
+			name: "with invalid annotation",
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/healthcheck-interval-seconds": "ten",
+			},
+			tgParams: elbv2api.TargetGroupParams{
+				HealthCheckIntervalSeconds: &elbv2api.TargetGroupInt64Param{Value: 10},
+			},
+			wantErr: errors.New("failed to parse int64 annotation, alb.ingress.kubernetes.io/healthcheck-interval-seconds: ten: strconv.ParseInt: parsing \"ten\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser:                  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultHealthCheckIntervalSeconds: 15,
+			}
+			got, err := task.buildTargetGroupHealthCheckIntervalSeconds(context.Background(), tt.svcAndIngAnnotations, tt.tgParams)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupAttributes(t *testing.T) {
+	tests := []struct {
+		name                 string
+		svcAndIngAnnotations map[string]string
+		tgParams             elbv2api.TargetGroupParams
+		want                 []elbv2model.TargetGroupAttribute
+	}{
+		{
+			name: "without annotation and IngressClassParams",
+			want: []elbv2model.TargetGroupAttribute{},
+		},
+		{
+			name: "with IngressClassParams attributes and annotation",
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=60,slow_start.duration_seconds=30",
+			},
+			tgParams: elbv2api.TargetGroupParams{
+				Attributes: []elbv2api.TargetGroupAttributeParam{
+					{Key: "deregistration_delay.timeout_seconds", Value: "120"},
+					{Key: "slow_start.duration_seconds", Value: "0", Enforced: true},
+					{Key: "load_balancing.algorithm.type", Value: "least_outstanding_requests"},
+				},
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{Key: "deregistration_delay.timeout_seconds", Value: "60"},
+				{Key: "load_balancing.algorithm.type", Value: "least_outstanding_requests"},
+				{Key: "slow_start.duration_seconds", Value: "0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildTargetGroupAttributes(context.Background(), tt.svcAndIngAnnotations, tt.tgParams)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strconv"
 
@@ -36,6 +37,32 @@ type ModelBuilder interface {
 	Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error)
 }
 
+// NewMemberBuildError constructs new MemberBuildError.
+func NewMemberBuildError(ingKey types.NamespacedName, err error) *MemberBuildError {
+	return &MemberBuildError{
+		IngKey: ingKey,
+		err:    err,
+	}
+}
+
+var _ error = &MemberBuildError{}
+
+// MemberBuildError is an error that fails model building due to a specific member Ingress of IngressGroup.
+// e.g. an invalid annotation, a missing backend Service or a missing certificate on the Ingress.
+type MemberBuildError struct {
+	// IngKey is the key of member Ingress that caused the error.
+	IngKey types.NamespacedName
+	err    error
+}
+
+func (e *MemberBuildError) Error() string {
+	return fmt.Sprintf("ingress: %v: %v", e.IngKey, e.err)
+}
+
+func (e *MemberBuildError) Unwrap() error {
+	return e.err
+}
+
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
 func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventRecorder,
 	ec2Client services.EC2, elbv2Client services.ELBV2, acmClient services.ACM,
@@ -241,7 +268,7 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		ingKey := k8s.NamespacedName(member.Ing)
 		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, &member)
 		if err != nil {
-			return errors.Wrapf(err, "ingress: %v", ingKey.String())
+			return NewMemberBuildError(ingKey, err)
 		}
 		for port, cfg := range listenPortConfigByPortForIngress {
 			ingListByPort[port] = append(ingListByPort[port], member)
@@ -402,6 +429,10 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 func (t *defaultModelBuildTask) buildSSLRedirectConfig(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*SSLRedirectConfig, error) {
 	explicitSSLRedirectPorts := sets.Int64{}
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.SSLRedirectPort != nil {
+			explicitSSLRedirectPorts.Insert(int64(*member.IngClassConfig.IngClassParams.Spec.SSLRedirectPort))
+			continue
+		}
 		var rawSSLRedirectPort int64
 		exists, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixSSLRedirect, &rawSSLRedirectPort, member.Ing.Annotations)
 		if err != nil {
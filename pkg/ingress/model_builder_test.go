@@ -3399,7 +3399,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			certDiscovery := NewMockCertDiscovery(ctrl)
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, NewDefaultBackendGrantChecker(k8sClient), true, true)
 			ruleOptimizer := NewDefaultRuleOptimizer(logr.New(&log.NullLogSink{}))
 			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName)
 			stackMarshaller := deploy.NewDefaultStackMarshaller()
@@ -4094,6 +4094,46 @@ func Test_defaultModelBuildTask_buildSSLRedirectConfig(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("conflicting sslRedirect port: [443 8443]"),
 		},
+		{
+			name: "IngressClassParams sslRedirectPort takes precedence over ssl-redirect annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/ssl-redirect": "8443",
+								},
+							}},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &v1beta1.IngressClassParams{
+									Spec: v1beta1.IngressClassParamsSpec{
+										SSLRedirectPort: awssdk.Int32(443),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				listenPortConfigByPort: map[int64]listenPortConfig{
+					80: {
+						protocol: elbv2model.ProtocolHTTP,
+					},
+					443: {
+						protocol: elbv2model.ProtocolHTTPS,
+					},
+				},
+			},
+			want: &SSLRedirectConfig{
+				SSLPort:    443,
+				StatusCode: "HTTP_301",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,193 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the Kind for NamespacedIngressClassParams CRD.
+	namespacedIngressClassParamsKind = "NamespacedIngressClassParams"
+)
+
+// FindTenantPolicyIngressClassParams finds the IngressClassParams whose tenantPolicy covers IngressClass.
+// Returns nil if there is no such IngressClassParams.
+func FindTenantPolicyIngressClassParams(ctx context.Context, k8sClient client.Client, ingClassName string) (*elbv2api.IngressClassParams, error) {
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := k8sClient.List(ctx, ingClassParamsList); err != nil {
+		return nil, err
+	}
+	var matchedParams []*elbv2api.IngressClassParams
+	for i := range ingClassParamsList.Items {
+		ingClassParams := &ingClassParamsList.Items[i]
+		if ingClassParams.Spec.TenantPolicy == nil {
+			continue
+		}
+		if sets.NewString(ingClassParams.Spec.TenantPolicy.IngressClassNames...).Has(ingClassName) {
+			matchedParams = append(matchedParams, ingClassParams)
+		}
+	}
+	if len(matchedParams) == 0 {
+		return nil, nil
+	}
+	if len(matchedParams) > 1 {
+		names := make([]string, 0, len(matchedParams))
+		for _, params := range matchedParams {
+			names = append(names, params.Name)
+		}
+		return nil, errors.Errorf("multiple IngressClassParams define tenantPolicy for IngressClass %v: %v", ingClassName, names)
+	}
+	return matchedParams[0], nil
+}
+
+// IsNamespacedIngressClassParamsRef checks whether IngressClass references the NamespacedIngressClassParams.
+func IsNamespacedIngressClassParamsRef(ingClass *networking.IngressClass, nsParamsKey types.NamespacedName) bool {
+	paramsRef := ingClass.Spec.Parameters
+	if ingClass.Spec.Controller != IngressClassControllerALB || paramsRef == nil {
+		return false
+	}
+	if paramsRef.APIGroup == nil || *paramsRef.APIGroup != elbv2api.GroupVersion.Group || paramsRef.Kind != namespacedIngressClassParamsKind {
+		return false
+	}
+	return paramsRef.Namespace != nil && *paramsRef.Namespace == nsParamsKey.Namespace && paramsRef.Name == nsParamsKey.Name
+}
+
+// FindTenantPolicyViolations returns the settings of NamespacedIngressClassParams that aren't allowed by tenantPolicy.
+func FindTenantPolicyViolations(tenantPolicy elbv2api.TenantPolicy, nsParamsSpec elbv2api.NamespacedIngressClassParamsSpec) []string {
+	allowedValuesByField := make(map[elbv2api.TenantOverrideField][]string, len(tenantPolicy.AllowedOverrides))
+	for _, override := range tenantPolicy.AllowedOverrides {
+		allowedValuesByField[override.Field] = override.AllowedValues
+	}
+
+	var violations []string
+	for _, override := range extractTenantOverrides(nsParamsSpec) {
+		allowedValues, allowed := allowedValuesByField[override.field]
+		if !allowed {
+			violations = append(violations, fmt.Sprintf("field %v is not allowed to be overridden", override.field))
+			continue
+		}
+		if len(allowedValues) == 0 {
+			continue
+		}
+		allowedValueSet := sets.NewString(allowedValues...)
+		for _, value := range override.values {
+			if !allowedValueSet.Has(value) {
+				violations = append(violations, fmt.Sprintf("value %v of field %v is not allowed, allowed values: %v", value, override.field, allowedValues))
+			}
+		}
+	}
+	return violations
+}
+
+// mergeNamespacedIngressClassParams computes the effective IngressClassParams by overriding base IngressClassParams with NamespacedIngressClassParams.
+func mergeNamespacedIngressClassParams(baseParams *elbv2api.IngressClassParams, nsParams *elbv2api.NamespacedIngressClassParams) *elbv2api.IngressClassParams {
+	mergedParams := baseParams.DeepCopy()
+	mergedParams.Spec.TenantPolicy = nil
+	nsParamsSpec := nsParams.DeepCopy().Spec
+	if nsParamsSpec.Group != nil {
+		mergedParams.Spec.Group = nsParamsSpec.Group
+	}
+	if nsParamsSpec.Scheme != nil {
+		mergedParams.Spec.Scheme = nsParamsSpec.Scheme
+	}
+	if len(nsParamsSpec.InboundCIDRs) != 0 {
+		mergedParams.Spec.InboundCIDRs = nsParamsSpec.InboundCIDRs
+	}
+	if nsParamsSpec.SSLPolicy != "" {
+		mergedParams.Spec.SSLPolicy = nsParamsSpec.SSLPolicy
+	}
+	if nsParamsSpec.Subnets != nil {
+		mergedParams.Spec.Subnets = nsParamsSpec.Subnets
+	}
+	if nsParamsSpec.IPAddressType != nil {
+		mergedParams.Spec.IPAddressType = nsParamsSpec.IPAddressType
+	}
+	if len(nsParamsSpec.Tags) != 0 {
+		mergedParams.Spec.Tags = nsParamsSpec.Tags
+	}
+	if len(nsParamsSpec.LoadBalancerAttributes) != 0 {
+		mergedParams.Spec.LoadBalancerAttributes = nsParamsSpec.LoadBalancerAttributes
+	}
+	if len(nsParamsSpec.ListenPorts) != 0 {
+		mergedParams.Spec.ListenPorts = nsParamsSpec.ListenPorts
+	}
+	if nsParamsSpec.SSLRedirectPort != nil {
+		mergedParams.Spec.SSLRedirectPort = nsParamsSpec.SSLRedirectPort
+	}
+	if nsParamsSpec.Certificates != nil {
+		mergedParams.Spec.Certificates = nsParamsSpec.Certificates
+	}
+	if nsParamsSpec.DefaultAction != nil {
+		mergedParams.Spec.DefaultAction = nsParamsSpec.DefaultAction
+	}
+	if len(nsParamsSpec.MutualAuthentication) != 0 {
+		mergedParams.Spec.MutualAuthentication = nsParamsSpec.MutualAuthentication
+	}
+	if nsParamsSpec.TargetGroup != nil {
+		mergedParams.Spec.TargetGroup = nsParamsSpec.TargetGroup
+	}
+	return mergedParams
+}
+
+// tenantOverride is a field set in NamespacedIngressClassParams along with its values.
+type tenantOverride struct {
+	field  elbv2api.TenantOverrideField
+	values []string
+}
+
+// extractTenantOverrides returns the fields set in NamespacedIngressClassParams.
+// values are only extracted for fields that support allowedValues.
+func extractTenantOverrides(nsParamsSpec elbv2api.NamespacedIngressClassParamsSpec) []tenantOverride {
+	var overrides []tenantOverride
+	if nsParamsSpec.Group != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldGroup, values: []string{nsParamsSpec.Group.Name}})
+	}
+	if nsParamsSpec.Scheme != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldScheme, values: []string{string(*nsParamsSpec.Scheme)}})
+	}
+	if len(nsParamsSpec.InboundCIDRs) != 0 {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldInboundCIDRs, values: nsParamsSpec.InboundCIDRs})
+	}
+	if nsParamsSpec.SSLPolicy != "" {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldSSLPolicy, values: []string{nsParamsSpec.SSLPolicy}})
+	}
+	if nsParamsSpec.Subnets != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldSubnets})
+	}
+	if nsParamsSpec.IPAddressType != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldIPAddressType, values: []string{string(*nsParamsSpec.IPAddressType)}})
+	}
+	if len(nsParamsSpec.Tags) != 0 {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldTags})
+	}
+	if len(nsParamsSpec.LoadBalancerAttributes) != 0 {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldLoadBalancerAttributes})
+	}
+	if len(nsParamsSpec.ListenPorts) != 0 {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldListenPorts})
+	}
+	if nsParamsSpec.SSLRedirectPort != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldSSLRedirectPort, values: []string{strconv.Itoa(int(*nsParamsSpec.SSLRedirectPort))}})
+	}
+	if nsParamsSpec.Certificates != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldCertificates})
+	}
+	if nsParamsSpec.DefaultAction != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldDefaultAction})
+	}
+	if len(nsParamsSpec.MutualAuthentication) != 0 {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldMutualAuthentication})
+	}
+	if nsParamsSpec.TargetGroup != nil {
+		overrides = append(overrides, tenantOverride{field: elbv2api.TenantOverrideFieldTargetGroup})
+	}
+	return overrides
+}
@@ -0,0 +1,155 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_FindTenantPolicyViolations(t *testing.T) {
+	tenantPolicy := elbv2api.TenantPolicy{
+		IngressClassNames: []string{"tenant-class"},
+		AllowedOverrides: []elbv2api.TenantOverride{
+			{
+				Field:         elbv2api.TenantOverrideFieldInboundCIDRs,
+				AllowedValues: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			},
+			{
+				Field:         elbv2api.TenantOverrideFieldSSLRedirectPort,
+				AllowedValues: []string{"443"},
+			},
+			{
+				Field: elbv2api.TenantOverrideFieldTargetGroup,
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		nsParamsSpec elbv2api.NamespacedIngressClassParamsSpec
+		want         []string
+	}{
+		{
+			name:         "no overrides",
+			nsParamsSpec: elbv2api.NamespacedIngressClassParamsSpec{},
+			want:         nil,
+		},
+		{
+			name: "allowed overrides",
+			nsParamsSpec: elbv2api.NamespacedIngressClassParamsSpec{
+				InboundCIDRs:    []string{"10.0.0.0/8"},
+				SSLRedirectPort: aws.Int32(443),
+				TargetGroup: &elbv2api.TargetGroupParams{
+					HealthCheckPath: &elbv2api.TargetGroupStringParam{Value: "/healthz"},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "disallowed fields and values",
+			nsParamsSpec: elbv2api.NamespacedIngressClassParamsSpec{
+				Group:           &elbv2api.IngressGroup{Name: "shared"},
+				InboundCIDRs:    []string{"10.0.0.0/8", "0.0.0.0/0"},
+				SSLRedirectPort: aws.Int32(8443),
+			},
+			want: []string{
+				"field group is not allowed to be overridden",
+				"value 0.0.0.0/0 of field inboundCIDRs is not allowed, allowed values: [10.0.0.0/8 192.168.0.0/16]",
+				"value 8443 of field sslRedirectPort is not allowed, allowed values: [443]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindTenantPolicyViolations(tenantPolicy, tt.nsParamsSpec)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_FindTenantPolicyIngressClassParams(t *testing.T) {
+	tests := []struct {
+		name               string
+		ingClassParamsList []*elbv2api.IngressClassParams
+		ingClassName       string
+		want               string
+		wantErr            error
+	}{
+		{
+			name: "no IngressClassParams with tenantPolicy",
+			ingClassParamsList: []*elbv2api.IngressClassParams{
+				{ObjectMeta: metav1.ObjectMeta{Name: "params-1"}},
+			},
+			ingClassName: "tenant-class",
+		},
+		{
+			name: "single IngressClassParams with matching tenantPolicy",
+			ingClassParamsList: []*elbv2api.IngressClassParams{
+				{ObjectMeta: metav1.ObjectMeta{Name: "params-1"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "params-2"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						TenantPolicy: &elbv2api.TenantPolicy{IngressClassNames: []string{"other-class"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "params-3"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						TenantPolicy: &elbv2api.TenantPolicy{IngressClassNames: []string{"other-class", "tenant-class"}},
+					},
+				},
+			},
+			ingClassName: "tenant-class",
+			want:         "params-3",
+		},
+		{
+			name: "multiple IngressClassParams with matching tenantPolicy",
+			ingClassParamsList: []*elbv2api.IngressClassParams{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "params-1"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						TenantPolicy: &elbv2api.TenantPolicy{IngressClassNames: []string{"tenant-class"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "params-2"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						TenantPolicy: &elbv2api.TenantPolicy{IngressClassNames: []string{"tenant-class"}},
+					},
+				},
+			},
+			ingClassName: "tenant-class",
+			wantErr:      errors.New("multiple IngressClassParams define tenantPolicy for IngressClass tenant-class: [params-1 params-2]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ingClassParams := range tt.ingClassParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, ingClassParams.DeepCopy()))
+			}
+			got, err := FindTenantPolicyIngressClassParams(ctx, k8sClient, tt.ingClassName)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, tt.want, got.Name)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,12 +15,18 @@ import (
 const (
 	// IndexKeyServiceRefName is index key for services referenced by Ingress.
 	IndexKeyServiceRefName = "ingress.serviceRef.name"
-	// IndexKeySecretRefName is index key for secrets referenced by Ingress or Service.
+	// IndexKeySecretRefName is index key for secrets referenced by Ingress, Service or ListenerAction.
 	IndexKeySecretRefName = "ingress.secretRef.name"
 	// IndexKeyIngressClassRefName is index key for ingressClass referenced by Ingress.
 	IndexKeyIngressClassRefName = "ingress.ingressClassRef.name"
 	// IndexKeyIngressClassParamsRefName is index key for ingressClassParams referenced by IngressClass.
 	IndexKeyIngressClassParamsRefName = "ingressClass.ingressClassParamsRef.name"
+	// IndexKeyListenerActionRefName is index key for listenerActions referenced by Ingress.
+	IndexKeyListenerActionRefName = "ingress.listenerActionRef.name"
+	// IndexKeyCrossNamespaceServiceRefKey is index key for services in another namespace referenced by Ingress or ListenerAction.
+	IndexKeyCrossNamespaceServiceRefKey = "ingress.crossNamespaceServiceRef.key"
+	// IndexKeyCrossNamespaceServiceRefNamespace is index key for namespace of services in another namespace referenced by Ingress or ListenerAction.
+	IndexKeyCrossNamespaceServiceRefNamespace = "ingress.crossNamespaceServiceRef.namespace"
 )
 
 // ReferenceIndexer has the ability to index Ingresses with referenced objects.
@@ -32,6 +39,16 @@ type ReferenceIndexer interface {
 	BuildIngressClassRefIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildIngressClassParamsRefIndexes returns the name of related IngressClassParams objects.
 	BuildIngressClassParamsRefIndexes(ctx context.Context, ingClass *networking.IngressClass) []string
+	// BuildListenerActionRefIndexes returns the name of related ListenerAction objects.
+	BuildListenerActionRefIndexes(ctx context.Context, ing *networking.Ingress) []string
+	// BuildListenerActionServiceRefIndexes returns the name of Service objects referenced by ListenerAction.
+	BuildListenerActionServiceRefIndexes(ctx context.Context, listenerAction *elbv2api.ListenerAction) []string
+	// BuildListenerActionSecretRefIndexes returns the name of Secret objects referenced by ListenerAction.
+	BuildListenerActionSecretRefIndexes(ctx context.Context, listenerAction *elbv2api.ListenerAction) []string
+	// BuildCrossNamespaceServiceRefKeyIndexes returns the key(namespace/name) of Service objects in another namespace referenced by Ingress or ListenerAction.
+	BuildCrossNamespaceServiceRefKeyIndexes(ctx context.Context, ingOrListenerAction client.Object) []string
+	// BuildCrossNamespaceServiceRefNamespaceIndexes returns the namespace of Service objects in another namespace referenced by Ingress or ListenerAction.
+	BuildCrossNamespaceServiceRefNamespaceIndexes(ctx context.Context, ingOrListenerAction client.Object) []string
 }
 
 // NewDefaultReferenceIndexer constructs new defaultReferenceIndexer.
@@ -53,34 +70,13 @@ type defaultReferenceIndexer struct {
 }
 
 func (i *defaultReferenceIndexer) BuildServiceRefIndexes(ctx context.Context, ing *networking.Ingress) []string {
-	var backends []networking.IngressBackend
-	if ing.Spec.DefaultBackend != nil {
-		backends = append(backends, *ing.Spec.DefaultBackend)
-	}
-	for _, rule := range ing.Spec.Rules {
-		if rule.HTTP == nil {
-			continue
-		}
-		for _, path := range rule.HTTP.Paths {
-			backends = append(backends, path.Backend)
-		}
-	}
-
-	serviceNames := sets.NewString()
-	for _, backend := range backends {
-		enhancedBackend, err := i.enhancedBackendBuilder.Build(ctx, ing, backend,
-			WithLoadBackendServices(false, nil),
-			WithLoadAuthConfig(false),
-		)
-		if err != nil {
-			i.logger.Error(err, "failed to build Ingress indexes",
-				"indexKey", IndexKeyServiceRefName)
-			return nil
-		}
-		serviceNamesFromBackend := extractServiceNamesFromAction(enhancedBackend.Action)
-		serviceNames.Insert(serviceNamesFromBackend...)
+	svcKeys, err := i.extractServiceRefs(ctx, ing)
+	if err != nil {
+		i.logger.Error(err, "failed to build Ingress indexes",
+			"indexKey", IndexKeyServiceRefName)
+		return nil
 	}
-	return serviceNames.List()
+	return extractServiceNamesInNamespace(svcKeys, ing.Namespace)
 }
 
 func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ingOrSvc client.Object) []string {
@@ -115,23 +111,128 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
-func extractServiceNamesFromAction(action Action) []string {
+func (i *defaultReferenceIndexer) BuildListenerActionRefIndexes(_ context.Context, ing *networking.Ingress) []string {
+	listenerActionNames := sets.NewString()
+	for _, backend := range ExtractIngressBackends(ing) {
+		if isListenerActionBackend(backend) {
+			listenerActionNames.Insert(backend.Resource.Name)
+		}
+	}
+	return listenerActionNames.List()
+}
+
+func (i *defaultReferenceIndexer) BuildListenerActionServiceRefIndexes(ctx context.Context, listenerAction *elbv2api.ListenerAction) []string {
+	svcKeys, _ := i.extractServiceRefs(ctx, listenerAction)
+	return extractServiceNamesInNamespace(svcKeys, listenerAction.Namespace)
+}
+
+func (i *defaultReferenceIndexer) BuildListenerActionSecretRefIndexes(_ context.Context, listenerAction *elbv2api.ListenerAction) []string {
+	authCfg := listenerAction.Spec.AuthenticateConfig
+	if authCfg == nil || authCfg.OIDC == nil {
+		return nil
+	}
+	return []string{authCfg.OIDC.SecretName}
+}
+
+func (i *defaultReferenceIndexer) BuildCrossNamespaceServiceRefKeyIndexes(ctx context.Context, ingOrListenerAction client.Object) []string {
+	svcKeys, err := i.extractServiceRefs(ctx, ingOrListenerAction)
+	if err != nil {
+		i.logger.Error(err, "failed to build indexes",
+			"indexKey", IndexKeyCrossNamespaceServiceRefKey)
+		return nil
+	}
+	svcKeyStrs := sets.NewString()
+	for _, svcKey := range svcKeys {
+		if svcKey.Namespace != ingOrListenerAction.GetNamespace() {
+			svcKeyStrs.Insert(svcKey.String())
+		}
+	}
+	return svcKeyStrs.List()
+}
+
+func (i *defaultReferenceIndexer) BuildCrossNamespaceServiceRefNamespaceIndexes(ctx context.Context, ingOrListenerAction client.Object) []string {
+	svcKeys, err := i.extractServiceRefs(ctx, ingOrListenerAction)
+	if err != nil {
+		i.logger.Error(err, "failed to build indexes",
+			"indexKey", IndexKeyCrossNamespaceServiceRefNamespace)
+		return nil
+	}
+	svcNamespaces := sets.NewString()
+	for _, svcKey := range svcKeys {
+		if svcKey.Namespace != ingOrListenerAction.GetNamespace() {
+			svcNamespaces.Insert(svcKey.Namespace)
+		}
+	}
+	return svcNamespaces.List()
+}
+
+// extractServiceRefs returns the key of Service objects referenced by Ingress or ListenerAction.
+// services referenced by ListenerAction backends of Ingress are indexed on the ListenerAction itself.
+func (i *defaultReferenceIndexer) extractServiceRefs(ctx context.Context, ingOrListenerAction client.Object) ([]types.NamespacedName, error) {
+	switch obj := ingOrListenerAction.(type) {
+	case *networking.Ingress:
+		var svcKeys []types.NamespacedName
+		for _, backend := range ExtractIngressBackends(obj) {
+			if isListenerActionBackend(backend) {
+				continue
+			}
+			enhancedBackend, err := i.enhancedBackendBuilder.Build(ctx, obj, backend,
+				WithLoadBackendServices(false, nil),
+				WithLoadAuthConfig(false),
+			)
+			if err != nil {
+				return nil, err
+			}
+			svcKeys = append(svcKeys, extractServiceRefsFromAction(enhancedBackend.Action, obj.Namespace)...)
+		}
+		return svcKeys, nil
+	case *elbv2api.ListenerAction:
+		action := convertListenerActionSpecToAction(obj.Spec)
+		return extractServiceRefsFromAction(action, obj.Namespace), nil
+	default:
+		return nil, nil
+	}
+}
+
+// ExtractIngressBackends returns the default backend and the backends of all rules of Ingress.
+func ExtractIngressBackends(ing *networking.Ingress) []networking.IngressBackend {
+	var backends []networking.IngressBackend
+	if ing.Spec.DefaultBackend != nil {
+		backends = append(backends, *ing.Spec.DefaultBackend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backends = append(backends, path.Backend)
+		}
+	}
+	return backends
+}
+
+func extractServiceRefsFromAction(action Action, namespace string) []types.NamespacedName {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
 	}
-	serviceNames := sets.NewString()
+	var svcKeys []types.NamespacedName
 	for _, tgt := range action.ForwardConfig.TargetGroups {
-		serviceNamesFromTGT := extractServiceNamesFromTargetGroupTuple(tgt)
-		serviceNames.Insert(serviceNamesFromTGT...)
+		if tgt.ServiceName == nil {
+			continue
+		}
+		svcKeys = append(svcKeys, tgt.serviceKey(namespace))
 	}
-	return serviceNames.List()
+	return svcKeys
 }
 
-func extractServiceNamesFromTargetGroupTuple(tgt TargetGroupTuple) []string {
-	if tgt.ServiceName == nil {
-		return nil
+func extractServiceNamesInNamespace(svcKeys []types.NamespacedName, namespace string) []string {
+	serviceNames := sets.NewString()
+	for _, svcKey := range svcKeys {
+		if svcKey.Namespace == namespace {
+			serviceNames.Insert(svcKey.Name)
+		}
 	}
-	return []string{*tgt.ServiceName}
+	return serviceNames.List()
 }
 
 func extractSecretNamesFromAuthConfig(authCfg AuthConfig) []string {
@@ -7,8 +7,11 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -314,7 +317,7 @@ func Test_defaultReferenceIndexer_BuildServiceRefIndexes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, nil, true, true)
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
@@ -365,7 +368,7 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, nil, true, true)
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
@@ -516,3 +519,320 @@ func Test_defaultReferenceIndexer_BuildIngressClassParamsRefIndexes(t *testing.T
 		})
 	}
 }
+
+func Test_defaultReferenceIndexer_BuildListenerActionRefIndexes(t *testing.T) {
+	type args struct {
+		ing *networking.Ingress
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Ingress refers no ListenerAction",
+			args: args{
+				ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{
+								Name: "svc-1",
+								Port: networking.ServiceBackendPort{Name: "http"},
+							},
+						},
+					},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "Ingress refers ListenerActions in default backend and rules",
+			args: args{
+				ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Resource: &corev1.TypedLocalObjectReference{
+								APIGroup: awssdk.String("elbv2.k8s.aws"),
+								Kind:     "ListenerAction",
+								Name:     "action-1",
+							},
+						},
+						Rules: []networking.IngressRule{
+							{
+								IngressRuleValue: networking.IngressRuleValue{
+									HTTP: &networking.HTTPIngressRuleValue{
+										Paths: []networking.HTTPIngressPath{
+											{
+												Path: "/path1",
+												Backend: networking.IngressBackend{
+													Resource: &corev1.TypedLocalObjectReference{
+														APIGroup: awssdk.String("elbv2.k8s.aws"),
+														Kind:     "ListenerAction",
+														Name:     "action-2",
+													},
+												},
+											},
+											{
+												Path: "/path2",
+												Backend: networking.IngressBackend{
+													Resource: &corev1.TypedLocalObjectReference{
+														APIGroup: awssdk.String("elbv2.k8s.aws"),
+														Kind:     "ListenerAction",
+														Name:     "action-1",
+													},
+												},
+											},
+											{
+												Path: "/path3",
+												Backend: networking.IngressBackend{
+													Resource: &corev1.TypedLocalObjectReference{
+														APIGroup: awssdk.String("example.com"),
+														Kind:     "ListenerAction",
+														Name:     "action-3",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"action-1", "action-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{}
+			got := i.BuildListenerActionRefIndexes(context.Background(), tt.args.ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildListenerActionServiceRefIndexes(t *testing.T) {
+	portHTTP := intstr.FromString("http")
+	type args struct {
+		listenerAction *elbv2api.ListenerAction
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "fixed-response ListenerAction",
+			args: args{
+				listenerAction: &elbv2api.ListenerAction{
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeFixedResponse,
+						FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+							StatusCode: "404",
+						},
+					},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "forward ListenerAction",
+			args: args{
+				listenerAction: &elbv2api.ListenerAction{
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeForward,
+						ForwardConfig: &elbv2api.ForwardActionConfig{
+							TargetGroups: []elbv2api.TargetGroupTuple{
+								{
+									ServiceName: awssdk.String("svc-2"),
+									ServicePort: &portHTTP,
+								},
+								{
+									ServiceName: awssdk.String("svc-1"),
+									ServicePort: &portHTTP,
+								},
+								{
+									TargetGroupARN: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"svc-1", "svc-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{}
+			got := i.BuildListenerActionServiceRefIndexes(context.Background(), tt.args.listenerAction)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildListenerActionSecretRefIndexes(t *testing.T) {
+	type args struct {
+		listenerAction *elbv2api.ListenerAction
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "ListenerAction without authentication",
+			args: args{
+				listenerAction: &elbv2api.ListenerAction{
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeFixedResponse,
+						FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+							StatusCode: "404",
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "ListenerAction with cognito authentication",
+			args: args{
+				listenerAction: &elbv2api.ListenerAction{
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeFixedResponse,
+						FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+							StatusCode: "200",
+						},
+						AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+							Type: elbv2api.AuthenticateTypeCognito,
+							Cognito: &elbv2api.AuthenticateCognitoConfig{
+								UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_xxx",
+								UserPoolClientID: "client-id",
+								UserPoolDomain:   "my-domain",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "ListenerAction with oidc authentication",
+			args: args{
+				listenerAction: &elbv2api.ListenerAction{
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeFixedResponse,
+						FixedResponseConfig: &elbv2api.FixedResponseActionConfig{
+							StatusCode: "200",
+						},
+						AuthenticateConfig: &elbv2api.AuthenticateActionConfig{
+							Type: elbv2api.AuthenticateTypeOIDC,
+							OIDC: &elbv2api.AuthenticateOIDCConfig{
+								Issuer:                "https://example.com",
+								AuthorizationEndpoint: "https://authorization.example.com",
+								TokenEndpoint:         "https://token.example.com",
+								UserInfoEndpoint:      "https://userinfo.example.com",
+								SecretName:            "my-k8s-secret",
+							},
+						},
+					},
+				},
+			},
+			want: []string{"my-k8s-secret"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{}
+			got := i.BuildListenerActionSecretRefIndexes(context.Background(), tt.args.listenerAction)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildCrossNamespaceServiceRefIndexes(t *testing.T) {
+	portHTTP := intstr.FromString("http")
+	type args struct {
+		obj client.Object
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantKeys      []string
+		wantNamespace []string
+	}{
+		{
+			name: "Ingress refers services in another namespace via actions annotation",
+			args: args{
+				obj: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/actions.weighted": `{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"svc-1","servicePort":"http","weight":40},{"serviceName":"auth-proxy","serviceNamespace":"shared-ns","servicePort":"http","weight":30},{"serviceName":"svc-2","serviceNamespace":"awesome-ns","servicePort":"http","weight":30}]}}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{
+								Name: "weighted",
+								Port: networking.ServiceBackendPort{Name: "use-annotation"},
+							},
+						},
+					},
+				},
+			},
+			wantKeys:      []string{"shared-ns/auth-proxy"},
+			wantNamespace: []string{"shared-ns"},
+		},
+		{
+			name: "ListenerAction refers services in another namespace",
+			args: args{
+				obj: &elbv2api.ListenerAction{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "action-1",
+					},
+					Spec: elbv2api.ListenerActionSpec{
+						Type: elbv2api.ListenerActionTypeForward,
+						ForwardConfig: &elbv2api.ForwardActionConfig{
+							TargetGroups: []elbv2api.TargetGroupTuple{
+								{
+									ServiceName:      awssdk.String("auth-proxy"),
+									ServiceNamespace: awssdk.String("shared-ns"),
+									ServicePort:      &portHTTP,
+								},
+								{
+									ServiceName:      awssdk.String("svc-1"),
+									ServiceNamespace: awssdk.String("other-ns"),
+									ServicePort:      &portHTTP,
+								},
+								{
+									ServiceName: awssdk.String("svc-2"),
+									ServicePort: &portHTTP,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantKeys:      []string{"other-ns/svc-1", "shared-ns/auth-proxy"},
+			wantNamespace: []string{"other-ns", "shared-ns"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, nil, true, true)
+			i := &defaultReferenceIndexer{
+				enhancedBackendBuilder: enhancedBackendBuilder,
+				logger:                 log.Log,
+			}
+			gotKeys := i.BuildCrossNamespaceServiceRefKeyIndexes(context.Background(), tt.args.obj)
+			assert.Equal(t, tt.wantKeys, gotKeys)
+			gotNamespaces := i.BuildCrossNamespaceServiceRefNamespaceIndexes(context.Background(), tt.args.obj)
+			assert.Equal(t, tt.wantNamespace, gotNamespaces)
+		})
+	}
+}
@@ -7,8 +7,12 @@ const (
 	IngressEventReasonFailedAddFinalizer      = "FailedAddFinalizer"
 	IngressEventReasonFailedRemoveFinalizer   = "FailedRemoveFinalizer"
 	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
+	IngressEventReasonFailedAssumeRole        = "FailedAssumeRole"
 	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
 	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
+	IngressEventReasonPolicyViolation         = "LoadBalancerPolicyViolation"
+	IngressEventReasonQuarantined             = "Quarantined"
+	IngressEventReasonDriftDetected           = "DriftDetected"
 	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
 
 	// Service events
@@ -16,8 +20,11 @@ const (
 	ServiceEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
 	ServiceEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	ServiceEventReasonFailedCleanupStatus    = "FailedCleanupStatus"
+	ServiceEventReasonFailedAssumeRole       = "FailedAssumeRole"
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
+	ServiceEventReasonPolicyViolation        = "LoadBalancerPolicyViolation"
+	ServiceEventReasonDriftDetected          = "DriftDetected"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 
 	// TargetGroupBinding events
@@ -26,6 +33,7 @@ const (
 	TargetGroupBindingEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	TargetGroupBindingEventReasonFailedCleanup          = "FailedCleanup"
 	TargetGroupBindingEventReasonFailedNetworkReconcile = "FailedNetworkReconcile"
+	TargetGroupBindingEventReasonFailedAssumeRole       = "FailedAssumeRole"
 	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
 	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 )
@@ -0,0 +1,115 @@
+package ec2
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &VPCEndpointService{}
+
+// VPCEndpointService represents a EC2 VPC endpoint service configuration.
+type VPCEndpointService struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of VPCEndpointService
+	Spec VPCEndpointServiceSpec `json:"spec"`
+
+	// observed state of VPCEndpointService
+	Status *VPCEndpointServiceStatus `json:"status,omitempty"`
+}
+
+// NewVPCEndpointService constructs new VPCEndpointService resource.
+func NewVPCEndpointService(stack core.Stack, id string, spec VPCEndpointServiceSpec) *VPCEndpointService {
+	vpces := &VPCEndpointService{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(vpces)
+	vpces.registerDependencies(stack)
+	return vpces
+}
+
+// SetStatus sets the VPCEndpointService's status
+func (vpces *VPCEndpointService) SetStatus(status VPCEndpointServiceStatus) {
+	vpces.Status = &status
+}
+
+// ServiceName returns a token for this VPCEndpointService's serviceName.
+func (vpces *VPCEndpointService) ServiceName() core.StringToken {
+	return core.NewResourceFieldStringToken(vpces, "status/serviceName",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			vpces := res.(*VPCEndpointService)
+			if vpces.Status == nil {
+				return "", errors.Errorf("VPCEndpointService is not fulfilled yet: %v", vpces.ID())
+			}
+			return vpces.Status.ServiceName, nil
+		},
+	)
+}
+
+// register dependencies for VPCEndpointService.
+func (vpces *VPCEndpointService) registerDependencies(stack core.Stack) {
+	for _, nlbARN := range vpces.Spec.NetworkLoadBalancerARNs {
+		for _, dep := range nlbARN.Dependencies() {
+			stack.AddDependency(dep, vpces)
+		}
+	}
+}
+
+// VPCEndpointServiceSpec defines the desired state of VPCEndpointService
+type VPCEndpointServiceSpec struct {
+	// The Amazon Resource Names (ARNs) of the Network Load Balancers.
+	NetworkLoadBalancerARNs []core.StringToken `json:"networkLoadBalancerARNs"`
+
+	// Indicates whether requests from service consumers to create an endpoint to the service must be accepted.
+	AcceptanceRequired bool `json:"acceptanceRequired"`
+
+	// The private DNS name to assign to the VPC endpoint service.
+	// +optional
+	PrivateDNSName *string `json:"privateDNSName,omitempty"`
+
+	// The supported IP address types.
+	// +optional
+	SupportedIPAddressTypes []string `json:"supportedIPAddressTypes,omitempty"`
+
+	// The Amazon Resource Names (ARN) of the principals allowed to discover and connect to the endpoint service.
+	// +optional
+	AllowedPrincipals []string `json:"allowedPrincipals,omitempty"`
+
+	// Indicates whether the endpoint service can be recreated when all of its Network Load Balancers are replaced.
+	// Recreation rejects the existing endpoint connections and changes the service name.
+	AllowRecreation bool `json:"allowRecreation"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// VPCEndpointServiceStatus defines the observed state of VPCEndpointService
+type VPCEndpointServiceStatus struct {
+	// The ID of the endpoint service.
+	ServiceID string `json:"serviceID"`
+
+	// The name of the endpoint service.
+	ServiceName string `json:"serviceName"`
+
+	// The DNS record used to verify the ownership of private DNS name.
+	// +optional
+	PrivateDNSNameVerification *PrivateDNSNameVerification `json:"privateDNSNameVerification,omitempty"`
+}
+
+// PrivateDNSNameVerification describes the DNS record used to verify the ownership of private DNS name.
+type PrivateDNSNameVerification struct {
+	// The name of the record subdomain.
+	Name string `json:"name"`
+
+	// The type of the DNS record.
+	Type string `json:"type"`
+
+	// The value of the DNS record.
+	Value string `json:"value"`
+
+	// The verification state.
+	State string `json:"state"`
+}
@@ -101,11 +101,19 @@ const (
 	LoadBalancerSchemeInternetFacing LoadBalancerScheme = "internet-facing"
 )
 
+type LoadBalancerDeletionPolicy string
+
+const (
+	LoadBalancerDeletionPolicyDelete LoadBalancerDeletionPolicy = "Delete"
+	LoadBalancerDeletionPolicyRetain LoadBalancerDeletionPolicy = "Retain"
+	LoadBalancerDeletionPolicyOrphan LoadBalancerDeletionPolicy = "Orphan"
+)
+
 // Information about a subnet mapping.
 type SubnetMapping struct {
 	// [Network Load Balancers] The allocation ID of the Elastic IP address for
 	// an internet-facing load balancer.
-	AllocationID *string `json:"allocationID,omitempty"`
+	AllocationID core.StringToken `json:"allocationID,omitempty"`
 
 	// [Network Load Balancers] The private IPv4 address for an internal load balancer.
 	PrivateIPv4Address *string `json:"privateIPv4Address,omitempty"`
@@ -166,6 +174,28 @@ type LoadBalancerSpec struct {
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// The existing load balancer that is adopted instead of creating a new one.
+	// +optional
+	Adoption *LoadBalancerAdoption `json:"adoption,omitempty"`
+
+	// The policy for the load balancer and its related resources once the load balancer is no longer desired.
+	// +optional
+	DeletionPolicy LoadBalancerDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// LoadBalancerAdoption defines an existing load balancer adopted by the controller.
+type LoadBalancerAdoption struct {
+	// The Amazon Resource Name (ARN) of the existing load balancer.
+	LoadBalancerARN string `json:"loadBalancerARN"`
+
+	// Whether listeners that already exist on the load balancer are left untouched unless claimed.
+	// +optional
+	UnmanagedListeners bool `json:"unmanagedListeners,omitempty"`
+
+	// The ports of existing listeners that are claimed to be managed by the controller.
+	// +optional
+	ClaimedListenerPorts []int64 `json:"claimedListenerPorts,omitempty"`
 }
 
 // LoadBalancerStatus defines the observed state of LoadBalancer
@@ -92,6 +92,10 @@ type TargetGroupBindingSpec struct {
 	// serviceRef is a reference to a Kubernetes Service and ServicePort.
 	ServiceRef elbv2api.ServiceReference `json:"serviceRef"`
 
+	// albTargetRef is a reference to an Ingress or IngressGroup whose ALB will be registered as target.
+	// +optional
+	ALBTargetRef *elbv2api.ALBTargetReference `json:"albTargetRef,omitempty"`
+
 	// networking provides the networking setup for ELBV2 LoadBalancer to access targets in TargetGroup.
 	// +optional
 	Networking *TargetGroupBindingNetworking `json:"networking,omitempty"`
@@ -344,6 +344,11 @@ func (p *defaultBackendSGProvider) getBackendSGName() string {
 	return fmt.Sprintf("k8s-traffic-%.232s-%.10s", sanitizedClusterName, sgHash)
 }
 
+// IsAutoGeneratedBackendSG checks whether the securityGroup is the backend SG auto-generated by the controller.
+func IsAutoGeneratedBackendSG(sgInfo SecurityGroupInfo) bool {
+	return sgInfo.Tags[tagKeyResource] == tagValueBackend
+}
+
 func isSecurityGroupDependencyViolationError(err error) bool {
 	var awsErr awserr.Error
 	if errors.As(err, &awsErr) {
@@ -0,0 +1,38 @@
+package networking
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// placeholder for the backend SecurityGroup ID when it's not allocated yet.
+	dryRunBackendSGIDPlaceholder = "sg-dryrun-backend"
+)
+
+// NewDryRunBackendSGProvider constructs new dryRunBackendSGProvider.
+// It never creates or deletes SecurityGroups, so that models can be built without side effects, e.g. at admission time.
+func NewDryRunBackendSGProvider(backendSG string) *dryRunBackendSGProvider {
+	return &dryRunBackendSGProvider{
+		backendSG: backendSG,
+	}
+}
+
+var _ BackendSGProvider = &dryRunBackendSGProvider{}
+
+// dryRunBackendSGProvider returns the configured backend SecurityGroup, or a placeholder if not configured.
+type dryRunBackendSGProvider struct {
+	backendSG string
+}
+
+func (p *dryRunBackendSGProvider) Get(_ context.Context, _ ResourceType, _ []types.NamespacedName) (string, error) {
+	if p.backendSG != "" {
+		return p.backendSG, nil
+	}
+	return dryRunBackendSGIDPlaceholder, nil
+}
+
+func (p *dryRunBackendSGProvider) Release(_ context.Context, _ ResourceType, _ []types.NamespacedName) error {
+	return nil
+}
@@ -0,0 +1,342 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	lbAttrsAccessLogsS3Enabled = "access_logs.s3.enabled"
+)
+
+// sslPolicyTLSVersionPattern extracts the minimum TLS minor version from names of ELB SSL policies,
+// e.g. ELBSecurityPolicy-TLS13-1-2-2021-06, ELBSecurityPolicy-TLS-1-2-Ext-2018-06, ELBSecurityPolicy-FS-1-2-Res-2020-10.
+var sslPolicyTLSVersionPattern = regexp.MustCompile(`-(?:TLS13|TLS|FS)-1-([0-3])(?:-|$)`)
+
+// tlsMinorVersions maps TLS versions to their minor versions.
+var tlsMinorVersions = map[elbv2api.TLSVersion]int{
+	elbv2api.TLSVersion12: 2,
+	elbv2api.TLSVersion13: 3,
+}
+
+// LoadBalancerPolicyEvaluator evaluates load balancers against LoadBalancerPolicies.
+type LoadBalancerPolicyEvaluator interface {
+	// ListPolicies returns the LoadBalancerPolicies that apply to load balancers serving resources in any of the namespaces.
+	ListPolicies(ctx context.Context, namespaces []string) ([]*elbv2api.LoadBalancerPolicy, error)
+
+	// Evaluate returns the violations of LoadBalancerPolicies by the load balancer within stack.
+	// namespaces are the namespaces of resources served by the load balancer.
+	Evaluate(ctx context.Context, policies []*elbv2api.LoadBalancerPolicy, namespaces []string, stack core.Stack) (field.ErrorList, error)
+}
+
+// NewDefaultLoadBalancerPolicyEvaluator constructs new defaultLoadBalancerPolicyEvaluator.
+func NewDefaultLoadBalancerPolicyEvaluator(k8sClient client.Client, logger logr.Logger) *defaultLoadBalancerPolicyEvaluator {
+	return &defaultLoadBalancerPolicyEvaluator{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ LoadBalancerPolicyEvaluator = &defaultLoadBalancerPolicyEvaluator{}
+
+// default implementation for LoadBalancerPolicyEvaluator
+type defaultLoadBalancerPolicyEvaluator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (e *defaultLoadBalancerPolicyEvaluator) ListPolicies(ctx context.Context, namespaces []string) ([]*elbv2api.LoadBalancerPolicy, error) {
+	policyList := &elbv2api.LoadBalancerPolicyList{}
+	if err := e.k8sClient.List(ctx, policyList); err != nil {
+		return nil, errors.Wrap(err, "failed to list LoadBalancerPolicies")
+	}
+	if len(policyList.Items) == 0 {
+		return nil, nil
+	}
+	nsLabelsByName, err := e.loadNamespaceLabels(ctx, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	var policies []*elbv2api.LoadBalancerPolicy
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		if policy.Spec.NamespaceSelector == nil {
+			policies = append(policies, policy)
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid namespaceSelector in LoadBalancerPolicy %v", policy.Name)
+		}
+		for _, ns := range namespaces {
+			if selector.Matches(nsLabelsByName[ns]) {
+				policies = append(policies, policy)
+				break
+			}
+		}
+	}
+	return policies, nil
+}
+
+func (e *defaultLoadBalancerPolicyEvaluator) Evaluate(ctx context.Context, policies []*elbv2api.LoadBalancerPolicy, namespaces []string, stack core.Stack) (field.ErrorList, error) {
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	var lbs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&lbs); err != nil {
+		return nil, err
+	}
+	if len(lbs) == 0 {
+		return nil, nil
+	}
+	nsLabelsByName, err := e.loadNamespaceLabels(ctx, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations field.ErrorList
+	lbPath := field.NewPath("loadBalancer")
+	for _, policy := range policies {
+		rules := policy.Spec.Rules
+		if rules.InternetFacing != nil {
+			errs, err := evaluateInternetFacingRule(policy.Name, *rules.InternetFacing, lbs[0], namespaces, nsLabelsByName, lbPath)
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, errs...)
+		}
+		if rules.MinimumTLSVersion != nil {
+			errs, err := evaluateMinimumTLSVersionRule(policy.Name, *rules.MinimumTLSVersion, stack, lbPath)
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, errs...)
+		}
+		if awssdk.BoolValue(rules.RequireAccessLogs) {
+			violations = append(violations, evaluateRequireAccessLogsRule(policy.Name, lbs[0], lbPath)...)
+		}
+		if rules.DeniedInboundCIDRs != nil {
+			errs, err := evaluateDeniedInboundCIDRsRule(policy.Name, *rules.DeniedInboundCIDRs, stack, namespaces, nsLabelsByName, lbPath)
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, errs...)
+		}
+	}
+	return violations, nil
+}
+
+// loadNamespaceLabels loads the labels of namespaces.
+func (e *defaultLoadBalancerPolicyEvaluator) loadNamespaceLabels(ctx context.Context, namespaces []string) (map[string]labels.Set, error) {
+	nsLabelsByName := make(map[string]labels.Set, len(namespaces))
+	for _, ns := range namespaces {
+		nsObj := &corev1.Namespace{}
+		if err := e.k8sClient.Get(ctx, types.NamespacedName{Name: ns}, nsObj); err != nil {
+			return nil, errors.Wrapf(err, "failed to get namespace %v", ns)
+		}
+		nsLabelsByName[ns] = nsObj.Labels
+	}
+	return nsLabelsByName, nil
+}
+
+// CheckLoadBalancerPolicies checks the load balancer within stack against LoadBalancerPolicies that apply to namespaces.
+// returns an error describing the violations if there is any.
+func CheckLoadBalancerPolicies(ctx context.Context, evaluator LoadBalancerPolicyEvaluator, namespaces []string, stack core.Stack) error {
+	policies, err := evaluator.ListPolicies(ctx, namespaces)
+	if err != nil {
+		return err
+	}
+	violations, err := evaluator.Evaluate(ctx, policies, namespaces, stack)
+	if err != nil {
+		return err
+	}
+	if len(violations) != 0 {
+		return NewViolationError(violations)
+	}
+	return nil
+}
+
+// NewViolationError constructs the error for violations of LoadBalancerPolicies.
+func NewViolationError(violations field.ErrorList) error {
+	return errors.Errorf("load balancer violates LoadBalancerPolicy: %v", violations.ToAggregate().Error())
+}
+
+func evaluateInternetFacingRule(policyName string, rule elbv2api.InternetFacingRule, lb *elbv2model.LoadBalancer,
+	namespaces []string, nsLabelsByName map[string]labels.Set, lbPath *field.Path) (field.ErrorList, error) {
+	if lb.Spec.Scheme == nil || *lb.Spec.Scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+		return nil, nil
+	}
+	unselectedNamespaces, err := findUnselectedNamespaces(rule.NamespaceSelector, namespaces, nsLabelsByName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid internetFacing.namespaceSelector in LoadBalancerPolicy %v", policyName)
+	}
+	if len(unselectedNamespaces) == 0 {
+		return nil, nil
+	}
+	return field.ErrorList{
+		field.Forbidden(lbPath.Child("scheme"), fmt.Sprintf("internet-facing load balancer is not allowed in namespace %v by LoadBalancerPolicy %v",
+			strings.Join(unselectedNamespaces, ", "), policyName)),
+	}, nil
+}
+
+func evaluateMinimumTLSVersionRule(policyName string, minTLSVersion elbv2api.TLSVersion, stack core.Stack, lbPath *field.Path) (field.ErrorList, error) {
+	var listeners []*elbv2model.Listener
+	if err := stack.ListResources(&listeners); err != nil {
+		return nil, err
+	}
+	var violations field.ErrorList
+	for _, ls := range listeners {
+		if ls.Spec.Protocol != elbv2model.ProtocolHTTPS && ls.Spec.Protocol != elbv2model.ProtocolTLS {
+			continue
+		}
+		sslPolicy := awssdk.StringValue(ls.Spec.SSLPolicy)
+		if minorVersion, ok := sslPolicyMinimumTLSMinorVersion(sslPolicy); ok && minorVersion >= tlsMinorVersions[minTLSVersion] {
+			continue
+		}
+		violations = append(violations, field.Invalid(lbPath.Child("listeners").Key(strconv.FormatInt(ls.Spec.Port, 10)).Child("sslPolicy"), sslPolicy,
+			fmt.Sprintf("SSL policy must enforce %v or later by LoadBalancerPolicy %v", minTLSVersion, policyName)))
+	}
+	return violations, nil
+}
+
+func evaluateRequireAccessLogsRule(policyName string, lb *elbv2model.LoadBalancer, lbPath *field.Path) field.ErrorList {
+	for _, attr := range lb.Spec.LoadBalancerAttributes {
+		if attr.Key == lbAttrsAccessLogsS3Enabled && attr.Value == "true" {
+			return nil
+		}
+	}
+	return field.ErrorList{
+		field.Required(lbPath.Child("loadBalancerAttributes").Key(lbAttrsAccessLogsS3Enabled),
+			fmt.Sprintf("access logs must be enabled by LoadBalancerPolicy %v", policyName)),
+	}
+}
+
+func evaluateDeniedInboundCIDRsRule(policyName string, rule elbv2api.DeniedInboundCIDRsRule, stack core.Stack,
+	namespaces []string, nsLabelsByName map[string]labels.Set, lbPath *field.Path) (field.ErrorList, error) {
+	if rule.ExemptNamespaceSelector != nil {
+		unselectedNamespaces, err := findUnselectedNamespaces(rule.ExemptNamespaceSelector, namespaces, nsLabelsByName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid deniedInboundCIDRs.exemptNamespaceSelector in LoadBalancerPolicy %v", policyName)
+		}
+		if len(unselectedNamespaces) == 0 {
+			return nil, nil
+		}
+	}
+	deniedCIDRs := sets.NewString()
+	for _, cidr := range rule.CIDRs {
+		normalizedCIDR, err := normalizeCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid deniedInboundCIDRs.cidrs in LoadBalancerPolicy %v", policyName)
+		}
+		deniedCIDRs.Insert(normalizedCIDR)
+	}
+	inboundCIDRs, err := extractInboundCIDRs(stack)
+	if err != nil {
+		return nil, err
+	}
+	var violations field.ErrorList
+	for _, cidr := range inboundCIDRs {
+		normalizedCIDR, err := normalizeCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if deniedCIDRs.Has(normalizedCIDR) {
+			violations = append(violations, field.Forbidden(lbPath.Child("inboundCIDRs"),
+				fmt.Sprintf("inbound CIDR %v is denied by LoadBalancerPolicy %v", cidr, policyName)))
+		}
+	}
+	return violations, nil
+}
+
+// extractInboundCIDRs returns the CIDRs allowed to access the load balancer,
+// which are from the ingress rules of managed security groups and the networking rules of TargetGroupBindings.
+func extractInboundCIDRs(stack core.Stack) ([]string, error) {
+	var sgs []*ec2model.SecurityGroup
+	if err := stack.ListResources(&sgs); err != nil {
+		return nil, err
+	}
+	var tgbs []*elbv2model.TargetGroupBindingResource
+	if err := stack.ListResources(&tgbs); err != nil {
+		return nil, err
+	}
+	cidrs := sets.NewString()
+	for _, sg := range sgs {
+		for _, permission := range sg.Spec.Ingress {
+			for _, ipRange := range permission.IPRanges {
+				cidrs.Insert(ipRange.CIDRIP)
+			}
+			for _, ipv6Range := range permission.IPv6Range {
+				cidrs.Insert(ipv6Range.CIDRIPv6)
+			}
+		}
+	}
+	for _, tgb := range tgbs {
+		if tgb.Spec.Template.Spec.Networking == nil {
+			continue
+		}
+		for _, rule := range tgb.Spec.Template.Spec.Networking.Ingress {
+			for _, peer := range rule.From {
+				if peer.IPBlock != nil {
+					cidrs.Insert(peer.IPBlock.CIDR)
+				}
+			}
+		}
+	}
+	return cidrs.List(), nil
+}
+
+// findUnselectedNamespaces returns the namespaces not selected by selector.
+func findUnselectedNamespaces(nsSelector *metav1.LabelSelector, namespaces []string, nsLabelsByName map[string]labels.Set) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(nsSelector)
+	if err != nil {
+		return nil, err
+	}
+	var unselectedNamespaces []string
+	for _, ns := range namespaces {
+		if !selector.Matches(nsLabelsByName[ns]) {
+			unselectedNamespaces = append(unselectedNamespaces, ns)
+		}
+	}
+	return unselectedNamespaces, nil
+}
+
+// sslPolicyMinimumTLSMinorVersion returns the minimum TLS minor version enforced by SSL policy.
+// returns false if it's unknown.
+func sslPolicyMinimumTLSMinorVersion(sslPolicy string) (int, bool) {
+	matches := sslPolicyTLSVersionPattern.FindStringSubmatch(sslPolicy)
+	if matches == nil {
+		return 0, false
+	}
+	minorVersion, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+	return minorVersion, true
+}
+
+func normalizeCIDR(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	return ipNet.String(), nil
+}
@@ -0,0 +1,247 @@
+package policy
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultLoadBalancerPolicyEvaluator_ListPolicies(t *testing.T) {
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-prod", Labels: map[string]string{"env": "prod"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-dev", Labels: map[string]string{"env": "dev"}}},
+	}
+	policies := []*elbv2api.LoadBalancerPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "all-namespaces"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-namespaces"},
+			Spec: elbv2api.LoadBalancerPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		policies   []*elbv2api.LoadBalancerPolicy
+		namespaces []string
+		want       []string
+	}{
+		{
+			name:       "no policies",
+			namespaces: []string{"ns-prod"},
+			want:       nil,
+		},
+		{
+			name:       "policies for dev namespace",
+			policies:   policies,
+			namespaces: []string{"ns-dev"},
+			want:       []string{"all-namespaces"},
+		},
+		{
+			name:       "policies for dev and prod namespaces",
+			policies:   policies,
+			namespaces: []string{"ns-dev", "ns-prod"},
+			want:       []string{"all-namespaces", "prod-namespaces"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newFakeClient(t, namespaces, tt.policies)
+			e := NewDefaultLoadBalancerPolicyEvaluator(k8sClient, logr.Discard())
+			got, err := e.ListPolicies(ctx, tt.namespaces)
+			assert.NoError(t, err)
+			var gotNames []string
+			for _, policy := range got {
+				gotNames = append(gotNames, policy.Name)
+			}
+			assert.Equal(t, tt.want, gotNames)
+		})
+	}
+}
+
+func Test_defaultLoadBalancerPolicyEvaluator_Evaluate(t *testing.T) {
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-public", Labels: map[string]string{"internet-facing": "allowed"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-private"}},
+	}
+	policy := &elbv2api.LoadBalancerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "security-baseline"},
+		Spec: elbv2api.LoadBalancerPolicySpec{
+			Rules: elbv2api.LoadBalancerPolicyRules{
+				InternetFacing: &elbv2api.InternetFacingRule{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"internet-facing": "allowed"}},
+				},
+				MinimumTLSVersion: tlsVersionPtr(elbv2api.TLSVersion12),
+				RequireAccessLogs: awssdk.Bool(true),
+				DeniedInboundCIDRs: &elbv2api.DeniedInboundCIDRsRule{
+					CIDRs: []string{"0.0.0.0/0", "::/0"},
+					ExemptNamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"internet-facing": "allowed"},
+					},
+				},
+			},
+		},
+	}
+	type lbSettings struct {
+		scheme         elbv2model.LoadBalancerScheme
+		accessLogs     bool
+		sslPolicy      string
+		inboundCIDRs   []string
+		skipBuildingLB bool
+	}
+	tests := []struct {
+		name       string
+		settings   lbSettings
+		namespaces []string
+		want       []string
+	}{
+		{
+			name: "compliant internet-facing load balancer",
+			settings: lbSettings{
+				scheme:       elbv2model.LoadBalancerSchemeInternetFacing,
+				accessLogs:   true,
+				sslPolicy:    "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				inboundCIDRs: []string{"0.0.0.0/0"},
+			},
+			namespaces: []string{"ns-public"},
+			want:       nil,
+		},
+		{
+			name: "non-compliant load balancer",
+			settings: lbSettings{
+				scheme:       elbv2model.LoadBalancerSchemeInternetFacing,
+				accessLogs:   false,
+				sslPolicy:    "ELBSecurityPolicy-2016-08",
+				inboundCIDRs: []string{"10.0.0.0/8", "0.0.0.0/0"},
+			},
+			namespaces: []string{"ns-public", "ns-private"},
+			want: []string{
+				"loadBalancer.scheme: Forbidden: internet-facing load balancer is not allowed in namespace ns-private by LoadBalancerPolicy security-baseline",
+				"loadBalancer.listeners[443].sslPolicy: Invalid value: \"ELBSecurityPolicy-2016-08\": SSL policy must enforce TLSv1.2 or later by LoadBalancerPolicy security-baseline",
+				"loadBalancer.loadBalancerAttributes[access_logs.s3.enabled]: Required value: access logs must be enabled by LoadBalancerPolicy security-baseline",
+				"loadBalancer.inboundCIDRs: Forbidden: inbound CIDR 0.0.0.0/0 is denied by LoadBalancerPolicy security-baseline",
+			},
+		},
+		{
+			name: "compliant internal load balancer",
+			settings: lbSettings{
+				scheme:       elbv2model.LoadBalancerSchemeInternal,
+				accessLogs:   true,
+				sslPolicy:    "ELBSecurityPolicy-TLS-1-2-Ext-2018-06",
+				inboundCIDRs: []string{"10.0.0.0/8"},
+			},
+			namespaces: []string{"ns-private"},
+			want:       nil,
+		},
+		{
+			name: "stack without load balancer",
+			settings: lbSettings{
+				skipBuildingLB: true,
+			},
+			namespaces: []string{"ns-private"},
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newFakeClient(t, namespaces, nil)
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "name"})
+			if !tt.settings.skipBuildingLB {
+				lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+					Scheme: &tt.settings.scheme,
+					LoadBalancerAttributes: []elbv2model.LoadBalancerAttribute{
+						{Key: "access_logs.s3.enabled", Value: strconv.FormatBool(tt.settings.accessLogs)},
+					},
+				})
+				elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+					LoadBalancerARN: lb.LoadBalancerARN(),
+					Port:            80,
+					Protocol:        elbv2model.ProtocolHTTP,
+				})
+				elbv2model.NewListener(stack, "443", elbv2model.ListenerSpec{
+					LoadBalancerARN: lb.LoadBalancerARN(),
+					Port:            443,
+					Protocol:        elbv2model.ProtocolHTTPS,
+					SSLPolicy:       awssdk.String(tt.settings.sslPolicy),
+				})
+				var ipRanges []ec2model.IPRange
+				for _, cidr := range tt.settings.inboundCIDRs {
+					ipRanges = append(ipRanges, ec2model.IPRange{CIDRIP: cidr})
+				}
+				ec2model.NewSecurityGroup(stack, "ManagedLBSecurityGroup", ec2model.SecurityGroupSpec{
+					Ingress: []ec2model.IPPermission{
+						{IPProtocol: "tcp", IPRanges: ipRanges},
+					},
+				})
+			}
+
+			e := NewDefaultLoadBalancerPolicyEvaluator(k8sClient, logr.Discard())
+			got, err := e.Evaluate(ctx, []*elbv2api.LoadBalancerPolicy{policy}, tt.namespaces, stack)
+			assert.NoError(t, err)
+			var gotMessages []string
+			for _, violation := range got {
+				gotMessages = append(gotMessages, violation.Error())
+			}
+			assert.Equal(t, tt.want, gotMessages)
+		})
+	}
+}
+
+func Test_sslPolicyMinimumTLSMinorVersion(t *testing.T) {
+	tests := []struct {
+		sslPolicy        string
+		wantMinorVersion int
+		wantKnown        bool
+	}{
+		{sslPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06", wantMinorVersion: 2, wantKnown: true},
+		{sslPolicy: "ELBSecurityPolicy-TLS13-1-3-2021-06", wantMinorVersion: 3, wantKnown: true},
+		{sslPolicy: "ELBSecurityPolicy-TLS13-1-1-2021-06", wantMinorVersion: 1, wantKnown: true},
+		{sslPolicy: "ELBSecurityPolicy-TLS-1-2-Ext-2018-06", wantMinorVersion: 2, wantKnown: true},
+		{sslPolicy: "ELBSecurityPolicy-FS-1-2-Res-2020-10", wantMinorVersion: 2, wantKnown: true},
+		{sslPolicy: "ELBSecurityPolicy-2016-08", wantKnown: false},
+		{sslPolicy: "", wantKnown: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sslPolicy, func(t *testing.T) {
+			gotMinorVersion, gotKnown := sslPolicyMinimumTLSMinorVersion(tt.sslPolicy)
+			assert.Equal(t, tt.wantKnown, gotKnown)
+			assert.Equal(t, tt.wantMinorVersion, gotMinorVersion)
+		})
+	}
+}
+
+func newFakeClient(t *testing.T, namespaces []*corev1.Namespace, policies []*elbv2api.LoadBalancerPolicy) client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	for _, ns := range namespaces {
+		assert.NoError(t, k8sClient.Create(context.Background(), ns.DeepCopy()))
+	}
+	for _, policy := range policies {
+		assert.NoError(t, k8sClient.Create(context.Background(), policy.DeepCopy()))
+	}
+	return k8sClient
+}
+
+func tlsVersionPtr(version elbv2api.TLSVersion) *elbv2api.TLSVersion {
+	return &version
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LoadBalancerClassParamsLoader loads the LoadBalancerClassParams for Services.
+type LoadBalancerClassParamsLoader interface {
+	// Load the LoadBalancerClassParams for the loadBalancerClass of service.
+	// returns nil if the service doesn't have loadBalancerClass or there is no LoadBalancerClassParams for it.
+	Load(ctx context.Context, service *corev1.Service) (*elbv2api.LoadBalancerClassParams, error)
+}
+
+// NewDefaultLoadBalancerClassParamsLoader constructs new defaultLoadBalancerClassParamsLoader.
+func NewDefaultLoadBalancerClassParamsLoader(k8sClient client.Client) *defaultLoadBalancerClassParamsLoader {
+	return &defaultLoadBalancerClassParamsLoader{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ LoadBalancerClassParamsLoader = &defaultLoadBalancerClassParamsLoader{}
+
+type defaultLoadBalancerClassParamsLoader struct {
+	k8sClient client.Client
+}
+
+func (l *defaultLoadBalancerClassParamsLoader) Load(ctx context.Context, service *corev1.Service) (*elbv2api.LoadBalancerClassParams, error) {
+	if service.Spec.LoadBalancerClass == nil || *service.Spec.LoadBalancerClass == "" {
+		return nil, nil
+	}
+	lbClass := *service.Spec.LoadBalancerClass
+	paramsList := &elbv2api.LoadBalancerClassParamsList{}
+	if err := l.k8sClient.List(ctx, paramsList); err != nil {
+		return nil, errors.Wrap(err, "failed to list LoadBalancerClassParams")
+	}
+	var matchedParams []*elbv2api.LoadBalancerClassParams
+	for i := range paramsList.Items {
+		if paramsList.Items[i].Spec.LoadBalancerClass == lbClass {
+			matchedParams = append(matchedParams, &paramsList.Items[i])
+		}
+	}
+	switch len(matchedParams) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matchedParams[0], nil
+	default:
+		paramsNames := make([]string, 0, len(matchedParams))
+		for _, params := range matchedParams {
+			paramsNames = append(paramsNames, params.Name)
+		}
+		return nil, errors.Errorf("multiple LoadBalancerClassParams for loadBalancerClass %v: %v", lbClass, paramsNames)
+	}
+}
+
+// loadBalancerClassDefaults returns the default settings from LoadBalancerClassParams of Service.
+func (t *defaultModelBuildTask) loadBalancerClassDefaults() *elbv2api.LoadBalancerClassSettings {
+	if t.lbClassParams == nil || t.lbClassParams.Spec.Defaults == nil {
+		return &elbv2api.LoadBalancerClassSettings{}
+	}
+	return t.lbClassParams.Spec.Defaults
+}
+
+// loadBalancerClassEnforced returns the enforced settings from LoadBalancerClassParams of Service.
+func (t *defaultModelBuildTask) loadBalancerClassEnforced() *elbv2api.LoadBalancerClassSettings {
+	if t.lbClassParams == nil || t.lbClassParams.Spec.Enforced == nil {
+		return &elbv2api.LoadBalancerClassSettings{}
+	}
+	return t.lbClassParams.Spec.Enforced
+}
+
+// buildLoadBalancerClassLoadBalancerAttributes builds the LB attributes from LoadBalancerClassSettings, including access logs.
+func buildLoadBalancerClassLoadBalancerAttributes(settings *elbv2api.LoadBalancerClassSettings) map[string]string {
+	if settings == nil {
+		return nil
+	}
+	attributes := make(map[string]string, len(settings.LoadBalancerAttributes))
+	for _, attr := range settings.LoadBalancerAttributes {
+		attributes[attr.Key] = attr.Value
+	}
+	if settings.AccessLogs != nil {
+		attributes[lbAttrsAccessLogsS3Enabled] = strconv.FormatBool(settings.AccessLogs.Enabled)
+		if settings.AccessLogs.S3BucketName != "" {
+			attributes[lbAttrsAccessLogsS3Bucket] = settings.AccessLogs.S3BucketName
+		}
+		if settings.AccessLogs.S3BucketPrefix != "" {
+			attributes[lbAttrsAccessLogsS3Prefix] = settings.AccessLogs.S3BucketPrefix
+		}
+	}
+	return attributes
+}
+
+// buildLoadBalancerClassTargetGroupAttributes builds the TargetGroup attributes from LoadBalancerClassSettings.
+func buildLoadBalancerClassTargetGroupAttributes(settings *elbv2api.LoadBalancerClassSettings) map[string]string {
+	if settings == nil {
+		return nil
+	}
+	attributes := make(map[string]string, len(settings.TargetGroupAttributes))
+	for _, attr := range settings.TargetGroupAttributes {
+		attributes[attr.Key] = attr.Value
+	}
+	return attributes
+}
+
+// buildLoadBalancerClassTags builds the tags from LoadBalancerClassSettings.
+func buildLoadBalancerClassTags(settings *elbv2api.LoadBalancerClassSettings) map[string]string {
+	if settings == nil {
+		return nil
+	}
+	tags := make(map[string]string, len(settings.Tags))
+	for _, tag := range settings.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+// FindLoadBalancerClassParamsConflicts finds the annotations on service that conflict with the enforced settings of LoadBalancerClassParams.
+// It returns a human-readable description for each conflict.
+func FindLoadBalancerClassParamsConflicts(annotationParser annotations.Parser, service *corev1.Service,
+	params *elbv2api.LoadBalancerClassParams) ([]string, error) {
+	if params == nil || params.Spec.Enforced == nil {
+		return nil, nil
+	}
+	enforced := params.Spec.Enforced
+	var conflicts []string
+	if enforced.Scheme != nil {
+		var rawScheme string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, service.Annotations); exists && rawScheme != string(*enforced.Scheme) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixScheme, rawScheme, string(*enforced.Scheme)))
+		}
+		var internal bool
+		exists, err := annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixInternal, &internal, service.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if exists && internal != (*enforced.Scheme == elbv2api.LoadBalancerSchemeInternal) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixInternal, strconv.FormatBool(internal), string(*enforced.Scheme)))
+		}
+	}
+	if enforced.Subnets != nil {
+		var rawSubnetNameOrIDs []string
+		if exists := annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, service.Annotations); exists {
+			enforcedSubnetIDs := sets.NewString()
+			for _, subnetID := range enforced.Subnets.IDs {
+				enforcedSubnetIDs.Insert(string(subnetID))
+			}
+			if len(enforced.Subnets.Tags) != 0 || !enforcedSubnetIDs.Equal(sets.NewString(rawSubnetNameOrIDs...)) {
+				conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixSubnets, rawSubnetNameOrIDs, *enforced.Subnets))
+			}
+		}
+	}
+	if enforced.IPAddressType != nil {
+		var rawIPAddressType string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, service.Annotations); exists && rawIPAddressType != string(*enforced.IPAddressType) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixIPAddressType, rawIPAddressType, string(*enforced.IPAddressType)))
+		}
+	}
+	if enforced.DeletionPolicy != nil {
+		var rawDeletionPolicy string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDeletionPolicy, &rawDeletionPolicy, service.Annotations); exists && rawDeletionPolicy != string(*enforced.DeletionPolicy) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixDeletionPolicy, rawDeletionPolicy, string(*enforced.DeletionPolicy)))
+		}
+	}
+	if enforced.TargetType != nil {
+		var rawTargetType string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &rawTargetType, service.Annotations); exists && rawTargetType != string(*enforced.TargetType) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixTargetType, rawTargetType, string(*enforced.TargetType)))
+		}
+		var rawLBType string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &rawLBType, service.Annotations); exists &&
+			rawLBType == LoadBalancerTypeNLBIP && *enforced.TargetType != elbv2api.LoadBalancerTargetTypeIP {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixLoadBalancerType, rawLBType, string(*enforced.TargetType)))
+		}
+	}
+	if len(enforced.SecurityGroups) != 0 {
+		var sgNameOrIDs []string
+		if exists := annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, service.Annotations); exists &&
+			!sets.NewString(sgNameOrIDs...).Equal(sets.NewString(enforced.SecurityGroups...)) {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixLoadBalancerSecurityGroups, sgNameOrIDs, enforced.SecurityGroups))
+		}
+	}
+
+	enforcedLBAttributes := buildLoadBalancerClassLoadBalancerAttributes(enforced)
+	lbAttrsConflicts, err := findStringMapAnnotationConflicts(annotationParser, annotations.SvcLBSuffixLoadBalancerAttributes, service, enforcedLBAttributes)
+	if err != nil {
+		return nil, err
+	}
+	conflicts = append(conflicts, lbAttrsConflicts...)
+	if enforced.AccessLogs != nil {
+		var accessLogEnabled bool
+		exists, err := annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixAccessLogEnabled, &accessLogEnabled, service.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if exists && accessLogEnabled != enforced.AccessLogs.Enabled {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixAccessLogEnabled, accessLogEnabled, enforced.AccessLogs.Enabled))
+		}
+		var bucketName string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixAccessLogS3BucketName, &bucketName, service.Annotations); exists &&
+			enforced.AccessLogs.S3BucketName != "" && bucketName != enforced.AccessLogs.S3BucketName {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixAccessLogS3BucketName, bucketName, enforced.AccessLogs.S3BucketName))
+		}
+		var bucketPrefix string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixAccessLogS3BucketPrefix, &bucketPrefix, service.Annotations); exists &&
+			enforced.AccessLogs.S3BucketPrefix != "" && bucketPrefix != enforced.AccessLogs.S3BucketPrefix {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixAccessLogS3BucketPrefix, bucketPrefix, enforced.AccessLogs.S3BucketPrefix))
+		}
+	}
+
+	enforcedTGAttributes := buildLoadBalancerClassTargetGroupAttributes(enforced)
+	tgAttrsConflicts, err := findStringMapAnnotationConflicts(annotationParser, annotations.SvcLBSuffixTargetGroupAttributes, service, enforcedTGAttributes)
+	if err != nil {
+		return nil, err
+	}
+	conflicts = append(conflicts, tgAttrsConflicts...)
+	if proxyProtocolV2Enabled, ok := enforcedTGAttributes[tgAttrsProxyProtocolV2Enabled]; ok && proxyProtocolV2Enabled != "true" {
+		var rawProxyProtocol string
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixProxyProtocol, &rawProxyProtocol, service.Annotations); exists {
+			conflicts = append(conflicts, conflictMessage(annotations.SvcLBSuffixProxyProtocol, rawProxyProtocol, tgAttrsProxyProtocolV2Enabled+"="+proxyProtocolV2Enabled))
+		}
+	}
+
+	tagsConflicts, err := findStringMapAnnotationConflicts(annotationParser, annotations.SvcLBSuffixAdditionalTags, service, buildLoadBalancerClassTags(enforced))
+	if err != nil {
+		return nil, err
+	}
+	conflicts = append(conflicts, tagsConflicts...)
+	return conflicts, nil
+}
+
+// findStringMapAnnotationConflicts finds the keys in string map annotation whose value differs from enforced ones.
+func findStringMapAnnotationConflicts(annotationParser annotations.Parser, annotation string, service *corev1.Service,
+	enforced map[string]string) ([]string, error) {
+	if len(enforced) == 0 {
+		return nil, nil
+	}
+	var rawMap map[string]string
+	if _, err := annotationParser.ParseStringMapAnnotation(annotation, &rawMap, service.Annotations); err != nil {
+		return nil, err
+	}
+	var conflicts []string
+	for _, key := range sets.StringKeySet(rawMap).List() {
+		if enforcedValue, ok := enforced[key]; ok && enforcedValue != rawMap[key] {
+			conflicts = append(conflicts, conflictMessage(annotation+"["+key+"]", rawMap[key], enforcedValue))
+		}
+	}
+	return conflicts, nil
+}
+
+func conflictMessage(annotation string, value interface{}, enforcedValue interface{}) string {
+	return fmt.Sprintf("annotation %v: %v conflicts with enforced value %v", annotation, value, enforcedValue)
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultLoadBalancerClassParamsLoader_Load(t *testing.T) {
+	nlbParams := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nlb-params",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "service.k8s.aws/nlb",
+		},
+	}
+	otherNLBParams := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "other-nlb-params",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "service.k8s.aws/nlb",
+		},
+	}
+	albParams := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "alb-params",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "service.k8s.aws/alb",
+		},
+	}
+	tests := []struct {
+		name     string
+		params   []*elbv2api.LoadBalancerClassParams
+		lbClass  *string
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:    "service without loadBalancerClass",
+			params:  []*elbv2api.LoadBalancerClassParams{nlbParams},
+			lbClass: nil,
+		},
+		{
+			name:    "no LoadBalancerClassParams for loadBalancerClass",
+			params:  []*elbv2api.LoadBalancerClassParams{albParams},
+			lbClass: awssdk.String("service.k8s.aws/nlb"),
+		},
+		{
+			name:     "single LoadBalancerClassParams for loadBalancerClass",
+			params:   []*elbv2api.LoadBalancerClassParams{nlbParams, albParams},
+			lbClass:  awssdk.String("service.k8s.aws/nlb"),
+			wantName: "nlb-params",
+		},
+		{
+			name:    "multiple LoadBalancerClassParams for loadBalancerClass",
+			params:  []*elbv2api.LoadBalancerClassParams{nlbParams, otherNLBParams, albParams},
+			lbClass: awssdk.String("service.k8s.aws/nlb"),
+			wantErr: errors.New("multiple LoadBalancerClassParams for loadBalancerClass service.k8s.aws/nlb: [nlb-params other-nlb-params]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, params := range tt.params {
+				assert.NoError(t, k8sClient.Create(ctx, params.DeepCopy()))
+			}
+			loader := NewDefaultLoadBalancerClassParamsLoader(k8sClient)
+			svc := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerClass: tt.lbClass,
+				},
+			}
+			got, err := loader.Load(ctx, svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantName == "" {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, tt.wantName, got.Name)
+			}
+		})
+	}
+}
+
+func Test_FindLoadBalancerClassParamsConflicts(t *testing.T) {
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	ipAddressTypeIPv4 := elbv2api.IPAddressTypeIPV4
+	targetTypeInstance := elbv2api.LoadBalancerTargetTypeInstance
+	deletionPolicyRetain := elbv2api.LoadBalancerDeletionPolicyRetain
+	enforced := &elbv2api.LoadBalancerClassSettings{
+		Scheme:         &schemeInternal,
+		IPAddressType:  &ipAddressTypeIPv4,
+		TargetType:     &targetTypeInstance,
+		DeletionPolicy: &deletionPolicyRetain,
+		Subnets: &elbv2api.SubnetSelector{
+			IDs: []elbv2api.SubnetID{"subnet-a", "subnet-b"},
+		},
+		SecurityGroups: []string{"sg-a"},
+		LoadBalancerAttributes: []elbv2api.Attribute{
+			{
+				Key:   "load_balancing.cross_zone.enabled",
+				Value: "true",
+			},
+		},
+		TargetGroupAttributes: []elbv2api.Attribute{
+			{
+				Key:   "proxy_protocol_v2.enabled",
+				Value: "false",
+			},
+		},
+		AccessLogs: &elbv2api.AccessLogsConfig{
+			Enabled:      true,
+			S3BucketName: "my-bucket",
+		},
+		Tags: []elbv2api.Tag{
+			{
+				Key:   "team",
+				Value: "platform",
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		enforced    *elbv2api.LoadBalancerClassSettings
+		want        []string
+	}{
+		{
+			name: "no enforced settings",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			},
+		},
+		{
+			name: "annotations matching enforced settings",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                   "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":          "ipv4",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type":          "instance",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                  "subnet-b, subnet-a",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":          "sg-a",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":               "load_balancing.cross_zone.enabled=true,deletion_protection.enabled=true",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":       "true",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "team=platform,env=prod",
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy":          "Retain",
+			},
+			enforced: enforced,
+		},
+		{
+			name: "annotations conflicting with enforced settings",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                    "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":           "dualstack",
+				"service.beta.kubernetes.io/aws-load-balancer-type":                      "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                   "subnet-c",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":           "sg-a, sg-b",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":                "load_balancing.cross_zone.enabled=false",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name": "other-bucket",
+				"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol":            "*",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":  "team=app",
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy":           "Delete",
+			},
+			enforced: enforced,
+			want: []string{
+				"annotation aws-load-balancer-scheme: internet-facing conflicts with enforced value internal",
+				"annotation aws-load-balancer-subnets: [subnet-c] conflicts with enforced value {[subnet-a subnet-b] map[]}",
+				"annotation aws-load-balancer-ip-address-type: dualstack conflicts with enforced value ipv4",
+				"annotation aws-load-balancer-deletion-policy: Delete conflicts with enforced value Retain",
+				"annotation aws-load-balancer-type: nlb-ip conflicts with enforced value instance",
+				"annotation aws-load-balancer-security-groups: [sg-a sg-b] conflicts with enforced value [sg-a]",
+				"annotation aws-load-balancer-attributes[load_balancing.cross_zone.enabled]: false conflicts with enforced value true",
+				"annotation aws-load-balancer-access-log-s3-bucket-name: other-bucket conflicts with enforced value my-bucket",
+				"annotation aws-load-balancer-proxy-protocol: * conflicts with enforced value proxy_protocol_v2.enabled=false",
+				"annotation aws-load-balancer-additional-resource-tags[team]: app conflicts with enforced value platform",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tt.annotations,
+				},
+			}
+			params := &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					LoadBalancerClass: "service.k8s.aws/nlb",
+					Enforced:          tt.enforced,
+				},
+			}
+			got, err := FindLoadBalancerClassParamsConflicts(annotationParser, svc, params)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_withLoadBalancerClassParams(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	ipAddressTypeDualStack := elbv2api.IPAddressTypeDualStack
+	type want struct {
+		scheme        elbv2model.LoadBalancerScheme
+		ipAddressType elbv2model.IPAddressType
+		lbAttributes  []elbv2model.LoadBalancerAttribute
+		tgAttributes  []elbv2model.TargetGroupAttribute
+		tags          map[string]string
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		params      *elbv2api.LoadBalancerClassParams
+		want        want
+	}{
+		{
+			name: "defaults are used when annotations are absent",
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Defaults: &elbv2api.LoadBalancerClassSettings{
+						Scheme:        &schemeInternetFacing,
+						IPAddressType: &ipAddressTypeDualStack,
+						LoadBalancerAttributes: []elbv2api.Attribute{
+							{
+								Key:   "load_balancing.cross_zone.enabled",
+								Value: "true",
+							},
+						},
+						TargetGroupAttributes: []elbv2api.Attribute{
+							{
+								Key:   "deregistration_delay.timeout_seconds",
+								Value: "30",
+							},
+						},
+						Tags: []elbv2api.Tag{
+							{
+								Key:   "team",
+								Value: "platform",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+				ipAddressType: elbv2model.IPAddressTypeDualStack,
+				lbAttributes: []elbv2model.LoadBalancerAttribute{
+					{
+						Key:   "load_balancing.cross_zone.enabled",
+						Value: "true",
+					},
+				},
+				tgAttributes: []elbv2model.TargetGroupAttribute{
+					{
+						Key:   "deregistration_delay.timeout_seconds",
+						Value: "30",
+					},
+					{
+						Key:   "proxy_protocol_v2.enabled",
+						Value: "false",
+					},
+				},
+				tags: map[string]string{"team": "platform"},
+			},
+		},
+		{
+			name: "annotations take priority over defaults",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                   "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":          "ipv4",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":               "load_balancing.cross_zone.enabled=false",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes":  "deregistration_delay.timeout_seconds=60",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "team=app",
+			},
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Defaults: &elbv2api.LoadBalancerClassSettings{
+						Scheme:        &schemeInternetFacing,
+						IPAddressType: &ipAddressTypeDualStack,
+						LoadBalancerAttributes: []elbv2api.Attribute{
+							{
+								Key:   "load_balancing.cross_zone.enabled",
+								Value: "true",
+							},
+						},
+						TargetGroupAttributes: []elbv2api.Attribute{
+							{
+								Key:   "deregistration_delay.timeout_seconds",
+								Value: "30",
+							},
+						},
+						Tags: []elbv2api.Tag{
+							{
+								Key:   "team",
+								Value: "platform",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				scheme:        elbv2model.LoadBalancerSchemeInternal,
+				ipAddressType: elbv2model.IPAddressTypeIPV4,
+				lbAttributes: []elbv2model.LoadBalancerAttribute{
+					{
+						Key:   "load_balancing.cross_zone.enabled",
+						Value: "false",
+					},
+				},
+				tgAttributes: []elbv2model.TargetGroupAttribute{
+					{
+						Key:   "deregistration_delay.timeout_seconds",
+						Value: "60",
+					},
+					{
+						Key:   "proxy_protocol_v2.enabled",
+						Value: "false",
+					},
+				},
+				tags: map[string]string{"team": "app"},
+			},
+		},
+		{
+			name: "enforced settings take priority over annotations",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                   "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":       "false",
+				"service.beta.kubernetes.io/aws-load-balancer-proxy-protocol":           "*",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "team=app,env=prod",
+			},
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Enforced: &elbv2api.LoadBalancerClassSettings{
+						Scheme: &schemeInternal,
+						AccessLogs: &elbv2api.AccessLogsConfig{
+							Enabled:      true,
+							S3BucketName: "my-bucket",
+						},
+						TargetGroupAttributes: []elbv2api.Attribute{
+							{
+								Key:   "proxy_protocol_v2.enabled",
+								Value: "false",
+							},
+						},
+						Tags: []elbv2api.Tag{
+							{
+								Key:   "team",
+								Value: "platform",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				scheme:        elbv2model.LoadBalancerSchemeInternal,
+				ipAddressType: elbv2model.IPAddressTypeIPV4,
+				lbAttributes: []elbv2model.LoadBalancerAttribute{
+					{
+						Key:   "access_logs.s3.bucket",
+						Value: "my-bucket",
+					},
+					{
+						Key:   "access_logs.s3.enabled",
+						Value: "true",
+					},
+				},
+				tgAttributes: []elbv2model.TargetGroupAttribute{
+					{
+						Key:   "proxy_protocol_v2.enabled",
+						Value: "false",
+					},
+				},
+				tags: map[string]string{"team": "platform", "env": "prod"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			task := &defaultModelBuildTask{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+				},
+				lbClassParams:        tt.params,
+				annotationParser:     annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				externalManagedTags:  sets.NewString(),
+				defaultIPAddressType: elbv2model.IPAddressTypeIPV4,
+			}
+			scheme, err := task.buildLoadBalancerScheme(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.scheme, scheme)
+			ipAddressType, err := task.buildLoadBalancerIPAddressType(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.ipAddressType, ipAddressType)
+			lbAttributes, err := task.buildLoadBalancerAttributes(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.lbAttributes, lbAttributes)
+			tgAttributes, err := task.buildTargetGroupAttributes(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.tgAttributes, tgAttributes)
+			tags, err := task.buildAdditionalResourceTags(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.tags, tags)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	backendProtocolHTTPS = "https"
+)
+
+// buildALBLoadBalancerSpec builds the spec of Application Load Balancer for Service with the ALB loadBalancerClass.
+func (t *defaultModelBuildTask) buildALBLoadBalancerSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme,
+	existingLB *elbv2deploy.LoadBalancerWithTags) (elbv2model.LoadBalancerSpec, error) {
+	lbCfg, err := t.buildLoadBalancerConfig(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, existingLB, lbCfg.ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(t.ec2Subnets))
+	for _, subnet := range t.ec2Subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(subnet.SubnetId),
+		})
+	}
+	return elbv2model.LoadBalancerSpec{
+		Name:                   lbCfg.name,
+		Type:                   elbv2model.LoadBalancerTypeApplication,
+		Scheme:                 &scheme,
+		IPAddressType:          &lbCfg.ipAddressType,
+		SecurityGroups:         securityGroups,
+		SubnetMappings:         subnetMappings,
+		LoadBalancerAttributes: lbCfg.lbAttributes,
+		Tags:                   lbCfg.tags,
+	}, nil
+}
+
+// buildALBListenerSpec builds the spec of HTTP or HTTPS listener for ServicePort on Application Load Balancer.
+// the listener uses HTTPS if certificates are configured for the port, and authenticates requests if configured.
+func (t *defaultModelBuildTask) buildALBListenerSpec(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
+	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
+	listenerProtocol, tgProtocol, err := buildALBListenerProtocols(port, cfg)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+
+	tags, err := t.buildListenerTags(ctx)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	targetGroup, err := t.buildTargetGroup(ctx, port, tgProtocol, scheme)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	if err := t.validateListenerTargetType(listenerProtocol, targetGroup.Spec.TargetType); err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+
+	var defaultActions []elbv2model.Action
+	var sslPolicy *string
+	var certificates []elbv2model.Certificate
+	if listenerProtocol == elbv2model.ProtocolHTTPS {
+		sslPolicy = cfg.sslPolicy
+		certificates = cfg.certificates
+		authAction, err := t.buildALBListenerAuthAction(ctx)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		if authAction != nil {
+			defaultActions = append(defaultActions, *authAction)
+		}
+	}
+	defaultActions = append(defaultActions, t.buildListenerDefaultActions(ctx, targetGroup)...)
+	return elbv2model.ListenerSpec{
+		LoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
+		Port:            int64(port.Port),
+		Protocol:        listenerProtocol,
+		Certificates:    certificates,
+		SSLPolicy:       sslPolicy,
+		DefaultActions:  defaultActions,
+		Tags:            tags,
+	}, nil
+}
+
+// buildALBListenerProtocols builds the protocol for ALB listener and its targetGroup.
+// the listener uses HTTPS when certificates are configured for the port.
+func buildALBListenerProtocols(port corev1.ServicePort, cfg listenerConfig) (elbv2model.Protocol, elbv2model.Protocol, error) {
+	if port.Protocol != corev1.ProtocolTCP {
+		return "", "", errors.Errorf("unsupported protocol %v for application load balancer, only TCP is supported", port.Protocol)
+	}
+	listenerProtocol := elbv2model.ProtocolHTTP
+	if len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
+		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
+		listenerProtocol = elbv2model.ProtocolHTTPS
+	}
+	tgProtocol := elbv2model.ProtocolHTTP
+	if cfg.backendProtocol == backendProtocolHTTPS {
+		tgProtocol = elbv2model.ProtocolHTTPS
+	}
+	return listenerProtocol, tgProtocol, nil
+}
+
+// buildALBListenerAuthAction builds the authenticate action from the auth annotations on Service, or nil if authentication isn't configured.
+func (t *defaultModelBuildTask) buildALBListenerAuthAction(ctx context.Context) (*elbv2model.Action, error) {
+	authCfg, err := t.authConfigBuilder.Build(ctx, t.service.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	authAction, _, err := ingress.BuildAuthAction(ctx, t.k8sClient, t.service.Namespace, authCfg)
+	if err != nil {
+		return nil, err
+	}
+	return authAction, nil
+}
+
+// buildTargetGroupAttributesForApplicationLoadBalancer adjusts the TargetGroup's attributes for Application Load Balancer.
+// proxy protocol v2 isn't supported by Application Load Balancer, so the default attribute is dropped.
+func (t *defaultModelBuildTask) buildTargetGroupAttributesForApplicationLoadBalancer(_ context.Context, tgAttrs []elbv2model.TargetGroupAttribute) ([]elbv2model.TargetGroupAttribute, error) {
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(tgAttrs))
+	for _, attr := range tgAttrs {
+		if attr.Key == tgAttrsProxyProtocolV2Enabled {
+			if attr.Value == "true" {
+				return nil, errors.New("proxy protocol v2 is not supported for application load balancer")
+			}
+			continue
+		}
+		attributes = append(attributes, attr)
+	}
+	return attributes, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildALBListenerSpec_unsupportedProtocol(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    corev1.ServicePort
+		wantErr error
+	}{
+		{
+			name: "udp port",
+			port: corev1.ServicePort{
+				Port:     53,
+				Protocol: corev1.ProtocolUDP,
+			},
+			wantErr: errors.New("unsupported protocol UDP for application load balancer, only TCP is supported"),
+		},
+		{
+			name: "sctp port",
+			port: corev1.ServicePort{
+				Port:     80,
+				Protocol: corev1.ProtocolSCTP,
+			},
+			wantErr: errors.New("unsupported protocol SCTP for application load balancer, only TCP is supported"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				loadBalancerType: elbv2model.LoadBalancerTypeApplication,
+			}
+			_, err := task.buildALBListenerSpec(context.Background(), tt.port, listenerConfig{tlsPortsSet: sets.NewString()}, elbv2model.LoadBalancerSchemeInternal)
+			assert.EqualError(t, err, tt.wantErr.Error())
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupAttributesForApplicationLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name    string
+		tgAttrs []elbv2model.TargetGroupAttribute
+		want    []elbv2model.TargetGroupAttribute
+		wantErr error
+	}{
+		{
+			name: "proxy protocol v2 disabled is dropped",
+			tgAttrs: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "proxy_protocol_v2.enabled",
+					Value: "false",
+				},
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "60",
+				},
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "60",
+				},
+			},
+		},
+		{
+			name: "proxy protocol v2 enabled",
+			tgAttrs: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "proxy_protocol_v2.enabled",
+					Value: "true",
+				},
+			},
+			wantErr: errors.New("proxy protocol v2 is not supported for application load balancer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got, err := task.buildTargetGroupAttributesForApplicationLoadBalancer(context.Background(), tt.tgAttrs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
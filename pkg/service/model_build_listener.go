@@ -19,7 +19,11 @@ func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2
 		return err
 	}
 
-	for _, port := range t.service.Spec.Ports {
+	listenerPorts, err := mergeServicePortsForListeners(t.service.Spec.Ports)
+	if err != nil {
+		return err
+	}
+	for _, port := range listenerPorts {
 		_, err := t.buildListener(ctx, port, *cfg, scheme)
 		if err != nil {
 			return err
@@ -28,6 +32,35 @@ func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2
 	return nil
 }
 
+// mergeServicePortsForListeners merges ServicePorts that share the same port into the ServicePort for a single listener.
+// a TCP and a UDP ServicePort with the same port, nodePort and targetPort are merged into a TCP_UDP ServicePort,
+// other ServicePorts sharing the same port are rejected since they would result in conflicting listeners.
+func mergeServicePortsForListeners(ports []corev1.ServicePort) ([]corev1.ServicePort, error) {
+	portIndexByPort := make(map[int32]int, len(ports))
+	var mergedPorts []corev1.ServicePort
+	for _, port := range ports {
+		idx, exists := portIndexByPort[port.Port]
+		if !exists {
+			portIndexByPort[port.Port] = len(mergedPorts)
+			mergedPorts = append(mergedPorts, port)
+			continue
+		}
+		mergedPort := mergedPorts[idx]
+		if !isTCPAndUDPProtocolPair(mergedPort.Protocol, port.Protocol) ||
+			mergedPort.NodePort != port.NodePort || mergedPort.TargetPort != port.TargetPort {
+			return nil, errors.Errorf("conflicting service ports with port %v, only a TCP and a UDP port with same nodePort and targetPort can be merged", port.Port)
+		}
+		mergedPorts[idx].Protocol = serviceProtocolTCPUDP
+	}
+	return mergedPorts, nil
+}
+
+// isTCPAndUDPProtocolPair checks whether the protocols are TCP and UDP, in any order.
+func isTCPAndUDPProtocolPair(protocolA corev1.Protocol, protocolB corev1.Protocol) bool {
+	return (protocolA == corev1.ProtocolTCP && protocolB == corev1.ProtocolUDP) ||
+		(protocolA == corev1.ProtocolUDP && protocolB == corev1.ProtocolTCP)
+}
+
 func (t *defaultModelBuildTask) buildListener(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
 	scheme elbv2model.LoadBalancerScheme) (*elbv2model.Listener, error) {
 	lsSpec, err := t.buildListenerSpec(ctx, port, cfg, scheme)
@@ -41,15 +74,10 @@ func (t *defaultModelBuildTask) buildListener(ctx context.Context, port corev1.S
 
 func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
 	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
-	tgProtocol := elbv2model.Protocol(port.Protocol)
-	listenerProtocol := elbv2model.Protocol(port.Protocol)
-	if tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
-		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
-		if cfg.backendProtocol == "ssl" {
-			tgProtocol = elbv2model.ProtocolTLS
-		}
-		listenerProtocol = elbv2model.ProtocolTLS
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return t.buildALBListenerSpec(ctx, port, cfg, scheme)
 	}
+	listenerProtocol, tgProtocol := buildListenerProtocols(port, cfg)
 
 	tags, err := t.buildListenerTags(ctx)
 	if err != nil {
@@ -59,6 +87,9 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
 	}
+	if err := t.validateListenerTargetType(listenerProtocol, targetGroup.Spec.TargetType); err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
 
 	alpnPolicy, err := t.buildListenerALPNPolicy(ctx, listenerProtocol, tgProtocol)
 	if err != nil {
@@ -85,6 +116,45 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	}, nil
 }
 
+// buildListenerProtocols builds the protocol for NLB listener and its targetGroup.
+// TCP ports are promoted to TLS when certificates are configured for them.
+func buildListenerProtocols(port corev1.ServicePort, cfg listenerConfig) (elbv2model.Protocol, elbv2model.Protocol) {
+	tgProtocol := elbv2model.Protocol(port.Protocol)
+	listenerProtocol := elbv2model.Protocol(port.Protocol)
+	if tgProtocol != elbv2model.ProtocolUDP && tgProtocol != elbv2model.ProtocolTCP_UDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
+		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
+		if cfg.backendProtocol == "ssl" {
+			tgProtocol = elbv2model.ProtocolTLS
+		}
+		listenerProtocol = elbv2model.ProtocolTLS
+	}
+	return listenerProtocol, tgProtocol
+}
+
+// buildListenerProtocolsForPort builds the protocol for listener and its targetGroup on either Network or Application Load Balancer.
+func (t *defaultModelBuildTask) buildListenerProtocolsForPort(port corev1.ServicePort, cfg listenerConfig) (elbv2model.Protocol, elbv2model.Protocol, error) {
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return buildALBListenerProtocols(port, cfg)
+	}
+	listenerProtocol, tgProtocol := buildListenerProtocols(port, cfg)
+	return listenerProtocol, tgProtocol, nil
+}
+
+// validateListenerTargetType validates the targetType of targetGroup is supported by the listener.
+// alb TargetType is only supported by TCP listeners of Network Load Balancer.
+func (t *defaultModelBuildTask) validateListenerTargetType(listenerProtocol elbv2model.Protocol, targetType elbv2model.TargetType) error {
+	if targetType != elbv2model.TargetTypeALB {
+		return nil
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return errors.New("alb TargetType is not supported for application load balancer")
+	}
+	if listenerProtocol != elbv2model.ProtocolTCP {
+		return errors.Errorf("unsupported listener protocol %v for alb TargetType, only TCP is supported", listenerProtocol)
+	}
+	return nil
+}
+
 func (t *defaultModelBuildTask) buildListenerDefaultActions(_ context.Context, targetGroup *elbv2model.TargetGroup) []elbv2model.Action {
 	return []elbv2model.Action{
 		{
@@ -182,3 +182,72 @@ func Test_defaultModelBuilderTask_buildListenerConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeServicePortsForListeners(t *testing.T) {
+	tests := []struct {
+		name    string
+		ports   []corev1.ServicePort
+		want    []corev1.ServicePort
+		wantErr error
+	}{
+		{
+			name: "ports with distinct port numbers",
+			ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 31080, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolUDP},
+			},
+			want: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 31080, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolUDP},
+			},
+		},
+		{
+			name: "tcp and udp ports merged",
+			ports: []corev1.ServicePort{
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolUDP},
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 31080, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-tcp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolTCP},
+			},
+			want: []corev1.ServicePort{
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: serviceProtocolTCPUDP},
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 31080, Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "tcp and udp ports with different nodePort",
+			ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31054, Protocol: corev1.ProtocolUDP},
+			},
+			wantErr: errors.New("conflicting service ports with port 53, only a TCP and a UDP port with same nodePort and targetPort can be merged"),
+		},
+		{
+			name: "tcp and udp ports with different targetPort",
+			ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(5353), NodePort: 31053, Protocol: corev1.ProtocolUDP},
+			},
+			wantErr: errors.New("conflicting service ports with port 53, only a TCP and a UDP port with same nodePort and targetPort can be merged"),
+		},
+		{
+			name: "more than two ports with same port number",
+			ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolUDP},
+				{Name: "dns-sctp", Port: 53, TargetPort: intstr.FromInt(53), NodePort: 31053, Protocol: corev1.ProtocolSCTP},
+			},
+			wantErr: errors.New("conflicting service ports with port 53, only a TCP and a UDP port with same nodePort and targetPort can be merged"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeServicePortsForListeners(tt.ports)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
@@ -39,6 +40,19 @@ const (
 )
 
 func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, scheme elbv2model.LoadBalancerScheme) error {
+	deletionPolicy, err := t.buildLoadBalancerDeletionPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if t.adoptedLoadBalancer != nil {
+		spec, err := t.buildAdoptedLoadBalancerSpec(ctx, scheme)
+		if err != nil {
+			return err
+		}
+		spec.DeletionPolicy = deletionPolicy
+		t.loadBalancer = elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, spec)
+		return nil
+	}
 	existingLB, err := t.fetchExistingLoadBalancer(ctx)
 	if err != nil {
 		return err
@@ -47,73 +61,139 @@ func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, scheme el
 	if err != nil {
 		return err
 	}
+	spec.DeletionPolicy = deletionPolicy
 	t.loadBalancer = elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, spec)
 	return nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme,
-	existingLB *elbv2deploy.LoadBalancerWithTags) (elbv2model.LoadBalancerSpec, error) {
-	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
-	if err != nil {
-		return elbv2model.LoadBalancerSpec{}, err
+// buildLoadBalancerDeletionPolicy builds the deletion policy configured via LoadBalancerClassParams or annotations.
+// it's empty if not explicitly specified.
+func (t *defaultModelBuildTask) buildLoadBalancerDeletionPolicy(_ context.Context) (elbv2model.LoadBalancerDeletionPolicy, error) {
+	if enforcedDeletionPolicy := t.loadBalancerClassEnforced().DeletionPolicy; enforcedDeletionPolicy != nil {
+		return elbv2model.LoadBalancerDeletionPolicy(*enforcedDeletionPolicy), nil
+	}
+	rawDeletionPolicy := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDeletionPolicy, &rawDeletionPolicy, t.service.Annotations); exists {
+		switch rawDeletionPolicy {
+		case string(elbv2model.LoadBalancerDeletionPolicyDelete):
+			return elbv2model.LoadBalancerDeletionPolicyDelete, nil
+		case string(elbv2model.LoadBalancerDeletionPolicyRetain):
+			return elbv2model.LoadBalancerDeletionPolicyRetain, nil
+		case string(elbv2model.LoadBalancerDeletionPolicyOrphan):
+			return elbv2model.LoadBalancerDeletionPolicyOrphan, nil
+		default:
+			return "", errors.Errorf("unknown deletion policy: %v", rawDeletionPolicy)
+		}
 	}
-	lbAttributes, err := t.buildLoadBalancerAttributes(ctx)
-	if err != nil {
-		return elbv2model.LoadBalancerSpec{}, err
+	if defaultDeletionPolicy := t.loadBalancerClassDefaults().DeletionPolicy; defaultDeletionPolicy != nil {
+		return elbv2model.LoadBalancerDeletionPolicy(*defaultDeletionPolicy), nil
 	}
-	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, existingLB, ipAddressType)
-	if err != nil {
-		return elbv2model.LoadBalancerSpec{}, err
+	return "", nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme,
+	existingLB *elbv2deploy.LoadBalancerWithTags) (elbv2model.LoadBalancerSpec, error) {
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return t.buildALBLoadBalancerSpec(ctx, scheme, existingLB)
 	}
-	tags, err := t.buildLoadBalancerTags(ctx)
+	lbCfg, err := t.buildLoadBalancerConfig(ctx, scheme)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, ipAddressType, scheme, t.ec2Subnets)
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, existingLB, lbCfg.ipAddressType)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	name, err := t.buildLoadBalancerName(ctx, scheme)
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, lbCfg.ipAddressType, scheme, t.ec2Subnets)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	securityGroupsInboundRulesOnPrivateLink, err := t.buildSecurityGroupsInboundRulesOnPrivateLink(ctx)
-	if err != nil {
+	if err := t.buildLoadBalancerAutoPrivateIPv4Addresses(ctx, lbCfg.name, scheme, subnetMappings, t.ec2Subnets); err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
 
 	spec := elbv2model.LoadBalancerSpec{
-		Name:                   name,
+		Name:                   lbCfg.name,
 		Type:                   elbv2model.LoadBalancerTypeNetwork,
 		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
+		IPAddressType:          &lbCfg.ipAddressType,
 		SecurityGroups:         securityGroups,
 		SubnetMappings:         subnetMappings,
-		LoadBalancerAttributes: lbAttributes,
-		Tags:                   tags,
+		LoadBalancerAttributes: lbCfg.lbAttributes,
+		Tags:                   lbCfg.tags,
 	}
 
-	if securityGroupsInboundRulesOnPrivateLink != nil {
-		spec.SecurityGroupsInboundRulesOnPrivateLink = securityGroupsInboundRulesOnPrivateLink
+	if lbCfg.securityGroupsInboundRulesOnPrivateLink != nil {
+		spec.SecurityGroupsInboundRulesOnPrivateLink = lbCfg.securityGroupsInboundRulesOnPrivateLink
 	}
 
 	return spec, nil
 }
 
+// loadBalancerConfig is the load balancer settings parsed from annotations, that don't depend on AWS resources.
+type loadBalancerConfig struct {
+	name                                    string
+	ipAddressType                           elbv2model.IPAddressType
+	lbAttributes                            []elbv2model.LoadBalancerAttribute
+	tags                                    map[string]string
+	securityGroupsInboundRulesOnPrivateLink *elbv2model.SecurityGroupsInboundRulesOnPrivateLinkStatus
+}
+
+// buildLoadBalancerConfig builds the load balancer settings shared by buildModel and validate.
+func (t *defaultModelBuildTask) buildLoadBalancerConfig(ctx context.Context, scheme elbv2model.LoadBalancerScheme) (loadBalancerConfig, error) {
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
+	if err != nil {
+		return loadBalancerConfig{}, err
+	}
+	lbAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return loadBalancerConfig{}, err
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return loadBalancerConfig{}, err
+	}
+	name, err := t.buildLoadBalancerName(ctx, scheme)
+	if err != nil {
+		return loadBalancerConfig{}, err
+	}
+	cfg := loadBalancerConfig{
+		name:          name,
+		ipAddressType: ipAddressType,
+		lbAttributes:  lbAttributes,
+		tags:          tags,
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return cfg, nil
+	}
+	cfg.securityGroupsInboundRulesOnPrivateLink, err = t.buildSecurityGroupsInboundRulesOnPrivateLink(ctx)
+	if err != nil {
+		return loadBalancerConfig{}, err
+	}
+	return cfg, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, existingLB *elbv2deploy.LoadBalancerWithTags,
 	ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
-	if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) == 0 {
-		return nil, nil
-	}
-	if !t.featureGates.Enabled(config.NLBSecurityGroup) {
-		if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) != 0 {
-			return nil, errors.New("conflicting security groups configuration")
+	// Application Load Balancers always have security groups.
+	if t.loadBalancerType != elbv2model.LoadBalancerTypeApplication {
+		if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) == 0 {
+			return nil, nil
+		}
+		if !t.featureGates.Enabled(config.NLBSecurityGroup) {
+			if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) != 0 {
+				return nil, errors.New("conflicting security groups configuration")
+			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 	var sgNameOrIDs []string
 	var lbSGTokens []core.StringToken
-	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	if enforcedSGs := t.loadBalancerClassEnforced().SecurityGroups; len(enforcedSGs) != 0 {
+		sgNameOrIDs = enforcedSGs
+	} else if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations); !exists {
+		sgNameOrIDs = t.loadBalancerClassDefaults().SecurityGroups
+	}
 	if len(sgNameOrIDs) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, ipAddressType)
 		if err != nil {
@@ -172,8 +252,14 @@ func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlag(ctx context.Co
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
+	if enforcedIPAddressType := t.loadBalancerClassEnforced().IPAddressType; enforcedIPAddressType != nil {
+		return elbv2model.IPAddressType(*enforcedIPAddressType), nil
+	}
 	rawIPAddressType := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, t.service.Annotations); !exists {
+		if defaultIPAddressType := t.loadBalancerClassDefaults().IPAddressType; defaultIPAddressType != nil {
+			return elbv2model.IPAddressType(*defaultIPAddressType), nil
+		}
 		return t.defaultIPAddressType, nil
 	}
 
@@ -205,10 +291,13 @@ func (t *defaultModelBuildTask) buildSecurityGroupsInboundRulesOnPrivateLink(_ c
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
-	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerSchemeViaAnnotation(ctx)
+	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerExplicitScheme(ctx)
 	if err != nil {
 		return elbv2model.LoadBalancerSchemeInternal, err
 	}
+	if t.adoptedLoadBalancer != nil {
+		return buildAdoptedLoadBalancerScheme(*t.adoptedLoadBalancer, scheme, explicitSchemeSpecified)
+	}
 	if explicitSchemeSpecified {
 		return scheme, nil
 	}
@@ -229,6 +318,25 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (el
 	return elbv2model.LoadBalancerSchemeInternal, nil
 }
 
+// buildLoadBalancerExplicitScheme builds the scheme configured via LoadBalancerClassParams or annotations.
+// the boolean result is false if scheme isn't configured explicitly.
+func (t *defaultModelBuildTask) buildLoadBalancerExplicitScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
+	if enforcedScheme := t.loadBalancerClassEnforced().Scheme; enforcedScheme != nil {
+		return elbv2model.LoadBalancerScheme(*enforcedScheme), true, nil
+	}
+	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerSchemeViaAnnotation(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if explicitSchemeSpecified {
+		return scheme, true, nil
+	}
+	if defaultScheme := t.loadBalancerClassDefaults().Scheme; defaultScheme != nil {
+		return elbv2model.LoadBalancerScheme(*defaultScheme), true, nil
+	}
+	return "", false, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSchemeViaAnnotation(ctx context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
 	rawScheme := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, t.service.Annotations); exists {
@@ -288,8 +396,15 @@ func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (
 			return nil, errors.Errorf("external managed tag key %v cannot be specified on Service", tagKey)
 		}
 	}
+	enforcedTags := buildLoadBalancerClassTags(t.loadBalancerClassEnforced())
+	defaultTags := buildLoadBalancerClassTags(t.loadBalancerClassDefaults())
+	for tagKey := range algorithm.MergeStringMap(enforcedTags, defaultTags) {
+		if t.externalManagedTags.Has(tagKey) {
+			return nil, errors.Errorf("external managed tag key %v cannot be specified on LoadBalancerClassParams %v", tagKey, t.lbClassParams.Name)
+		}
+	}
 
-	mergedTags := algorithm.MergeStringMap(t.defaultTags, annotationTags)
+	mergedTags := algorithm.MergeStringMap(t.defaultTags, enforcedTags, annotationTags, defaultTags)
 	return mergedTags, nil
 }
 
@@ -297,62 +412,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerTags(ctx context.Context) (map[
 	return t.buildAdditionalResourceTags(ctx)
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme, ec2Subnets []*ec2sdk.Subnet) ([]elbv2model.SubnetMapping, error) {
-	var eipAllocation []string
-	eipConfigured := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEIPAllocations, &eipAllocation, t.service.Annotations)
-	if eipConfigured {
-		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
-			return nil, errors.Errorf("EIP allocations can only be set for internet facing load balancers")
-		}
-		if len(eipAllocation) != len(ec2Subnets) {
-			return nil, errors.Errorf("count of EIP allocations (%d) and subnets (%d) must match", len(eipAllocation), len(ec2Subnets))
-		}
-	}
-
-	var ipv4Addresses []netip.Addr
-	var rawIPv4Addresses []string
-	ipv4AddrConfigured := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixPrivateIpv4Addresses, &rawIPv4Addresses, t.service.Annotations)
-	if ipv4AddrConfigured {
-		if scheme != elbv2model.LoadBalancerSchemeInternal {
-			return nil, errors.Errorf("private IPv4 addresses can only be set for internal load balancers")
-		}
-		// TODO: consider relax this requirement as ELBv2 API don't require every subnet to have IPv4 address specified.
-		if len(rawIPv4Addresses) != len(ec2Subnets) {
-			return nil, errors.Errorf("count of private IPv4 addresses (%d) and subnets (%d) must match", len(rawIPv4Addresses), len(ec2Subnets))
-		}
-		for _, rawIPv4Address := range rawIPv4Addresses {
-			ipv4Address, err := netip.ParseAddr(rawIPv4Address)
-			if err != nil {
-				return nil, errors.Errorf("private IPv4 addresses must be valid IP address: %v", rawIPv4Address)
-			}
-			if !ipv4Address.Is4() {
-				return nil, errors.Errorf("private IPv4 addresses must be valid IPv4 address: %v", rawIPv4Address)
-			}
-			ipv4Addresses = append(ipv4Addresses, ipv4Address)
-		}
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme, ec2Subnets []*ec2sdk.Subnet) ([]elbv2model.SubnetMapping, error) {
+	cfg, err := t.buildSubnetMappingConfig(ctx, ipAddressType, scheme)
+	if err != nil {
+		return nil, err
 	}
-
-	var ipv6Addresses []netip.Addr
-	var rawIPv6Addresses []string
-	ipv6AddrConfigured := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixIpv6Addresses, &rawIPv6Addresses, t.service.Annotations)
-	if ipv6AddrConfigured {
-		if ipAddressType != elbv2model.IPAddressTypeDualStack {
-			return nil, errors.Errorf("IPv6 addresses can only be set for dualstack load balancers")
-		}
-		// TODO: consider relax this requirement as ELBv2 API don't require every subnet to have IPv6 address specified.
-		if len(rawIPv6Addresses) != len(ec2Subnets) {
-			return nil, errors.Errorf("count of IPv6 addresses (%d) and subnets (%d) must match", len(rawIPv6Addresses), len(ec2Subnets))
-		}
-		for _, rawIPv6Address := range rawIPv6Addresses {
-			ipv6Address, err := netip.ParseAddr(rawIPv6Address)
-			if err != nil {
-				return nil, errors.Errorf("IPv6 addresses must be valid IP address: %v", rawIPv6Address)
-			}
-			if !ipv6Address.Is6() {
-				return nil, errors.Errorf("IPv6 addresses must be valid IPv6 address: %v", rawIPv6Address)
-			}
-			ipv6Addresses = append(ipv6Addresses, ipv6Address)
-		}
+	if err := cfg.validateSubnetCount(len(ec2Subnets)); err != nil {
+		return nil, err
 	}
 
 	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(ec2Subnets))
@@ -360,26 +426,31 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 		mapping := elbv2model.SubnetMapping{
 			SubnetID: awssdk.StringValue(subnet.SubnetId),
 		}
-		if eipConfigured {
-			mapping.AllocationID = awssdk.String(eipAllocation[idx])
+		if cfg.eipConfigured {
+			mapping.AllocationID = core.LiteralStringToken(cfg.eipAllocations[idx])
 		}
-		if ipv4AddrConfigured {
+		if cfg.managedEIPConfigured {
+			eipResID := fmt.Sprintf("ElasticIP/%v", awssdk.StringValue(subnet.AvailabilityZone))
+			eip := ec2model.NewElasticIP(t.stack, eipResID, cfg.managedEIPSpec)
+			mapping.AllocationID = eip.AllocationID()
+		}
+		if cfg.ipv4AddrConfigured {
 			subnetIPv4CIDRs, err := networking.GetSubnetAssociatedIPv4CIDRs(subnet)
 			if err != nil {
 				return nil, err
 			}
-			ipv4AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(ipv4Addresses, subnetIPv4CIDRs)
+			ipv4AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(cfg.ipv4Addresses, subnetIPv4CIDRs)
 			if len(ipv4AddressesWithinSubnet) != 1 {
 				return nil, errors.Errorf("expect one private IPv4 address configured for subnet: %v", awssdk.StringValue(subnet.SubnetId))
 			}
 			mapping.PrivateIPv4Address = awssdk.String(ipv4AddressesWithinSubnet[0].String())
 		}
-		if ipv6AddrConfigured {
+		if cfg.ipv6AddrConfigured {
 			subnetIPv6CIDRs, err := networking.GetSubnetAssociatedIPv6CIDRs(subnet)
 			if err != nil {
 				return nil, err
 			}
-			ipv6AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(ipv6Addresses, subnetIPv6CIDRs)
+			ipv6AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(cfg.ipv6Addresses, subnetIPv6CIDRs)
 			if len(ipv6AddressesWithinSubnet) != 1 {
 				return nil, errors.Errorf("expect one IPv6 address configured for subnet: %v", awssdk.StringValue(subnet.SubnetId))
 			}
@@ -390,28 +461,161 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 	return subnetMappings, nil
 }
 
+// subnetMappingConfig is the per-subnet settings parsed from annotations, before they're mapped onto subnets.
+type subnetMappingConfig struct {
+	eipAllocations       []string
+	eipConfigured        bool
+	managedEIPSpec       ec2model.ElasticIPSpec
+	managedEIPConfigured bool
+	ipv4Addresses        []netip.Addr
+	ipv4AddrConfigured   bool
+	ipv6Addresses        []netip.Addr
+	ipv6AddrConfigured   bool
+}
+
+// validateSubnetCount validates the per-subnet settings matches the count of subnets.
+func (c subnetMappingConfig) validateSubnetCount(subnetCount int) error {
+	if c.eipConfigured && len(c.eipAllocations) != subnetCount {
+		return errors.Errorf("count of EIP allocations (%d) and subnets (%d) must match", len(c.eipAllocations), subnetCount)
+	}
+	// TODO: consider relax this requirement as ELBv2 API don't require every subnet to have IPv4 address specified.
+	if c.ipv4AddrConfigured && len(c.ipv4Addresses) != subnetCount {
+		return errors.Errorf("count of private IPv4 addresses (%d) and subnets (%d) must match", len(c.ipv4Addresses), subnetCount)
+	}
+	// TODO: consider relax this requirement as ELBv2 API don't require every subnet to have IPv6 address specified.
+	if c.ipv6AddrConfigured && len(c.ipv6Addresses) != subnetCount {
+		return errors.Errorf("count of IPv6 addresses (%d) and subnets (%d) must match", len(c.ipv6Addresses), subnetCount)
+	}
+	return nil
+}
+
+// buildSubnetMappingConfig builds the per-subnet settings from annotations, it doesn't depend on the resolved subnets.
+func (t *defaultModelBuildTask) buildSubnetMappingConfig(ctx context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme) (subnetMappingConfig, error) {
+	cfg := subnetMappingConfig{}
+	cfg.eipConfigured = t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEIPAllocations, &cfg.eipAllocations, t.service.Annotations)
+	if cfg.eipConfigured && scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+		return subnetMappingConfig{}, errors.Errorf("EIP allocations can only be set for internet facing load balancers")
+	}
+	managedEIPSpec, managedEIPConfigured, err := t.buildManagedElasticIPSpec(ctx, scheme)
+	if err != nil {
+		return subnetMappingConfig{}, err
+	}
+	if managedEIPConfigured && cfg.eipConfigured {
+		return subnetMappingConfig{}, errors.Errorf("managed EIPs cannot be used together with EIP allocations")
+	}
+	cfg.managedEIPSpec = managedEIPSpec
+	cfg.managedEIPConfigured = managedEIPConfigured
+
+	var rawIPv4Addresses []string
+	cfg.ipv4AddrConfigured = t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixPrivateIpv4Addresses, &rawIPv4Addresses, t.service.Annotations)
+	if cfg.ipv4AddrConfigured {
+		if scheme != elbv2model.LoadBalancerSchemeInternal {
+			return subnetMappingConfig{}, errors.Errorf("private IPv4 addresses can only be set for internal load balancers")
+		}
+		for _, rawIPv4Address := range rawIPv4Addresses {
+			ipv4Address, err := netip.ParseAddr(rawIPv4Address)
+			if err != nil {
+				return subnetMappingConfig{}, errors.Errorf("private IPv4 addresses must be valid IP address: %v", rawIPv4Address)
+			}
+			if !ipv4Address.Is4() {
+				return subnetMappingConfig{}, errors.Errorf("private IPv4 addresses must be valid IPv4 address: %v", rawIPv4Address)
+			}
+			cfg.ipv4Addresses = append(cfg.ipv4Addresses, ipv4Address)
+		}
+	}
+
+	var rawIPv6Addresses []string
+	cfg.ipv6AddrConfigured = t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixIpv6Addresses, &rawIPv6Addresses, t.service.Annotations)
+	if cfg.ipv6AddrConfigured {
+		if ipAddressType != elbv2model.IPAddressTypeDualStack {
+			return subnetMappingConfig{}, errors.Errorf("IPv6 addresses can only be set for dualstack load balancers")
+		}
+		for _, rawIPv6Address := range rawIPv6Addresses {
+			ipv6Address, err := netip.ParseAddr(rawIPv6Address)
+			if err != nil {
+				return subnetMappingConfig{}, errors.Errorf("IPv6 addresses must be valid IP address: %v", rawIPv6Address)
+			}
+			if !ipv6Address.Is6() {
+				return subnetMappingConfig{}, errors.Errorf("IPv6 addresses must be valid IPv6 address: %v", rawIPv6Address)
+			}
+			cfg.ipv6Addresses = append(cfg.ipv6Addresses, ipv6Address)
+		}
+	}
+	return cfg, nil
+}
+
+// buildManagedElasticIPSpec builds the spec for ElasticIPs to allocate per subnet when controller-managed EIPs are enabled.
+func (t *defaultModelBuildTask) buildManagedElasticIPSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme) (ec2model.ElasticIPSpec, bool, error) {
+	managedEIPs := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixManagedEIPs, &managedEIPs, t.service.Annotations); err != nil {
+		return ec2model.ElasticIPSpec{}, false, err
+	}
+	if !managedEIPs {
+		return ec2model.ElasticIPSpec{}, false, nil
+	}
+	if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+		return ec2model.ElasticIPSpec{}, false, errors.Errorf("managed EIPs can only be used for internet facing load balancers")
+	}
+	var publicIPv4Pool *string
+	rawPublicIPv4Pool := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixEIPPublicIPv4Pool, &rawPublicIPv4Pool, t.service.Annotations); exists && rawPublicIPv4Pool != "" {
+		publicIPv4Pool = awssdk.String(rawPublicIPv4Pool)
+	}
+	retain := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixEIPRetain, &retain, t.service.Annotations); err != nil {
+		return ec2model.ElasticIPSpec{}, false, err
+	}
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return ec2model.ElasticIPSpec{}, false, err
+	}
+	return ec2model.ElasticIPSpec{
+		PublicIPv4Pool: publicIPv4Pool,
+		Retain:         retain,
+		Tags:           tags,
+	}, true, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]*ec2sdk.Subnet, error) {
+	lbType := elbv2model.LoadBalancerTypeNetwork
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		lbType = elbv2model.LoadBalancerTypeApplication
+	}
+	if t.adoptedLoadBalancer != nil {
+		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, buildSDKLoadBalancerSubnetIDs(*t.adoptedLoadBalancer),
+			networking.WithSubnetsResolveLBType(lbType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+	}
+	if enforcedSubnets := t.loadBalancerClassEnforced().Subnets; enforcedSubnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, enforcedSubnets,
+			networking.WithSubnetsResolveLBType(lbType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		)
+	}
 	var rawSubnetNameOrIDs []string
 	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations); exists {
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
-			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBType(lbType),
 			networking.WithSubnetsResolveLBScheme(scheme),
 		)
 	}
+	if defaultSubnets := t.loadBalancerClassDefaults().Subnets; defaultSubnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, defaultSubnets,
+			networking.WithSubnetsResolveLBType(lbType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		)
+	}
 
 	existingLB, err := t.fetchExistingLoadBalancer(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if existingLB != nil && string(scheme) == awssdk.StringValue(existingLB.LoadBalancer.Scheme) {
-		availabilityZones := existingLB.LoadBalancer.AvailabilityZones
-		subnetIDs := make([]string, 0, len(availabilityZones))
-		for _, availabilityZone := range availabilityZones {
-			subnetID := awssdk.StringValue(availabilityZone.SubnetId)
-			subnetIDs = append(subnetIDs, subnetID)
-		}
-		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, subnetIDs,
-			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, buildSDKLoadBalancerSubnetIDs(*existingLB),
+			networking.WithSubnetsResolveLBType(lbType),
 			networking.WithSubnetsResolveLBScheme(scheme),
 		)
 	}
@@ -423,14 +627,14 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, sc
 	if (scheme == elbv2model.LoadBalancerSchemeInternetFacing) ||
 		((scheme == elbv2model.LoadBalancerSchemeInternal) && !ipv4Configured) {
 		return t.subnetsResolver.ResolveViaDiscovery(ctx,
-			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBType(lbType),
 			networking.WithSubnetsResolveLBScheme(scheme),
 			networking.WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
 			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
 		)
 	}
 	return t.subnetsResolver.ResolveViaDiscovery(ctx,
-		networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+		networking.WithSubnetsResolveLBType(lbType),
 		networking.WithSubnetsResolveLBScheme(scheme),
 		networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
 	)
@@ -445,7 +649,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	if err != nil {
 		return []elbv2model.LoadBalancerAttribute{}, err
 	}
-	mergedAttributes := algorithm.MergeStringMap(specificAttributes, loadBalancerAttributes)
+	enforcedAttributes := buildLoadBalancerClassLoadBalancerAttributes(t.loadBalancerClassEnforced())
+	defaultAttributes := buildLoadBalancerClassLoadBalancerAttributes(t.loadBalancerClassDefaults())
+	mergedAttributes := algorithm.MergeStringMap(enforcedAttributes, specificAttributes, loadBalancerAttributes, defaultAttributes)
 	return makeAttributesSliceFromMap(mergedAttributes), nil
 }
 
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// buildLoadBalancerAdoption builds the adoption of an existing load balancer, and validates the load balancer can be adopted.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoption(ctx context.Context) error {
+	adoption, err := t.buildLoadBalancerAdoptionConfig(ctx)
+	if err != nil || adoption == nil {
+		return err
+	}
+	if !t.featureGates.Enabled(config.ListenerRulesTagging) {
+		return errors.Errorf("%v feature gate is required to adopt load balancer", config.ListenerRulesTagging)
+	}
+	sdkLB, err := t.elbv2TaggingManager.GetLoadBalancer(ctx, adoption.LoadBalancerARN)
+	if err != nil {
+		return err
+	}
+	if err := elbv2deploy.ValidateLoadBalancerAdoption(sdkLB, t.loadBalancerType, t.vpcID, t.trackingProvider.StackTags(t.stack)); err != nil {
+		return err
+	}
+	t.loadBalancerAdoption = adoption
+	t.adoptedLoadBalancer = &sdkLB
+	return nil
+}
+
+// buildLoadBalancerAdoptionConfig builds the adoption of an existing load balancer configured via annotations.
+// it returns nil if no load balancer is adopted.
+func (t *defaultModelBuildTask) buildLoadBalancerAdoptionConfig(_ context.Context) (*elbv2model.LoadBalancerAdoption, error) {
+	var lbARN string
+	lbARNExists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerARN, &lbARN, t.service.Annotations)
+	var unmanagedListeners bool
+	unmanagedListenersExists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixUnmanagedListeners, &unmanagedListeners, t.service.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	var rawClaimedPorts []string
+	claimedPortsExists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixClaimedListenerPorts, &rawClaimedPorts, t.service.Annotations)
+	if !lbARNExists {
+		if unmanagedListenersExists || claimedPortsExists {
+			return nil, errors.Errorf("%v annotation is required to adopt load balancer", annotations.SvcLBSuffixLoadBalancerARN)
+		}
+		return nil, nil
+	}
+	if len(lbARN) == 0 {
+		return nil, errors.Errorf("cannot use empty value for %v annotation", annotations.SvcLBSuffixLoadBalancerARN)
+	}
+	if err := t.checkLoadBalancerAdoptable(lbARN); err != nil {
+		return nil, err
+	}
+	if claimedPortsExists && !unmanagedListeners {
+		return nil, errors.Errorf("%v annotation is only supported with unmanaged listeners", annotations.SvcLBSuffixClaimedListenerPorts)
+	}
+	claimedPorts, err := parseClaimedListenerPorts(rawClaimedPorts)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2model.LoadBalancerAdoption{
+		LoadBalancerARN:      lbARN,
+		UnmanagedListeners:   unmanagedListeners,
+		ClaimedListenerPorts: claimedPorts,
+	}, nil
+}
+
+// checkLoadBalancerAdoptable checks the load balancer is adoptable per LoadBalancerClassParams of the Service.
+// Services are namespace-scoped, so adopting by annotation alone would let any tenant take over load balancers.
+func (t *defaultModelBuildTask) checkLoadBalancerAdoptable(lbARN string) error {
+	if t.lbClassParams == nil || !sets.NewString(t.lbClassParams.Spec.AdoptableLoadBalancers...).Has(lbARN) {
+		return errors.Errorf("loadBalancer %v is not in adoptableLoadBalancers of LoadBalancerClassParams", lbARN)
+	}
+	return nil
+}
+
+// buildAdoptedLoadBalancerSpec builds the spec of the adopted load balancer.
+// the scheme, IPAddressType, subnets and security groups of the load balancer are kept as is.
+func (t *defaultModelBuildTask) buildAdoptedLoadBalancerSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme) (elbv2model.LoadBalancerSpec, error) {
+	sdkLB := t.adoptedLoadBalancer.LoadBalancer
+	ipAddressType := elbv2model.IPAddressType(awssdk.StringValue(sdkLB.IpAddressType))
+	lbAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	securityGroups, err := t.buildAdoptedLoadBalancerSecurityGroups(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(t.ec2Subnets))
+	for _, subnet := range t.ec2Subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(subnet.SubnetId),
+		})
+	}
+	return elbv2model.LoadBalancerSpec{
+		Name:                   awssdk.StringValue(sdkLB.LoadBalancerName),
+		Type:                   t.loadBalancerType,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SecurityGroups:         securityGroups,
+		SubnetMappings:         subnetMappings,
+		LoadBalancerAttributes: lbAttributes,
+		Tags:                   tags,
+		Adoption:               t.loadBalancerAdoption,
+	}, nil
+}
+
+// buildAdoptedLoadBalancerSecurityGroups builds the security groups of the adopted load balancer.
+// the backend security group is added to existing security groups if backend security group rules are managed.
+func (t *defaultModelBuildTask) buildAdoptedLoadBalancerSecurityGroups(ctx context.Context) ([]core.StringToken, error) {
+	manageBackendSGRules, err := t.buildManageSecurityGroupRulesFlag(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkSGIDs := awssdk.StringValueSlice(t.adoptedLoadBalancer.LoadBalancer.SecurityGroups)
+	backendSGID := ""
+	if manageBackendSGRules {
+		if len(sdkSGIDs) == 0 {
+			return nil, errors.New("cannot manage worker node SG rules for adopted load balancer without security groups")
+		}
+		if !t.enableBackendSG {
+			return nil, errors.New("backendSG feature is required to manage worker node SG rules for adopted load balancer")
+		}
+		backendSGID, err = t.backendSGProvider.Get(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(t.service)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var lbSGTokens []core.StringToken
+	for _, sgID := range sdkSGIDs {
+		if sgID == backendSGID {
+			continue
+		}
+		lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+	}
+	if manageBackendSGRules {
+		t.backendSGIDToken = core.LiteralStringToken(backendSGID)
+		t.backendSGAllocated = true
+		lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
+	}
+	return lbSGTokens, nil
+}
+
+// buildAdoptedLoadBalancerScheme returns the scheme of the adopted load balancer, which must match the explicit scheme if specified.
+func buildAdoptedLoadBalancerScheme(sdkLB elbv2deploy.LoadBalancerWithTags, explicitScheme elbv2model.LoadBalancerScheme,
+	explicitSchemeSpecified bool) (elbv2model.LoadBalancerScheme, error) {
+	scheme := elbv2model.LoadBalancerScheme(awssdk.StringValue(sdkLB.LoadBalancer.Scheme))
+	if explicitSchemeSpecified && explicitScheme != scheme {
+		return "", errors.Errorf("cannot adopt loadBalancer %v: its scheme is %v instead of %v",
+			awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), scheme, explicitScheme)
+	}
+	return scheme, nil
+}
+
+// buildSDKLoadBalancerSubnetIDs returns the IDs of subnets that the load balancer is attached to.
+func buildSDKLoadBalancerSubnetIDs(sdkLB elbv2deploy.LoadBalancerWithTags) []string {
+	availabilityZones := sdkLB.LoadBalancer.AvailabilityZones
+	subnetIDs := make([]string, 0, len(availabilityZones))
+	for _, availabilityZone := range availabilityZones {
+		subnetIDs = append(subnetIDs, awssdk.StringValue(availabilityZone.SubnetId))
+	}
+	return subnetIDs
+}
+
+// parseClaimedListenerPorts parses the ports of claimed listeners.
+func parseClaimedListenerPorts(rawClaimedPorts []string) ([]int64, error) {
+	var claimedPorts []int64
+	for _, rawPort := range rawClaimedPorts {
+		port, err := strconv.ParseInt(rawPort, 10, 64)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, errors.Errorf("invalid claimed listener port: %v", rawPort)
+		}
+		claimedPorts = append(claimedPorts, port)
+	}
+	return claimedPorts, nil
+}
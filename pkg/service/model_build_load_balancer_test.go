@@ -16,10 +16,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -264,14 +266,57 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			want: []elbv2.SubnetMapping{
 				{
 					SubnetID:     "subnet-1",
-					AllocationID: aws.String("eip1"),
+					AllocationID: core.LiteralStringToken("eip1"),
 				},
 				{
 					SubnetID:     "subnet-2",
-					AllocationID: aws.String("eip2"),
+					AllocationID: core.LiteralStringToken("eip2"),
 				},
 			},
 		},
+		{
+			name:          "ipv4 - with managed EIPs: on internal load balancer",
+			ipAddressType: elbv2.IPAddressTypeIPV4,
+			scheme:        elbv2.LoadBalancerSchemeInternal,
+			subnets: []*ec2.Subnet{
+				{
+					SubnetId:         aws.String("subnet-1"),
+					AvailabilityZone: aws.String("us-west-2a"),
+					VpcId:            aws.String("vpc-1"),
+					CidrBlock:        aws.String("192.168.1.0/24"),
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-managed-eips": "true",
+					},
+				},
+			},
+			wantErr: errors.New("managed EIPs can only be used for internet facing load balancers"),
+		},
+		{
+			name:          "ipv4 - with managed EIPs: together with EIP allocation",
+			ipAddressType: elbv2.IPAddressTypeIPV4,
+			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
+			subnets: []*ec2.Subnet{
+				{
+					SubnetId:         aws.String("subnet-1"),
+					AvailabilityZone: aws.String("us-west-2a"),
+					VpcId:            aws.String("vpc-1"),
+					CidrBlock:        aws.String("192.168.1.0/24"),
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-managed-eips":    "true",
+						"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eip1",
+					},
+				},
+			},
+			wantErr: errors.New("managed EIPs cannot be used together with EIP allocations"),
+		},
 		{
 			name:          "ipv4 - with EIP allocation: on internal load balancer",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
@@ -843,12 +888,12 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			want: []elbv2.SubnetMapping{
 				{
 					SubnetID:     "subnet-1",
-					AllocationID: aws.String("eip1"),
+					AllocationID: core.LiteralStringToken("eip1"),
 					IPv6Address:  aws.String("2600:1f13:837:8500::1"),
 				},
 				{
 					SubnetID:     "subnet-2",
-					AllocationID: aws.String("eip2"),
+					AllocationID: core.LiteralStringToken("eip2"),
 					IPv6Address:  aws.String("2600:1f13:837:8504::1"),
 				},
 			},
@@ -927,6 +972,90 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuilderTask_buildSubnetMappings_withManagedEIPs(t *testing.T) {
+	subnets := []*ec2.Subnet{
+		{
+			SubnetId:         aws.String("subnet-1"),
+			AvailabilityZone: aws.String("us-west-2a"),
+			VpcId:            aws.String("vpc-1"),
+			CidrBlock:        aws.String("192.168.1.0/24"),
+		},
+		{
+			SubnetId:         aws.String("subnet-2"),
+			AvailabilityZone: aws.String("us-west-2b"),
+			VpcId:            aws.String("vpc-1"),
+			CidrBlock:        aws.String("192.168.2.0/24"),
+		},
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantEIPIDs  []string
+		wantEIPSpec ec2model.ElasticIPSpec
+	}{
+		{
+			name: "managed EIPs from Amazon's pool",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-managed-eips": "true",
+			},
+			wantEIPIDs: []string{"ElasticIP/us-west-2a", "ElasticIP/us-west-2b"},
+			wantEIPSpec: ec2model.ElasticIPSpec{
+				Tags: map[string]string{},
+			},
+		},
+		{
+			name: "managed EIPs from BYOIP pool with retain policy",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-managed-eips":             "true",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-public-ipv4-pool":     "ipv4pool-ec2-abcdef",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-retain":               "true",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "k1=v1",
+			},
+			wantEIPIDs: []string{"ElasticIP/us-west-2a", "ElasticIP/us-west-2b"},
+			wantEIPSpec: ec2model.ElasticIPSpec{
+				PublicIPv4Pool: aws.String("ipv4pool-ec2-abcdef"),
+				Retain:         true,
+				Tags: map[string]string{
+					"k1": "v1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"})
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			builder := &defaultModelBuildTask{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+				},
+				annotationParser: annotationParser,
+				stack:            stack,
+			}
+			got, err := builder.buildLoadBalancerSubnetMappings(context.Background(), elbv2.IPAddressTypeIPV4, elbv2.LoadBalancerSchemeInternetFacing, subnets)
+			assert.NoError(t, err)
+
+			var resEIPs []*ec2model.ElasticIP
+			assert.NoError(t, stack.ListResources(&resEIPs))
+			var gotEIPIDs []string
+			for _, resEIP := range resEIPs {
+				gotEIPIDs = append(gotEIPIDs, resEIP.ID())
+				assert.Equal(t, tt.wantEIPSpec, resEIP.Spec)
+			}
+			assert.ElementsMatch(t, tt.wantEIPIDs, gotEIPIDs)
+
+			assert.Len(t, got, len(subnets))
+			for idx, mapping := range got {
+				assert.Equal(t, aws.StringValue(subnets[idx].SubnetId), mapping.SubnetID)
+				assert.Len(t, mapping.AllocationID.Dependencies(), 1)
+				assert.Equal(t, tt.wantEIPIDs[idx], mapping.AllocationID.Dependencies()[0].ID())
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 	type resolveSubnetResults struct {
 		subnets []*ec2.Subnet
@@ -1445,3 +1574,256 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerAdoptionConfig(t *testing.T) {
+	adoptableLBClassParams := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass:      "service.k8s.aws/nlb",
+			AdoptableLoadBalancers: []string{"my-arn"},
+		},
+	}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		lbClassParams *elbv2api.LoadBalancerClassParams
+		want          *elbv2.LoadBalancerAdoption
+		wantErr       error
+	}{
+		{
+			name:        "load balancer not adopted",
+			annotations: map[string]string{},
+			want:        nil,
+		},
+		{
+			name: "load balancer adopted",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn": "my-arn",
+			},
+			lbClassParams: adoptableLBClassParams,
+			want: &elbv2.LoadBalancerAdoption{
+				LoadBalancerARN: "my-arn",
+			},
+		},
+		{
+			name: "load balancer adopted with unmanaged listeners",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn":                    "my-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-unmanaged-listeners":    "true",
+				"service.beta.kubernetes.io/aws-load-balancer-claimed-listener-ports": "80, 443",
+			},
+			lbClassParams: adoptableLBClassParams,
+			want: &elbv2.LoadBalancerAdoption{
+				LoadBalancerARN:      "my-arn",
+				UnmanagedListeners:   true,
+				ClaimedListenerPorts: []int64{80, 443},
+			},
+		},
+		{
+			name: "empty load balancer ARN",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn": "",
+			},
+			wantErr: errors.New("cannot use empty value for aws-load-balancer-arn annotation"),
+		},
+		{
+			name: "claimed listener ports without unmanaged listeners",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn":                    "my-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-claimed-listener-ports": "80",
+			},
+			lbClassParams: adoptableLBClassParams,
+			wantErr:       errors.New("aws-load-balancer-claimed-listener-ports annotation is only supported with unmanaged listeners"),
+		},
+		{
+			name: "invalid claimed listener ports",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn":                    "my-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-unmanaged-listeners":    "true",
+				"service.beta.kubernetes.io/aws-load-balancer-claimed-listener-ports": "80, 70000",
+			},
+			lbClassParams: adoptableLBClassParams,
+			wantErr:       errors.New("invalid claimed listener port: 70000"),
+		},
+		{
+			name: "load balancer not in adoptableLoadBalancers",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn": "other-arn",
+			},
+			lbClassParams: adoptableLBClassParams,
+			wantErr:       errors.New("loadBalancer other-arn is not in adoptableLoadBalancers of LoadBalancerClassParams"),
+		},
+		{
+			name: "load balancer adopted without LoadBalancerClassParams",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-arn": "my-arn",
+			},
+			wantErr: errors.New("loadBalancer my-arn is not in adoptableLoadBalancers of LoadBalancerClassParams"),
+		},
+		{
+			name: "unmanaged listeners without load balancer ARN",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-unmanaged-listeners": "true",
+			},
+			wantErr: errors.New("aws-load-balancer-arn annotation is required to adopt load balancer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+				},
+				lbClassParams: tt.lbClassParams,
+			}
+			got, err := task.buildLoadBalancerAdoptionConfig(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_buildAdoptedLoadBalancerScheme(t *testing.T) {
+	sdkLB := elbv2deploy.LoadBalancerWithTags{
+		LoadBalancer: &elbv2sdk.LoadBalancer{
+			LoadBalancerArn: aws.String("my-arn"),
+			Scheme:          aws.String("internal"),
+		},
+	}
+	tests := []struct {
+		name                    string
+		explicitScheme          elbv2.LoadBalancerScheme
+		explicitSchemeSpecified bool
+		want                    elbv2.LoadBalancerScheme
+		wantErr                 error
+	}{
+		{
+			name: "scheme not specified",
+			want: elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name:                    "scheme matches",
+			explicitScheme:          elbv2.LoadBalancerSchemeInternal,
+			explicitSchemeSpecified: true,
+			want:                    elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name:                    "scheme mismatches",
+			explicitScheme:          elbv2.LoadBalancerSchemeInternetFacing,
+			explicitSchemeSpecified: true,
+			wantErr:                 errors.New("cannot adopt loadBalancer my-arn: its scheme is internal instead of internet-facing"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAdoptedLoadBalancerScheme(sdkLB, tt.explicitScheme, tt.explicitSchemeSpecified)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerDeletionPolicy(t *testing.T) {
+	deletionPolicyRetain := elbv2api.LoadBalancerDeletionPolicyRetain
+	deletionPolicyOrphan := elbv2api.LoadBalancerDeletionPolicyOrphan
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		params      *elbv2api.LoadBalancerClassParams
+		want        elbv2.LoadBalancerDeletionPolicy
+		wantErr     error
+	}{
+		{
+			name:        "deletion policy not specified",
+			annotations: map[string]string{},
+			want:        "",
+		},
+		{
+			name: "deletion policy specified via annotation",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Retain",
+			},
+			want: elbv2.LoadBalancerDeletionPolicyRetain,
+		},
+		{
+			name: "annotation takes priority over defaults",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Delete",
+			},
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Defaults: &elbv2api.LoadBalancerClassSettings{
+						DeletionPolicy: &deletionPolicyRetain,
+					},
+				},
+			},
+			want: elbv2.LoadBalancerDeletionPolicyDelete,
+		},
+		{
+			name:        "defaults are used when annotation is absent",
+			annotations: map[string]string{},
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Defaults: &elbv2api.LoadBalancerClassSettings{
+						DeletionPolicy: &deletionPolicyRetain,
+					},
+				},
+			},
+			want: elbv2.LoadBalancerDeletionPolicyRetain,
+		},
+		{
+			name: "enforced takes priority over annotation",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Delete",
+			},
+			params: &elbv2api.LoadBalancerClassParams{
+				Spec: elbv2api.LoadBalancerClassParamsSpec{
+					Enforced: &elbv2api.LoadBalancerClassSettings{
+						DeletionPolicy: &deletionPolicyOrphan,
+					},
+				},
+			},
+			want: elbv2.LoadBalancerDeletionPolicyOrphan,
+		},
+		{
+			name: "unknown deletion policy",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Keep",
+			},
+			wantErr: errors.New("unknown deletion policy: Keep"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+				},
+				lbClassParams: tt.params,
+			}
+			got, err := task.buildLoadBalancerDeletionPolicy(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	// the number of addresses AWS reserves at the beginning of each subnet CIDR.
+	subnetReservedLeadingIPv4Addresses = 4
+	// the number of addresses AWS reserves at the end of each subnet CIDR.
+	subnetReservedTrailingIPv4Addresses = 1
+)
+
+// buildLoadBalancerAutoPrivateIPv4Addresses fills subnetMappings with private IPv4 addresses picked by controller.
+// The addresses recorded on Service are reused, so that they are stable across load balancer recreation.
+func (t *defaultModelBuildTask) buildLoadBalancerAutoPrivateIPv4Addresses(ctx context.Context, lbName string, scheme elbv2model.LoadBalancerScheme,
+	subnetMappings []elbv2model.SubnetMapping, ec2Subnets []*ec2sdk.Subnet) error {
+	autoPrivateIPv4Addresses := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixAutoPrivateIpv4Addresses, &autoPrivateIPv4Addresses, t.service.Annotations); err != nil {
+		return err
+	}
+	if !autoPrivateIPv4Addresses {
+		return nil
+	}
+	if scheme != elbv2model.LoadBalancerSchemeInternal {
+		return errors.Errorf("auto private IPv4 addresses can only be used for internal load balancers")
+	}
+	var rawIPv4Addresses []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixPrivateIpv4Addresses, &rawIPv4Addresses, t.service.Annotations); exists {
+		return errors.Errorf("auto private IPv4 addresses cannot be used together with private IPv4 addresses")
+	}
+	recordedIPv4Addresses := make(map[string]string)
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixRecordedPrivateIpv4Addresses, &recordedIPv4Addresses, t.service.Annotations); err != nil {
+		return err
+	}
+
+	for idx, subnet := range ec2Subnets {
+		subnetID := awssdk.StringValue(subnet.SubnetId)
+		subnetIPv4CIDRs, err := networking.GetSubnetAssociatedIPv4CIDRs(subnet)
+		if err != nil {
+			return err
+		}
+		if len(subnetIPv4CIDRs) != 1 {
+			return errors.Errorf("expect one IPv4 CIDR for subnet: %v", subnetID)
+		}
+		enis, err := t.ec2Client.DescribeNetworkInterfacesAsList(ctx, &ec2sdk.DescribeNetworkInterfacesInput{
+			Filters: []*ec2sdk.Filter{
+				{
+					Name:   awssdk.String("subnet-id"),
+					Values: awssdk.StringSlice([]string{subnetID}),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		eniByIPv4Address := make(map[netip.Addr]*ec2sdk.NetworkInterface)
+		var lbIPv4Address *netip.Addr
+		for _, eni := range enis {
+			for _, privateIPAddress := range eni.PrivateIpAddresses {
+				addr, err := netip.ParseAddr(awssdk.StringValue(privateIPAddress.PrivateIpAddress))
+				if err != nil {
+					continue
+				}
+				eniByIPv4Address[addr] = eni
+				if isLoadBalancerNetworkInterface(eni, lbName) && awssdk.BoolValue(privateIPAddress.Primary) {
+					lbIPv4Address = &addr
+				}
+			}
+		}
+
+		var ipv4Address netip.Addr
+		if rawIPv4Address, ok := recordedIPv4Addresses[subnetID]; ok {
+			ipv4Address, err = netip.ParseAddr(rawIPv4Address)
+			if err != nil || !ipv4Address.Is4() || !subnetIPv4CIDRs[0].Contains(ipv4Address) {
+				return errors.Errorf("recorded private IPv4 address %v is invalid for subnet: %v", rawIPv4Address, subnetID)
+			}
+			if eni, ok := eniByIPv4Address[ipv4Address]; ok && !isLoadBalancerNetworkInterface(eni, lbName) {
+				return errors.Errorf("recorded private IPv4 address %v for subnet %v conflicts with network interface %v",
+					rawIPv4Address, subnetID, awssdk.StringValue(eni.NetworkInterfaceId))
+			}
+		} else if lbIPv4Address != nil {
+			// adopt the address of existing load balancer.
+			ipv4Address = *lbIPv4Address
+		} else {
+			seed := fmt.Sprintf("%v/%v", t.stack.StackID().String(), subnetID)
+			ipv4Address, err = pickFreeIPv4Address(subnetIPv4CIDRs[0], eniByIPv4Address, seed)
+			if err != nil {
+				return errors.Wrapf(err, "failed to pick private IPv4 address for subnet: %v", subnetID)
+			}
+		}
+		subnetMappings[idx].PrivateIPv4Address = awssdk.String(ipv4Address.String())
+	}
+	return nil
+}
+
+// isLoadBalancerNetworkInterface checks whether the network interface belongs to the Network Load Balancer with specified name.
+func isLoadBalancerNetworkInterface(eni *ec2sdk.NetworkInterface, lbName string) bool {
+	return strings.HasPrefix(awssdk.StringValue(eni.Description), fmt.Sprintf("ELB net/%v/", lbName))
+}
+
+// pickFreeIPv4Address picks an IPv4 address within cidr that isn't used by any network interface.
+// the search starts from an offset derived from seed, so that the same address is picked for the same seed when it's still free.
+func pickFreeIPv4Address(cidr netip.Prefix, eniByIPv4Address map[netip.Addr]*ec2sdk.NetworkInterface, seed string) (netip.Addr, error) {
+	if !cidr.Addr().Is4() || cidr.Bits() > 29 {
+		return netip.Addr{}, errors.Errorf("unsupported IPv4 CIDR: %v", cidr)
+	}
+	hostBits := 32 - cidr.Bits()
+	usableCount := (uint64(1) << hostBits) - subnetReservedLeadingIPv4Addresses - subnetReservedTrailingIPv4Addresses
+	hasher := fnv.New64a()
+	hasher.Write([]byte(seed))
+	startOffset := hasher.Sum64() % usableCount
+
+	networkAddr := cidr.Masked().Addr().As4()
+	networkAddrValue := binary.BigEndian.Uint32(networkAddr[:])
+	for i := uint64(0); i < usableCount; i++ {
+		offset := subnetReservedLeadingIPv4Addresses + (startOffset+i)%usableCount
+		var addrBytes [4]byte
+		binary.BigEndian.PutUint32(addrBytes[:], networkAddrValue+uint32(offset))
+		addr := netip.AddrFrom4(addrBytes)
+		if _, ok := eniByIPv4Address[addr]; !ok {
+			return addr, nil
+		}
+	}
+	return netip.Addr{}, errors.Errorf("no free IPv4 address within %v", cidr)
+}
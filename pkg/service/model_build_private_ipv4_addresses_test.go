@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildLoadBalancerAutoPrivateIPv4Addresses(t *testing.T) {
+	type describeNetworkInterfacesAsListCall struct {
+		subnetID string
+		resp     []*ec2.NetworkInterface
+		err      error
+	}
+	subnets := []*ec2.Subnet{
+		{
+			SubnetId:  aws.String("subnet-1"),
+			CidrBlock: aws.String("192.168.1.0/28"),
+		},
+		{
+			SubnetId:  aws.String("subnet-2"),
+			CidrBlock: aws.String("192.168.2.0/28"),
+		},
+	}
+	tests := []struct {
+		name                                 string
+		annotations                          map[string]string
+		scheme                               elbv2.LoadBalancerScheme
+		describeNetworkInterfacesAsListCalls []describeNetworkInterfacesAsListCall
+		want                                 []*string
+		wantErr                              error
+	}{
+		{
+			name:   "auto private IPv4 addresses not enabled",
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			want:   []*string{nil, nil},
+		},
+		{
+			name: "use recorded addresses",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses":     "true",
+				"service.beta.kubernetes.io/aws-load-balancer-recorded-private-ipv4-addresses": "subnet-1=192.168.1.5,subnet-2=192.168.2.9",
+			},
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					subnetID: "subnet-1",
+					resp: []*ec2.NetworkInterface{
+						{
+							NetworkInterfaceId: aws.String("eni-1"),
+							Description:        aws.String("ELB net/k8s-awesomen-awesomes-abcdef/1234567890"),
+							PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+								{PrivateIpAddress: aws.String("192.168.1.5"), Primary: aws.Bool(true)},
+							},
+						},
+					},
+				},
+				{
+					subnetID: "subnet-2",
+				},
+			},
+			want: []*string{aws.String("192.168.1.5"), aws.String("192.168.2.9")},
+		},
+		{
+			name: "adopt addresses of existing load balancer",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses": "true",
+			},
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					subnetID: "subnet-1",
+					resp: []*ec2.NetworkInterface{
+						{
+							NetworkInterfaceId: aws.String("eni-1"),
+							Description:        aws.String("ELB net/k8s-awesomen-awesomes-abcdef/1234567890"),
+							PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+								{PrivateIpAddress: aws.String("192.168.1.7"), Primary: aws.Bool(true)},
+							},
+						},
+					},
+				},
+				{
+					subnetID: "subnet-2",
+					resp: []*ec2.NetworkInterface{
+						{
+							NetworkInterfaceId: aws.String("eni-2"),
+							Description:        aws.String("ELB net/k8s-awesomen-awesomes-abcdef/1234567890"),
+							PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+								{PrivateIpAddress: aws.String("192.168.2.11"), Primary: aws.Bool(true)},
+							},
+						},
+					},
+				},
+			},
+			want: []*string{aws.String("192.168.1.7"), aws.String("192.168.2.11")},
+		},
+		{
+			name: "recorded address conflicts with other network interface",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses":     "true",
+				"service.beta.kubernetes.io/aws-load-balancer-recorded-private-ipv4-addresses": "subnet-1=192.168.1.5,subnet-2=192.168.2.9",
+			},
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					subnetID: "subnet-1",
+					resp: []*ec2.NetworkInterface{
+						{
+							NetworkInterfaceId: aws.String("eni-1"),
+							Description:        aws.String("some instance"),
+							PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+								{PrivateIpAddress: aws.String("192.168.1.5"), Primary: aws.Bool(true)},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("recorded private IPv4 address 192.168.1.5 for subnet subnet-1 conflicts with network interface eni-1"),
+		},
+		{
+			name: "recorded address outside subnet",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses":     "true",
+				"service.beta.kubernetes.io/aws-load-balancer-recorded-private-ipv4-addresses": "subnet-1=192.168.3.5",
+			},
+			scheme: elbv2.LoadBalancerSchemeInternal,
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					subnetID: "subnet-1",
+				},
+			},
+			wantErr: errors.New("recorded private IPv4 address 192.168.3.5 is invalid for subnet: subnet-1"),
+		},
+		{
+			name: "internet-facing load balancer",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses": "true",
+			},
+			scheme:  elbv2.LoadBalancerSchemeInternetFacing,
+			wantErr: errors.New("auto private IPv4 addresses can only be used for internal load balancers"),
+		},
+		{
+			name: "together with private IPv4 addresses",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-auto-private-ipv4-addresses": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-private-ipv4-addresses":      "192.168.1.5, 192.168.2.9",
+			},
+			scheme:  elbv2.LoadBalancerSchemeInternal,
+			wantErr: errors.New("auto private IPv4 addresses cannot be used together with private IPv4 addresses"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeNetworkInterfacesAsListCalls {
+				ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), &ec2.DescribeNetworkInterfacesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("subnet-id"),
+							Values: aws.StringSlice([]string{call.subnetID}),
+						},
+					},
+				}).Return(call.resp, call.err)
+			}
+			builder := &defaultModelBuildTask{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				ec2Client:        ec2Client,
+				stack:            core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"}),
+			}
+			subnetMappings := []elbv2.SubnetMapping{
+				{SubnetID: "subnet-1"},
+				{SubnetID: "subnet-2"},
+			}
+			err := builder.buildLoadBalancerAutoPrivateIPv4Addresses(context.Background(), "k8s-awesomen-awesomes-abcdef", tt.scheme, subnetMappings, subnets)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				var got []*string
+				for _, mapping := range subnetMappings {
+					got = append(got, mapping.PrivateIPv4Address)
+				}
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_pickFreeIPv4Address(t *testing.T) {
+	usedBy := func(addrs ...string) map[netip.Addr]*ec2.NetworkInterface {
+		eniByIPv4Address := make(map[netip.Addr]*ec2.NetworkInterface)
+		for _, addr := range addrs {
+			eniByIPv4Address[netip.MustParseAddr(addr)] = &ec2.NetworkInterface{}
+		}
+		return eniByIPv4Address
+	}
+	tests := []struct {
+		name             string
+		cidr             netip.Prefix
+		eniByIPv4Address map[netip.Addr]*ec2.NetworkInterface
+		wantErr          error
+	}{
+		{
+			name:             "free address available",
+			cidr:             netip.MustParsePrefix("192.168.1.0/24"),
+			eniByIPv4Address: usedBy("192.168.1.10", "192.168.1.11"),
+		},
+		{
+			name:             "only one free address available",
+			cidr:             netip.MustParsePrefix("192.168.1.0/29"),
+			eniByIPv4Address: usedBy("192.168.1.4", "192.168.1.6"),
+		},
+		{
+			name:             "no free address available",
+			cidr:             netip.MustParsePrefix("192.168.1.0/29"),
+			eniByIPv4Address: usedBy("192.168.1.4", "192.168.1.5", "192.168.1.6"),
+			wantErr:          errors.New("no free IPv4 address within 192.168.1.0/29"),
+		},
+		{
+			name:    "unsupported CIDR",
+			cidr:    netip.MustParsePrefix("192.168.1.0/30"),
+			wantErr: errors.New("unsupported IPv4 CIDR: 192.168.1.0/30"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickFreeIPv4Address(tt.cidr, tt.eniByIPv4Address, "awesome-ns/awesome-svc/subnet-1")
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.cidr.Contains(got))
+			assert.NotContains(t, tt.eniByIPv4Address, got)
+			lastAddr := tt.cidr.Addr().As4()
+			lastAddr[3] |= byte(1<<(32-tt.cidr.Bits()) - 1)
+			assert.True(t, got.Compare(tt.cidr.Addr().Next().Next().Next()) > 0, "reserved leading address picked: %v", got)
+			assert.NotEqual(t, netip.AddrFrom4(lastAddr), got, "reserved broadcast address picked")
+
+			again, err := pickFreeIPv4Address(tt.cidr, tt.eniByIPv4Address, "awesome-ns/awesome-svc/subnet-1")
+			assert.NoError(t, err)
+			assert.Equal(t, got, again)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -28,6 +29,9 @@ const (
 	tgAttrsProxyProtocolV2Enabled  = "proxy_protocol_v2.enabled"
 	tgAttrsPreserveClientIPEnabled = "preserve_client_ip.enabled"
 	healthCheckPortTrafficPort     = "traffic-port"
+
+	// serviceProtocolTCPUDP is the protocol of a merged TCP and UDP ServicePort, see mergeServicePortsForListeners.
+	serviceProtocolTCPUDP corev1.Protocol = "TCP_UDP"
 )
 
 func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev1.ServicePort, tgProtocol elbv2model.Protocol, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroup, error) {
@@ -36,55 +40,106 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	if targetGroup, exists := t.tgByResID[tgResourceID]; exists {
 		return targetGroup, nil
 	}
-	targetType, err := t.buildTargetType(ctx, port)
+	tgCfg, err := t.buildTargetGroupConfig(ctx, port)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType)
+	t.preserveClientIP = tgCfg.preserveClientIP
+	tgSpec, err := t.buildTargetGroupSpec(ctx, tgProtocol, port, tgCfg)
 	if err != nil {
 		return nil, err
 	}
-	tgAttrs, err := t.buildTargetGroupAttributes(ctx)
+	targetGroup := elbv2model.NewTargetGroup(t.stack, tgResourceID, tgSpec)
+	_, err = t.buildTargetGroupBinding(ctx, targetGroup, port, tgCfg, scheme)
 	if err != nil {
 		return nil, err
 	}
-	t.preserveClientIP, err = t.buildPreserveClientIPFlag(ctx, targetType, tgAttrs)
+	t.tgByResID[tgResourceID] = targetGroup
+	return targetGroup, nil
+}
+
+// targetGroupConfig is the TargetGroup settings for a ServicePort parsed from annotations, that don't depend on AWS resources.
+type targetGroupConfig struct {
+	targetType        elbv2model.TargetType
+	healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig
+	tgAttrs           []elbv2model.TargetGroupAttribute
+	preserveClientIP  bool
+	ipAddressType     elbv2model.TargetGroupIPAddressType
+	// albTargetRef is only set for alb TargetType.
+	albTargetRef *elbv2api.ALBTargetReference
+}
+
+// buildTargetGroupConfig builds the TargetGroup settings for port shared by buildModel and validate.
+func (t *defaultModelBuildTask) buildTargetGroupConfig(ctx context.Context, port corev1.ServicePort) (targetGroupConfig, error) {
+	targetType, err := t.buildTargetType(ctx, port)
 	if err != nil {
-		return nil, err
+		return targetGroupConfig{}, err
 	}
-	tgSpec, err := t.buildTargetGroupSpec(ctx, tgProtocol, targetType, port, healthCheckConfig, tgAttrs)
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType)
 	if err != nil {
-		return nil, err
+		return targetGroupConfig{}, err
 	}
-	targetGroup := elbv2model.NewTargetGroup(t.stack, tgResourceID, tgSpec)
-	_, err = t.buildTargetGroupBinding(ctx, targetGroup, port, healthCheckConfig, scheme)
+	tgAttrs, err := t.buildTargetGroupAttributesForTargetType(ctx, targetType)
 	if err != nil {
-		return nil, err
+		return targetGroupConfig{}, err
 	}
-	t.tgByResID[tgResourceID] = targetGroup
-	return targetGroup, nil
+	preserveClientIP, err := t.buildPreserveClientIPFlag(ctx, targetType, tgAttrs)
+	if err != nil {
+		return targetGroupConfig{}, err
+	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, t.service)
+	if err != nil {
+		return targetGroupConfig{}, err
+	}
+	var albTargetRef *elbv2api.ALBTargetReference
+	if targetType == elbv2model.TargetTypeALB {
+		albTargetRef, err = t.buildALBTargetRef(ctx)
+		if err != nil {
+			return targetGroupConfig{}, err
+		}
+	}
+	return targetGroupConfig{
+		targetType:        targetType,
+		healthCheckConfig: healthCheckConfig,
+		tgAttrs:           tgAttrs,
+		preserveClientIP:  preserveClientIP,
+		ipAddressType:     ipAddressType,
+		albTargetRef:      albTargetRef,
+	}, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProtocol elbv2model.Protocol, targetType elbv2model.TargetType,
-	port corev1.ServicePort, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig, tgAttrs []elbv2model.TargetGroupAttribute) (elbv2model.TargetGroupSpec, error) {
-	tags, err := t.buildTargetGroupTags(ctx)
+// buildTargetGroupAttributesForTargetType builds the TargetGroup's attributes, adjusted for the targetType and load balancer type.
+func (t *defaultModelBuildTask) buildTargetGroupAttributesForTargetType(ctx context.Context, targetType elbv2model.TargetType) ([]elbv2model.TargetGroupAttribute, error) {
+	tgAttrs, err := t.buildTargetGroupAttributes(ctx)
 	if err != nil {
-		return elbv2model.TargetGroupSpec{}, err
+		return nil, err
 	}
-	targetPort := t.buildTargetGroupPort(ctx, targetType, port)
-	tgName := t.buildTargetGroupName(ctx, intstr.FromInt(int(port.Port)), targetPort, targetType, tgProtocol, healthCheckConfig)
-	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, t.service)
+	if targetType == elbv2model.TargetTypeALB {
+		return t.buildTargetGroupAttributesForALBTargetType(ctx, tgAttrs)
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return t.buildTargetGroupAttributesForApplicationLoadBalancer(ctx, tgAttrs)
+	}
+	return tgAttrs, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProtocol elbv2model.Protocol,
+	port corev1.ServicePort, tgCfg targetGroupConfig) (elbv2model.TargetGroupSpec, error) {
+	tags, err := t.buildTargetGroupTags(ctx)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
+	targetPort := t.buildTargetGroupPort(ctx, tgCfg.targetType, port)
+	tgName := t.buildTargetGroupName(ctx, intstr.FromInt(int(port.Port)), targetPort, tgCfg.targetType, tgProtocol, tgCfg.healthCheckConfig)
+	ipAddressType := tgCfg.ipAddressType
 	return elbv2model.TargetGroupSpec{
 		Name:                  tgName,
-		TargetType:            targetType,
+		TargetType:            tgCfg.targetType,
 		Port:                  targetPort,
 		Protocol:              tgProtocol,
 		IPAddressType:         &ipAddressType,
-		HealthCheckConfig:     healthCheckConfig,
-		TargetGroupAttributes: tgAttrs,
+		HealthCheckConfig:     tgCfg.healthCheckConfig,
+		TargetGroupAttributes: tgCfg.tgAttrs,
 		Tags:                  tags,
 	}, nil
 }
@@ -98,10 +153,20 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Co
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx context.Context, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
-	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocol)
+	defaultHealthCheckProtocol := t.defaultHealthCheckProtocol
+	if targetType == elbv2model.TargetTypeALB || t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		defaultHealthCheckProtocol = elbv2model.ProtocolHTTP
+	}
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, defaultHealthCheckProtocol)
 	if err != nil {
 		return nil, err
 	}
+	if targetType == elbv2model.TargetTypeALB && healthCheckProtocol == elbv2model.ProtocolTCP {
+		return nil, errors.Errorf("unsupported healthCheckProtocol %v for alb TargetType", healthCheckProtocol)
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication && healthCheckProtocol == elbv2model.ProtocolTCP {
+		return nil, errors.Errorf("unsupported healthCheckProtocol %v for application load balancer", healthCheckProtocol)
+	}
 	healthCheckPathPtr := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPath, healthCheckProtocol)
 	healthCheckMatcherPtr := t.buildTargetGroupHealthCheckMatcher(ctx, healthCheckProtocol)
 	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPort, targetType)
@@ -209,9 +274,7 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawAttributes, t.service.Annotations); err != nil {
 		return nil, err
 	}
-	if rawAttributes == nil {
-		rawAttributes = make(map[string]string)
-	}
+	rawAttributes = algorithm.MergeStringMap(rawAttributes, buildLoadBalancerClassTargetGroupAttributes(t.loadBalancerClassDefaults()))
 	if _, ok := rawAttributes[tgAttrsProxyProtocolV2Enabled]; !ok {
 		rawAttributes[tgAttrsProxyProtocolV2Enabled] = strconv.FormatBool(t.defaultProxyProtocolV2Enabled)
 	}
@@ -222,6 +285,9 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 		}
 		rawAttributes[tgAttrsProxyProtocolV2Enabled] = "true"
 	}
+	for attrKey, attrValue := range buildLoadBalancerClassTargetGroupAttributes(t.loadBalancerClassEnforced()) {
+		rawAttributes[attrKey] = attrValue
+	}
 	if rawPreserveIPEnabled, ok := rawAttributes[tgAttrsPreserveClientIPEnabled]; ok {
 		_, err := strconv.ParseBool(rawPreserveIPEnabled)
 		if err != nil {
@@ -241,6 +307,22 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	return attributes, nil
 }
 
+// buildTargetGroupAttributesForALBTargetType adjusts the TargetGroup's attributes for alb TargetType.
+// proxy protocol v2 isn't supported by alb TargetType, so the default attribute is dropped.
+func (t *defaultModelBuildTask) buildTargetGroupAttributesForALBTargetType(_ context.Context, tgAttrs []elbv2model.TargetGroupAttribute) ([]elbv2model.TargetGroupAttribute, error) {
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(tgAttrs))
+	for _, attr := range tgAttrs {
+		if attr.Key == tgAttrsProxyProtocolV2Enabled {
+			if attr.Value == "true" {
+				return nil, errors.New("proxy protocol v2 is not supported for alb TargetType")
+			}
+			continue
+		}
+		attributes = append(attributes, attr)
+	}
+	return attributes, nil
+}
+
 func (t *defaultModelBuildTask) buildPreserveClientIPFlag(_ context.Context, targetType elbv2model.TargetType, tgAttrs []elbv2model.TargetGroupAttribute) (bool, error) {
 	for _, attr := range tgAttrs {
 		if attr.Key == tgAttrsPreserveClientIPEnabled {
@@ -267,6 +349,10 @@ func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetTy
 	if targetType == elbv2model.TargetTypeInstance {
 		return int64(svcPort.NodePort)
 	}
+	// the ALB receives traffic on its listener port, which must match the service port.
+	if targetType == elbv2model.TargetTypeALB {
+		return int64(svcPort.Port)
+	}
 	if svcPort.TargetPort.Type == intstr.Int {
 		return int64(svcPort.TargetPort.IntValue())
 	}
@@ -294,6 +380,9 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Contex
 	if targetType == elbv2model.TargetTypeInstance {
 		return intstr.FromInt(int(svcPort.NodePort)), nil
 	}
+	if targetType == elbv2model.TargetTypeALB {
+		return intstr.FromInt(int(svcPort.Port)), nil
+	}
 	if svcPort.TargetPort.Type == intstr.Int {
 		return svcPort.TargetPort, nil
 	}
@@ -324,7 +413,10 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Contex
 	return &healthCheckPath
 }
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, hcProtocol elbv2model.Protocol) *elbv2model.HealthCheckMatcher {
-	if hcProtocol == elbv2model.ProtocolTCP || !t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
+	if hcProtocol == elbv2model.ProtocolTCP {
+		return nil
+	}
+	if t.loadBalancerType != elbv2model.LoadBalancerTypeApplication && !t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
 		return nil
 	}
 	rawHealthCheckMatcherSuccessCodes := t.defaultHealthCheckMatcherHTTPCode
@@ -375,7 +467,17 @@ func (t *defaultModelBuildTask) buildTargetType(_ context.Context, port corev1.S
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, t.service.Annotations)
 	var lbTargetType string
 	lbTargetType = string(t.defaultTargetType)
+	if defaultTargetType := t.loadBalancerClassDefaults().TargetType; defaultTargetType != nil {
+		lbTargetType = string(*defaultTargetType)
+	}
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, t.service.Annotations)
+	if enforcedTargetType := t.loadBalancerClassEnforced().TargetType; enforcedTargetType != nil {
+		lbType = ""
+		lbTargetType = string(*enforcedTargetType)
+	}
+	if lbTargetType == LoadBalancerTargetTypeALB {
+		return elbv2model.TargetTypeALB, nil
+	}
 	if lbTargetType == LoadBalancerTargetTypeIP && !t.enableIPTargetType {
 		return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", lbTargetType, t.enableIPTargetType)
 	}
@@ -400,8 +502,8 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(ctx context.Context) (map[s
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, targetGroup *elbv2model.TargetGroup,
-	port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroupBindingResource, error) {
-	tgbSpec, err := t.buildTargetGroupBindingSpec(ctx, targetGroup, port, hc, scheme)
+	port corev1.ServicePort, tgCfg targetGroupConfig, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroupBindingResource, error) {
+	tgbSpec, err := t.buildTargetGroupBindingSpec(ctx, targetGroup, port, tgCfg, scheme)
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +511,11 @@ func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tar
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, targetGroup *elbv2model.TargetGroup,
-	port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (elbv2model.TargetGroupBindingResourceSpec, error) {
+	port corev1.ServicePort, tgCfg targetGroupConfig, scheme elbv2model.LoadBalancerScheme) (elbv2model.TargetGroupBindingResourceSpec, error) {
+	if targetGroup.Spec.TargetType == elbv2model.TargetTypeALB {
+		return t.buildTargetGroupBindingSpecForALBTargetType(ctx, targetGroup, tgCfg.albTargetRef), nil
+	}
+	hc := tgCfg.healthCheckConfig
 	nodeSelector, err := t.buildTargetGroupBindingNodeSelector(ctx, targetGroup.Spec.TargetType)
 	if err != nil {
 		return elbv2model.TargetGroupBindingResourceSpec{}, err
@@ -450,6 +556,42 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	}, nil
 }
 
+// buildTargetGroupBindingSpecForALBTargetType builds the TargetGroupBinding spec that registers the referenced ALB as target.
+func (t *defaultModelBuildTask) buildTargetGroupBindingSpecForALBTargetType(_ context.Context, targetGroup *elbv2model.TargetGroup,
+	albTargetRef *elbv2api.ALBTargetReference) elbv2model.TargetGroupBindingResourceSpec {
+	targetType := elbv2api.TargetTypeALB
+	return elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: t.service.Namespace,
+				Name:      targetGroup.Spec.Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: targetGroup.TargetGroupARN(),
+				TargetType:     &targetType,
+				ALBTargetRef:   albTargetRef,
+				IPAddressType:  (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType),
+				VpcID:          t.vpcID,
+			},
+		},
+	}
+}
+
+// buildALBTargetRef builds the reference to the Ingress or IngressGroup whose ALB is registered as target.
+func (t *defaultModelBuildTask) buildALBTargetRef(_ context.Context) (*elbv2api.ALBTargetReference, error) {
+	var ingressGroupName, ingressName string
+	groupExists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixALBTargetIngressGroup, &ingressGroupName, t.service.Annotations)
+	ingExists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixALBTargetIngress, &ingressName, t.service.Annotations)
+	if groupExists == ingExists {
+		return nil, errors.Errorf("exactly one of %v and %v annotations must be specified for alb TargetType",
+			annotations.SvcLBSuffixALBTargetIngressGroup, annotations.SvcLBSuffixALBTargetIngress)
+	}
+	if groupExists {
+		return &elbv2api.ALBTargetReference{IngressGroupName: &ingressGroupName}, nil
+	}
+	return &elbv2api.ALBTargetReference{IngressName: &ingressName}, nil
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Context, tgPort intstr.IntOrString,
 	hcPort intstr.IntOrString, port corev1.ServicePort) (*elbv2model.TargetGroupBindingNetworking, error) {
 	if t.backendSGIDToken == nil {
@@ -464,7 +606,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 			Protocol: &protocolTCP,
 			Port:     nil,
 		})
-		if port.Protocol == corev1.ProtocolUDP {
+		if port.Protocol == corev1.ProtocolUDP || port.Protocol == serviceProtocolTCPUDP {
 			ports = append(ports, elbv2api.NetworkingPort{
 				Protocol: &protocolUDP,
 				Port:     nil,
@@ -477,6 +619,14 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 				Protocol: &protocolTCP,
 				Port:     &tgPort,
 			})
+		case serviceProtocolTCPUDP:
+			ports = append(ports, elbv2api.NetworkingPort{
+				Protocol: &protocolTCP,
+				Port:     &tgPort,
+			}, elbv2api.NetworkingPort{
+				Protocol: &protocolUDP,
+				Port:     &tgPort,
+			})
 		case corev1.ProtocolUDP:
 			ports = append(ports, elbv2api.NetworkingPort{
 				Protocol: &protocolUDP,
@@ -551,10 +701,23 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingLegacy(ctx cont
 	if tgProtocol == corev1.ProtocolUDP {
 		networkingProtocol = elbv2api.NetworkingProtocolUDP
 	}
+	networkingPorts := []elbv2api.NetworkingPort{
+		{
+			Port:     &tgPort,
+			Protocol: &networkingProtocol,
+		},
+	}
+	if tgProtocol == serviceProtocolTCPUDP {
+		networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+		networkingPorts = append(networkingPorts, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocolUDP,
+		})
+	}
 	loadBalancerSubnetCIDRs := t.getLoadBalancerSubnetsSourceRanges(targetGroupIPAddressType)
 	trafficSource := loadBalancerSubnetCIDRs
 	defaultRangeUsed := false
-	if networkingProtocol == elbv2api.NetworkingProtocolUDP || t.preserveClientIP {
+	if isUDPServiceProtocol(tgProtocol) || t.preserveClientIP {
 		trafficSource = t.getLoadBalancerSourceRanges(ctx)
 		if len(trafficSource) == 0 {
 			trafficSource, err = t.getDefaultIPSourceRanges(ctx, targetGroupIPAddressType, port.Protocol, scheme)
@@ -567,13 +730,8 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingLegacy(ctx cont
 	tgbNetworking := &elbv2model.TargetGroupBindingNetworking{
 		Ingress: []elbv2model.NetworkingIngressRule{
 			{
-				From: t.buildPeersFromSourceRangeCIDRs(ctx, trafficSource),
-				Ports: []elbv2api.NetworkingPort{
-					{
-						Port:     &tgPort,
-						Protocol: &networkingProtocol,
-					},
-				},
+				From:  t.buildPeersFromSourceRangeCIDRs(ctx, trafficSource),
+				Ports: networkingPorts,
 			},
 		},
 	}
@@ -602,7 +760,7 @@ func (t *defaultModelBuildTask) getDefaultIPSourceRanges(ctx context.Context, ta
 	if targetGroupIPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
 		defaultSourceRanges = t.defaultIPv6SourceRanges
 	}
-	if (protocol == corev1.ProtocolUDP || t.preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
+	if (isUDPServiceProtocol(protocol) || t.preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
 		vpcInfo, err := t.vpcInfoProvider.FetchVPCInfo(ctx, t.vpcID, networking.FetchVPCInfoWithoutCache())
 		if err != nil {
 			return nil, err
@@ -665,7 +823,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Co
 
 func (t *defaultModelBuildTask) buildHealthCheckSourceCIDRs(trafficSource, subnetCIDRs []string, tgPort, hcPort intstr.IntOrString,
 	tgProtocol corev1.Protocol, defaultRangeUsed bool) []string {
-	if tgProtocol != corev1.ProtocolUDP &&
+	if !isUDPServiceProtocol(tgProtocol) &&
 		(hcPort.String() == healthCheckPortTrafficPort || hcPort.IntValue() == tgPort.IntValue()) {
 		if !t.preserveClientIP {
 			return nil
@@ -682,6 +840,11 @@ func (t *defaultModelBuildTask) buildHealthCheckSourceCIDRs(trafficSource, subne
 	return subnetCIDRs
 }
 
+// isUDPServiceProtocol checks whether the ServicePort protocol carries UDP traffic.
+func isUDPServiceProtocol(protocol corev1.Protocol) bool {
+	return protocol == corev1.ProtocolUDP || protocol == serviceProtocolTCPUDP
+}
+
 func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlagLegacy(_ context.Context) (bool, error) {
 	var rawEnabled bool
 	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixManageSGRules, &rawEnabled, t.service.Annotations)
@@ -1426,6 +1426,65 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 				},
 			},
 		},
+		{
+			name:             "tcp_udp with port restricted rules, different hc",
+			tgPort:           port80,
+			hcPort:           port808,
+			backendSGIDToken: core.LiteralStringToken(sgBackend),
+			tgProtocol:       serviceProtocolTCPUDP,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{GroupID: core.LiteralStringToken(sgBackend)},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port808,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                   "tcp_udp with restricted rules disabled",
+			tgPort:                 port80,
+			hcPort:                 trafficPort,
+			tgProtocol:             serviceProtocolTCPUDP,
+			backendSGIDToken:       core.LiteralStringToken(sgBackend),
+			disableRestrictedRules: true,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{GroupID: core.LiteralStringToken(sgBackend)},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:       "no backend SG configured",
 			tgPort:     port80,
@@ -1752,6 +1811,28 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			},
 			wantErr: errors.New("unable to support instance target type with an unallocated NodePort"),
 		},
+		{
+			testName: "alb target type",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "alb",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			want: elbv2.TargetTypeALB,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -1779,6 +1860,74 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuilder_buildALBTargetRef(t *testing.T) {
+	tests := []struct {
+		testName string
+		svc      *corev1.Service
+		want     *elbv2api.ALBTargetReference
+		wantErr  error
+	}{
+		{
+			testName: "ingress group annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-alb-target-ingress-group": "awesome-group",
+					},
+				},
+			},
+			want: &elbv2api.ALBTargetReference{
+				IngressGroupName: aws.String("awesome-group"),
+			},
+		},
+		{
+			testName: "ingress annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-alb-target-ingress": "awesome-ing",
+					},
+				},
+			},
+			want: &elbv2api.ALBTargetReference{
+				IngressName: aws.String("awesome-ing"),
+			},
+		},
+		{
+			testName: "no annotation",
+			svc:      &corev1.Service{},
+			wantErr:  errors.New("exactly one of aws-load-balancer-alb-target-ingress-group and aws-load-balancer-alb-target-ingress annotations must be specified for alb TargetType"),
+		},
+		{
+			testName: "both annotations",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-alb-target-ingress-group": "awesome-group",
+						"service.beta.kubernetes.io/aws-load-balancer-alb-target-ingress":       "awesome-ing",
+					},
+				},
+			},
+			wantErr: errors.New("exactly one of aws-load-balancer-alb-target-ingress-group and aws-load-balancer-alb-target-ingress annotations must be specified for alb TargetType"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          tt.svc,
+			}
+			got, err := builder.buildALBTargetRef(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuilder_buildTargetGroupBindingNodeSelector(t *testing.T) {
 	tests := []struct {
 		testName   string
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	vpcEndpointServiceResourceID = "VPCEndpointService"
+
+	vpcEndpointServiceIPAddressTypeIPv4 = "ipv4"
+	vpcEndpointServiceIPAddressTypeIPv6 = "ipv6"
+)
+
+// buildVPCEndpointService builds the VPC endpoint service that exposes the load balancer via PrivateLink.
+func (t *defaultModelBuildTask) buildVPCEndpointService(ctx context.Context) error {
+	enabled := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixVPCEndpointService, &enabled, t.service.Annotations); err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		return errors.Errorf("VPC endpoint service can only be used for network load balancers")
+	}
+	spec, err := t.buildVPCEndpointServiceSpec(ctx)
+	if err != nil {
+		return err
+	}
+	t.vpcEndpointService = ec2model.NewVPCEndpointService(t.stack, vpcEndpointServiceResourceID, spec)
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildVPCEndpointServiceSpec(ctx context.Context) (ec2model.VPCEndpointServiceSpec, error) {
+	acceptanceRequired := true
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixVPCEndpointServiceAcceptanceRequired, &acceptanceRequired, t.service.Annotations); err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	allowRecreation := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixVPCEndpointServiceAllowRecreation, &allowRecreation, t.service.Annotations); err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	var privateDNSName *string
+	var rawPrivateDNSName string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixVPCEndpointServicePrivateDNSName, &rawPrivateDNSName, t.service.Annotations); exists && rawPrivateDNSName != "" {
+		privateDNSName = awssdk.String(rawPrivateDNSName)
+	}
+	ipAddressTypes, err := t.buildVPCEndpointServiceIPAddressTypes(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	var allowedPrincipals []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixVPCEndpointServiceAllowedPrincipals, &allowedPrincipals, t.service.Annotations)
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	return ec2model.VPCEndpointServiceSpec{
+		NetworkLoadBalancerARNs: []core.StringToken{t.loadBalancer.LoadBalancerARN()},
+		AcceptanceRequired:      acceptanceRequired,
+		PrivateDNSName:          privateDNSName,
+		SupportedIPAddressTypes: ipAddressTypes,
+		AllowedPrincipals:       allowedPrincipals,
+		AllowRecreation:         allowRecreation,
+		Tags:                    tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildVPCEndpointServiceIPAddressTypes(_ context.Context) ([]string, error) {
+	var ipAddressTypes []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixVPCEndpointServiceIPAddressTypes, &ipAddressTypes, t.service.Annotations); !exists {
+		return nil, nil
+	}
+	for _, ipAddressType := range ipAddressTypes {
+		switch ipAddressType {
+		case vpcEndpointServiceIPAddressTypeIPv4, vpcEndpointServiceIPAddressTypeIPv6:
+		default:
+			return nil, errors.Errorf("invalid VPC endpoint service IP address type: %v", ipAddressType)
+		}
+	}
+	return ipAddressTypes, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildVPCEndpointService(t *testing.T) {
+	type wantSpec struct {
+		acceptanceRequired      bool
+		privateDNSName          *string
+		supportedIPAddressTypes []string
+		allowedPrincipals       []string
+		allowRecreation         bool
+		tags                    map[string]string
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        *wantSpec
+		wantErr     error
+	}{
+		{
+			name: "vpc endpoint service not enabled",
+		},
+		{
+			name: "vpc endpoint service with default settings",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service": "true",
+			},
+			want: &wantSpec{
+				acceptanceRequired: true,
+				tags:               map[string]string{},
+			},
+		},
+		{
+			name: "vpc endpoint service with all settings",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service":                     "true",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-acceptance-required": "false",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-private-dns-name":    "api.example.com",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-ip-address-types":    "ipv4, ipv6",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-allowed-principals":  "arn:aws:iam::123456789012:root, arn:aws:iam::210987654321:root",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-allow-recreation":    "true",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":                 "team=api",
+			},
+			want: &wantSpec{
+				acceptanceRequired:      false,
+				privateDNSName:          aws.String("api.example.com"),
+				supportedIPAddressTypes: []string{"ipv4", "ipv6"},
+				allowedPrincipals:       []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:root"},
+				allowRecreation:         true,
+				tags:                    map[string]string{"team": "api"},
+			},
+		},
+		{
+			name: "invalid ip address type",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service":                  "true",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-ip-address-types": "dualstack",
+			},
+			wantErr: errors.New("invalid VPC endpoint service IP address type: dualstack"),
+		},
+		{
+			name: "invalid acceptance required",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service":                     "true",
+				"service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-acceptance-required": "maybe",
+			},
+			wantErr: errors.New("failed to parse bool annotation, service.beta.kubernetes.io/aws-load-balancer-vpc-endpoint-service-acceptance-required: maybe: strconv.ParseBool: parsing \"maybe\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"})
+			lb := elbv2.NewLoadBalancer(stack, "LoadBalancer", elbv2.LoadBalancerSpec{})
+			builder := &defaultModelBuildTask{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				externalManagedTags: sets.NewString(),
+				stack:               stack,
+				loadBalancer:        lb,
+			}
+			err := builder.buildVPCEndpointService(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, builder.vpcEndpointService)
+				return
+			}
+			spec := builder.vpcEndpointService.Spec
+			assert.Equal(t, tt.want.acceptanceRequired, spec.AcceptanceRequired)
+			assert.Equal(t, tt.want.privateDNSName, spec.PrivateDNSName)
+			assert.Equal(t, tt.want.supportedIPAddressTypes, spec.SupportedIPAddressTypes)
+			assert.Equal(t, tt.want.allowedPrincipals, spec.AllowedPrincipals)
+			assert.Equal(t, tt.want.allowRecreation, spec.AllowRecreation)
+			assert.Equal(t, tt.want.tags, spec.Tags)
+			assert.Len(t, spec.NetworkLoadBalancerARNs, 1)
+			assert.Equal(t, lb.ID(), spec.NetworkLoadBalancerARNs[0].Dependencies()[0].ID())
+		})
+	}
+}
@@ -10,15 +10,19 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -26,6 +30,7 @@ const (
 	LoadBalancerTypeExternal       = "external"
 	LoadBalancerTargetTypeIP       = "ip"
 	LoadBalancerTargetTypeInstance = "instance"
+	LoadBalancerTargetTypeALB      = "alb"
 	lbAttrsDeletionProtection      = "deletion_protection.enabled"
 )
 
@@ -33,17 +38,23 @@ const (
 type ModelBuilder interface {
 	// Build model stack for service
 	Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error)
+
+	// Validate validates the load balancer settings of service that can be checked without resolving AWS resources.
+	Validate(ctx context.Context, service *corev1.Service) error
 }
 
 // NewDefaultModelBuilder construct a new defaultModelBuilder
-func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
+func NewDefaultModelBuilder(k8sClient client.Client, annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
 	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
 	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
 	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, enableIPTargetType bool, serviceUtils ServiceUtils,
 	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool,
 	disableRestrictedSGRules bool, logger logr.Logger) *defaultModelBuilder {
 	return &defaultModelBuilder{
+		k8sClient:                k8sClient,
 		annotationParser:         annotationParser,
+		authConfigBuilder:        ingress.NewDefaultAuthConfigBuilder(annotationParser),
+		lbClassParamsLoader:      NewDefaultLoadBalancerClassParamsLoader(k8sClient),
 		subnetsResolver:          subnetsResolver,
 		vpcInfoProvider:          vpcInfoProvider,
 		trackingProvider:         trackingProvider,
@@ -69,7 +80,10 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 var _ ModelBuilder = &defaultModelBuilder{}
 
 type defaultModelBuilder struct {
+	k8sClient                client.Client
 	annotationParser         annotations.Parser
+	authConfigBuilder        ingress.AuthConfigBuilder
+	lbClassParamsLoader      LoadBalancerClassParamsLoader
 	subnetsResolver          networking.SubnetsResolver
 	vpcInfoProvider          networking.VPCInfoProvider
 	backendSGProvider        networking.BackendSGProvider
@@ -93,11 +107,27 @@ type defaultModelBuilder struct {
 }
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	task := b.newModelBuildTask(service)
+	if err := task.run(ctx); err != nil {
+		return nil, nil, false, err
+	}
+	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
+}
+
+func (b *defaultModelBuilder) Validate(ctx context.Context, service *corev1.Service) error {
+	task := b.newModelBuildTask(service)
+	return task.validate(ctx)
+}
+
+func (b *defaultModelBuilder) newModelBuildTask(service *corev1.Service) *defaultModelBuildTask {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
-	task := &defaultModelBuildTask{
+	return &defaultModelBuildTask{
+		k8sClient:                b.k8sClient,
 		clusterName:              b.clusterName,
 		vpcID:                    b.vpcID,
 		annotationParser:         b.annotationParser,
+		authConfigBuilder:        b.authConfigBuilder,
+		lbClassParamsLoader:      b.lbClassParamsLoader,
 		subnetsResolver:          b.subnetsResolver,
 		backendSGProvider:        b.backendSGProvider,
 		sgResolver:               b.sgResolver,
@@ -145,17 +175,15 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
 	}
-
-	if err := task.run(ctx); err != nil {
-		return nil, nil, false, err
-	}
-	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
 }
 
 type defaultModelBuildTask struct {
+	k8sClient           client.Client
 	clusterName         string
 	vpcID               string
 	annotationParser    annotations.Parser
+	authConfigBuilder   ingress.AuthConfigBuilder
+	lbClassParamsLoader LoadBalancerClassParamsLoader
 	subnetsResolver     networking.SubnetsResolver
 	vpcInfoProvider     networking.VPCInfoProvider
 	backendSGProvider   networking.BackendSGProvider
@@ -168,10 +196,13 @@ type defaultModelBuildTask struct {
 	ec2Client           services.EC2
 	logger              logr.Logger
 
-	service *corev1.Service
+	service       *corev1.Service
+	lbClassParams *elbv2api.LoadBalancerClassParams
 
 	stack                    core.Stack
+	loadBalancerType         elbv2model.LoadBalancerType
 	loadBalancer             *elbv2model.LoadBalancer
+	vpcEndpointService       *ec2model.VPCEndpointService
 	tgByResID                map[string]*elbv2model.TargetGroup
 	ec2Subnets               []*ec2.Subnet
 	enableBackendSG          bool
@@ -182,6 +213,8 @@ type defaultModelBuildTask struct {
 
 	fetchExistingLoadBalancerOnce sync.Once
 	existingLoadBalancer          *elbv2deploy.LoadBalancerWithTags
+	loadBalancerAdoption          *elbv2model.LoadBalancerAdoption
+	adoptedLoadBalancer           *elbv2deploy.LoadBalancerWithTags
 
 	defaultTags                          map[string]string
 	externalManagedTags                  sets.String
@@ -233,6 +266,12 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 }
 
 func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
+	if err := t.buildLoadBalancerTypeAndClassParams(ctx); err != nil {
+		return err
+	}
+	if err := t.buildLoadBalancerAdoption(ctx); err != nil {
+		return err
+	}
 	scheme, err := t.buildLoadBalancerScheme(ctx)
 	if err != nil {
 		return err
@@ -249,6 +288,105 @@ func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = t.buildVPCEndpointService(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// buildLoadBalancerTypeAndClassParams determines the load balancer type and loads the LoadBalancerClassParams for service.
+func (t *defaultModelBuildTask) buildLoadBalancerTypeAndClassParams(ctx context.Context) error {
+	t.loadBalancerType = elbv2model.LoadBalancerTypeNetwork
+	if t.serviceUtils.IsALBService(t.service) {
+		t.loadBalancerType = elbv2model.LoadBalancerTypeApplication
+	}
+	lbClassParams, err := t.lbClassParamsLoader.Load(ctx, t.service)
+	if err != nil {
+		return err
+	}
+	t.lbClassParams = lbClassParams
+	return nil
+}
+
+// validate runs the checks of buildModel that don't depend on AWS resources, i.e. subnets, security groups and the existing load balancer.
+// it shares the parsing steps with buildModel, but skips the steps that resolve or create resources.
+// checks that depend on the load balancer scheme are skipped unless the scheme is configured explicitly,
+// and the count of subnet mappings is only checked against subnets configured explicitly via annotation.
+func (t *defaultModelBuildTask) validate(ctx context.Context) error {
+	if !t.serviceUtils.IsServiceSupported(t.service) {
+		return nil
+	}
+	if err := t.buildLoadBalancerTypeAndClassParams(ctx); err != nil {
+		return err
+	}
+	if _, err := t.buildLoadBalancerAdoptionConfig(ctx); err != nil {
+		return err
+	}
+	scheme, schemeKnown, err := t.buildLoadBalancerExplicitScheme(ctx)
+	if err != nil {
+		return err
+	}
+	if err := t.validateLoadBalancer(ctx, scheme, schemeKnown); err != nil {
+		return err
+	}
+	return t.validateListeners(ctx)
+}
+
+func (t *defaultModelBuildTask) validateLoadBalancer(ctx context.Context, scheme elbv2model.LoadBalancerScheme, schemeKnown bool) error {
+	lbCfg, err := t.buildLoadBalancerConfig(ctx, scheme)
+	if err != nil {
+		return err
+	}
+	// the TargetGroup settings depend on the load balancer's IP address type.
+	t.loadBalancer = elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, elbv2model.LoadBalancerSpec{
+		IPAddressType: &lbCfg.ipAddressType,
+	})
+	if _, err := t.buildManageSecurityGroupRulesFlag(ctx); err != nil {
+		return err
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication || !schemeKnown {
+		return nil
+	}
+	subnetMappingCfg, err := t.buildSubnetMappingConfig(ctx, lbCfg.ipAddressType, scheme)
+	if err != nil {
+		return err
+	}
+	var rawSubnetNameOrIDs []string
+	if t.loadBalancerClassEnforced().Subnets == nil &&
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations) {
+		return subnetMappingCfg.validateSubnetCount(len(rawSubnetNameOrIDs))
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) validateListeners(ctx context.Context) error {
+	cfg, err := t.buildListenerConfig(ctx)
+	if err != nil {
+		return err
+	}
+	listenerPorts, err := mergeServicePortsForListeners(t.service.Spec.Ports)
+	if err != nil {
+		return err
+	}
+	for _, port := range listenerPorts {
+		listenerProtocol, tgProtocol, err := t.buildListenerProtocolsForPort(port, *cfg)
+		if err != nil {
+			return err
+		}
+		tgCfg, err := t.buildTargetGroupConfig(ctx, port)
+		if err != nil {
+			return err
+		}
+		if err := t.validateListenerTargetType(listenerProtocol, tgCfg.targetType); err != nil {
+			return err
+		}
+		if t.loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
+			if _, err := t.buildListenerALPNPolicy(ctx, listenerProtocol, tgProtocol); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
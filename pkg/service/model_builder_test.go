@@ -14,7 +14,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -22,6 +25,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -268,6 +272,136 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
     }
  }
 }
+`,
+		},
+		{
+			testName: "Service with alb target type",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-alb-svc",
+					Namespace: "default",
+					UID:       "bdca2bd0-bfc6-449a-88a3-03451f05f18c",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":                     "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type":          "alb",
+						"service.beta.kubernetes.io/aws-load-balancer-alb-target-ingress-group": "awesome-group",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			listLoadBalancerCalls:    []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			wantError:                false,
+			wantNumResources:         4,
+			featureGates: map[config.Feature]bool{
+				config.NLBSecurityGroup: false,
+			},
+			wantValue: `
+{
+ "id":"default/nlb-alb-svc",
+ "resources":{
+    "AWS::ElasticLoadBalancingV2::Listener":{
+       "80":{
+          "spec":{
+             "loadBalancerARN":{
+                "$ref":"#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+             },
+             "port":80,
+             "protocol":"TCP",
+             "defaultActions":[
+                {
+                   "type":"forward",
+                   "forwardConfig":{
+                      "targetGroups":[
+                         {
+                            "targetGroupARN":{
+                               "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-alb-svc:80/status/targetGroupARN"
+                            }
+                         }
+                      ]
+                   }
+                }
+             ]
+          }
+       }
+    },
+    "AWS::ElasticLoadBalancingV2::LoadBalancer":{
+       "LoadBalancer":{
+          "spec":{
+             "name":"k8s-default-nlbalbsv-6b0ba8ff70",
+             "type":"network",
+             "scheme":"internal",
+             "ipAddressType":"ipv4",
+             "subnetMapping":[
+                {
+                   "subnetID":"subnet-1"
+                }
+             ]
+          }
+       }
+    },
+    "AWS::ElasticLoadBalancingV2::TargetGroup":{
+       "default/nlb-alb-svc:80":{
+          "spec":{
+             "name":"k8s-default-nlbalbsv-8f62cbf95f",
+             "targetType":"alb",
+             "ipAddressType":"ipv4",
+             "port":80,
+             "protocol":"TCP",
+             "healthCheckConfig":{
+                "port":"traffic-port",
+                "protocol":"HTTP",
+                "path":"/",
+                "matcher":{
+                   "httpCode":"200-399"
+                },
+                "intervalSeconds":10,
+                "timeoutSeconds":10,
+                "healthyThresholdCount":3,
+                "unhealthyThresholdCount":3
+             }
+          }
+       }
+    },
+    "K8S::ElasticLoadBalancingV2::TargetGroupBinding":{
+       "default/nlb-alb-svc:80":{
+          "spec":{
+             "template":{
+                "metadata":{
+                   "name":"k8s-default-nlbalbsv-8f62cbf95f",
+                   "namespace":"default",
+                   "creationTimestamp":null
+                },
+                "spec":{
+                   "targetGroupARN":{
+                      "$ref":"#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-alb-svc:80/status/targetGroupARN"
+                   },
+                   "targetType":"alb",
+                   "ipAddressType":"ipv4",
+                   "vpcID": "vpc-xxx",
+                   "serviceRef":{
+                      "name":"",
+                      "port":0
+                   },
+                   "albTargetRef":{
+                      "ingressGroupName":"awesome-group"
+                   }
+                }
+             }
+          }
+       }
+    }
+ }
+}
 `,
 		},
 		{
@@ -6439,7 +6573,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			for _, call := range tt.fetchVPCInfoCalls {
 				vpcInfoProvider.EXPECT().FetchVPCInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.wantVPCInfo, call.err).AnyTimes()
 			}
-			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", featureGates)
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", "service.k8s.aws/alb", featureGates)
 			defaultTargetType := tt.defaultTargetType
 			if defaultTargetType == "" {
 				defaultTargetType = "instance"
@@ -6459,7 +6593,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			} else {
 				enableIPTargetType = *tt.enableIPTargetType
 			}
-			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, "vpc-xxx", trackingProvider, elbv2TaggingManager, ec2Client, featureGates,
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			builder := NewDefaultModelBuilder(k8sClient, annotationParser, subnetsResolver, vpcInfoProvider, "vpc-xxx", trackingProvider, elbv2TaggingManager, ec2Client, featureGates,
 				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", defaultTargetType, enableIPTargetType, serviceUtils,
 				backendSGProvider, sgResolver, tt.enableBackendSG, tt.disableRestrictedSGRules, logr.New(&log.NullLogSink{}))
 			ctx := context.Background()
@@ -6479,3 +6617,140 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilder_Validate(t *testing.T) {
+	newService := func(annotations map[string]string) *corev1.Service {
+		svcAnnotations := map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+			"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+		}
+		for key, value := range annotations {
+			svcAnnotations[key] = value
+		}
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "nlb-svc",
+				Annotations: svcAnnotations,
+			},
+			Spec: corev1.ServiceSpec{
+				Type: corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "https",
+						Port:       443,
+						TargetPort: intstr.FromInt(8443),
+						Protocol:   corev1.ProtocolTCP,
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		wantErr error
+	}{
+		{
+			name: "valid settings",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                  "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                 "subnet-1,subnet-2",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations":         "eipalloc-1,eipalloc-2",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":                "cert-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":               "https",
+				"service.beta.kubernetes.io/aws-load-balancer-alpn-policy":             "HTTP2Preferred",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled=true",
+			}),
+		},
+		{
+			name: "unsupported service",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "clb-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+				},
+			},
+		},
+		{
+			name: "EIP allocations and subnets count mismatch",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":         "subnet-1,subnet-2",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1",
+			}),
+			wantErr: errors.New("count of EIP allocations (1) and subnets (2) must match"),
+		},
+		{
+			name: "EIP allocations for internal load balancer",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eipalloc-1",
+			}),
+			wantErr: errors.New("EIP allocations can only be set for internet facing load balancers"),
+		},
+		{
+			name: "invalid private IPv4 addresses",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                 "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-private-ipv4-addresses": "192.168.1.300",
+			}),
+			wantErr: errors.New("private IPv4 addresses must be valid IP address: 192.168.1.300"),
+		},
+		{
+			name: "unused ssl ports",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":  "cert-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "https, 8443",
+			}),
+			wantErr: errors.New("Unused port in ssl-ports annotation [8443]"),
+		},
+		{
+			name: "malformed target group attributes",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "preserve_client_ip.enabled",
+			}),
+			wantErr: errors.New("failed to parse stringMap annotation, service.beta.kubernetes.io/aws-load-balancer-target-group-attributes: preserve_client_ip.enabled"),
+		},
+		{
+			name: "invalid ALPN policy",
+			svc: newService(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":    "cert-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-alpn-policy": "HTTP3",
+			}),
+			wantErr: errors.New("invalid ALPN policy HTTP3, policy must be one of [None, HTTP1Only, HTTP2Only, HTTP2Optional, HTTP2Preferred]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			featureGates := config.NewFeatureGates()
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", "service.k8s.aws/alb", featureGates)
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			// the AWS dependencies are mocks without expectations, Validate must not call into AWS.
+			builder := NewDefaultModelBuilder(k8sClient, annotationParser, networking.NewMockSubnetsResolver(ctrl), networking.NewMockVPCInfoProvider(ctrl),
+				"vpc-xxx", trackingProvider, elbv2.NewMockTaggingManager(ctrl), services.NewMockEC2(ctrl), featureGates,
+				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", "instance", true, serviceUtils,
+				networking.NewMockBackendSGProvider(ctrl), networking.NewMockSecurityGroupResolver(ctrl), true, false, logr.New(&log.NullLogSink{}))
+			err := builder.Validate(context.Background(), tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
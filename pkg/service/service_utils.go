@@ -14,25 +14,30 @@ type ServiceUtils interface {
 
 	// IsServicePendingFinalization returns true if the service contains the aws-load-balancer-controller finalizer
 	IsServicePendingFinalization(service *corev1.Service) bool
+
+	// IsALBService returns true if the service requests an Application Load Balancer via its loadBalancerClass
+	IsALBService(service *corev1.Service) bool
 }
 
 func NewServiceUtils(annotationsParser annotations.Parser, serviceFinalizer string, loadBalancerClass string,
-	featureGates config.FeatureGates) *defaultServiceUtils {
+	albLoadBalancerClass string, featureGates config.FeatureGates) *defaultServiceUtils {
 	return &defaultServiceUtils{
-		annotationParser:  annotationsParser,
-		serviceFinalizer:  serviceFinalizer,
-		loadBalancerClass: loadBalancerClass,
-		featureGates:      featureGates,
+		annotationParser:     annotationsParser,
+		serviceFinalizer:     serviceFinalizer,
+		loadBalancerClass:    loadBalancerClass,
+		albLoadBalancerClass: albLoadBalancerClass,
+		featureGates:         featureGates,
 	}
 }
 
 var _ ServiceUtils = (*defaultServiceUtils)(nil)
 
 type defaultServiceUtils struct {
-	annotationParser  annotations.Parser
-	serviceFinalizer  string
-	loadBalancerClass string
-	featureGates      config.FeatureGates
+	annotationParser     annotations.Parser
+	serviceFinalizer     string
+	loadBalancerClass    string
+	albLoadBalancerClass string
+	featureGates         config.FeatureGates
 }
 
 // IsServicePendingFinalization returns true if service has the aws-load-balancer-controller finalizer
@@ -52,7 +57,7 @@ func (u *defaultServiceUtils) IsServiceSupported(service *corev1.Service) bool {
 		return false
 	}
 	if service.Spec.LoadBalancerClass != nil {
-		if *service.Spec.LoadBalancerClass == u.loadBalancerClass {
+		if *service.Spec.LoadBalancerClass == u.loadBalancerClass || u.IsALBService(service) {
 			return true
 		} else {
 			return false
@@ -61,6 +66,12 @@ func (u *defaultServiceUtils) IsServiceSupported(service *corev1.Service) bool {
 	return u.checkAWSLoadBalancerTypeAnnotation(service)
 }
 
+// IsALBService returns true if the service requests an Application Load Balancer via its loadBalancerClass
+func (u *defaultServiceUtils) IsALBService(service *corev1.Service) bool {
+	return u.albLoadBalancerClass != "" && service.Spec.LoadBalancerClass != nil &&
+		*service.Spec.LoadBalancerClass == u.albLoadBalancerClass
+}
+
 func (u *defaultServiceUtils) checkAWSLoadBalancerTypeAnnotation(service *corev1.Service) bool {
 	lbType := ""
 	_ = u.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, service.Annotations)
@@ -70,7 +81,7 @@ func (u *defaultServiceUtils) checkAWSLoadBalancerTypeAnnotation(service *corev1
 	var lbTargetType string
 	_ = u.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, service.Annotations)
 	if lbType == LoadBalancerTypeExternal && (lbTargetType == LoadBalancerTargetTypeIP ||
-		lbTargetType == LoadBalancerTargetTypeInstance) {
+		lbTargetType == LoadBalancerTargetTypeInstance || lbTargetType == LoadBalancerTargetTypeALB) {
 		return true
 	}
 	return false
@@ -216,6 +216,42 @@ func Test_defaultServiceUtils_IsServiceSupported(t *testing.T) {
 			restrictToTypeLoadBalancer: true,
 			want:                       true,
 		},
+		{
+			name: "spec.loadBalancerClass for application load balancer",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "alb-ip",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("service.k8s.aws/alb"),
+					Selector:          map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "spec.loadBalancerClass of other controller",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("other.k8s.io/lb"),
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -224,7 +260,7 @@ func Test_defaultServiceUtils_IsServiceSupported(t *testing.T) {
 			if tt.restrictToTypeLoadBalancer {
 				featureGates.Enable(config.ServiceTypeLoadBalancerOnly)
 			}
-			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", featureGates)
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", "service.k8s.aws/alb", featureGates)
 			got := serviceUtils.IsServiceSupported(tt.svc)
 			assert.Equal(t, tt.want, got)
 		})
@@ -273,9 +309,50 @@ func Test_defaultServiceUtils_IsServicePendingFinalization(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			featureGates := config.NewFeatureGates()
-			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", featureGates)
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", "service.k8s.aws/alb", featureGates)
 			got := serviceUtils.IsServicePendingFinalization(tt.svc)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func Test_defaultServiceUtils_IsALBService(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want bool
+	}{
+		{
+			name: "service without loadBalancerClass",
+			svc:  &corev1.Service{},
+			want: false,
+		},
+		{
+			name: "service with nlb loadBalancerClass",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "service with alb loadBalancerClass",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerClass: awssdk.String("service.k8s.aws/alb"),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			featureGates := config.NewFeatureGates()
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", "service.k8s.aws/alb", featureGates)
+			got := serviceUtils.IsALBService(tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
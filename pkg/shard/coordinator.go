@@ -0,0 +1,414 @@
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// renewIntervalDivisor divides the lease duration into the interval between renewals of Leases.
+	renewIntervalDivisor = 3
+	// drainPollInterval is the interval to check whether claims on shards are released when shutting down.
+	drainPollInterval = 100 * time.Millisecond
+)
+
+// ShardsAcquiredHandler is invoked whenever the replica acquires shards,
+// so that the objects of acquired shards can be reconciled.
+type ShardsAcquiredHandler func(ctx context.Context)
+
+// ShardsLostHandler is invoked whenever the replica stops owning shards,
+// so that the state kept for objects of lost shards can be dropped.
+type ShardsLostHandler func(ctx context.Context)
+
+// Coordinator coordinates the ownership of shards among controller replicas.
+type Coordinator interface {
+	// Owns returns whether this replica owns the shard that key is hashed into.
+	Owns(key string) bool
+
+	// Claim claims the shard that key is hashed into for a reconcile if this replica owns it.
+	// release must be invoked once the reconcile finishes, shards are only handed over to other replicas when they're not claimed.
+	Claim(key string) (release func(), owned bool)
+
+	// AddShardsAcquiredHandler registers handler to be invoked whenever this replica acquires shards.
+	AddShardsAcquiredHandler(handler ShardsAcquiredHandler)
+
+	// AddShardsLostHandler registers handler to be invoked whenever this replica stops owning shards.
+	AddShardsLostHandler(handler ShardsLostHandler)
+}
+
+// NewDefaultCoordinator constructs new defaultCoordinator.
+// identity identifies this replica among controller replicas, and leaseNamePrefix prefixes the name of Leases.
+func NewDefaultCoordinator(k8sClient client.Client, apiReader client.Reader, shardingConfig config.ShardingConfig,
+	leaseNamePrefix string, identity string, logger logr.Logger) *defaultCoordinator {
+	return &defaultCoordinator{
+		k8sClient:       k8sClient,
+		apiReader:       apiReader,
+		shardingConfig:  shardingConfig,
+		leaseNamePrefix: leaseNamePrefix,
+		identity:        identity,
+		clock:           clock.RealClock{},
+		logger:          logger,
+		ownedShards:     make(map[int]time.Time),
+		claims:          make(map[int]int),
+	}
+}
+
+var _ Coordinator = &defaultCoordinator{}
+var _ manager.Runnable = &defaultCoordinator{}
+var _ manager.LeaderElectionRunnable = &defaultCoordinator{}
+
+// default implementation for Coordinator.
+// each shard is owned by the holder of its shard Lease, and each replica announces itself via its replica Lease.
+// a replica holds at most ceil(shardCount / liveReplicas) shards, and releases the excess shards when replicas join,
+// so that they can be acquired by the new replicas. shards of departed replicas are acquired once their Leases expire.
+// an excess shard stops being owned first, and its Lease is only released once reconciles that claimed it have finished,
+// so that the same objects are never reconciled by two replicas concurrently.
+type defaultCoordinator struct {
+	k8sClient       client.Client
+	apiReader       client.Reader
+	shardingConfig  config.ShardingConfig
+	leaseNamePrefix string
+	identity        string
+	clock           clock.Clock
+	logger          logr.Logger
+
+	mutex sync.RWMutex
+	// ownedShards tracks the shards owned by this replica, and until when they're owned without renewal.
+	ownedShards map[int]time.Time
+	// claims tracks the number of unreleased claims on each shard.
+	claims           map[int]int
+	acquiredHandlers []ShardsAcquiredHandler
+	lostHandlers     []ShardsLostHandler
+}
+
+func (c *defaultCoordinator) Owns(key string) bool {
+	shard := ShardOf(key, c.shardingConfig.ShardCount)
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.ownsShard(shard)
+}
+
+func (c *defaultCoordinator) Claim(key string) (func(), bool) {
+	shard := ShardOf(key, c.shardingConfig.ShardCount)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.ownsShard(shard) {
+		return nil, false
+	}
+	c.claims[shard]++
+	var releaseOnce sync.Once
+	return func() {
+		releaseOnce.Do(func() {
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+			c.claims[shard]--
+			if c.claims[shard] == 0 {
+				delete(c.claims, shard)
+			}
+		})
+	}, true
+}
+
+func (c *defaultCoordinator) AddShardsAcquiredHandler(handler ShardsAcquiredHandler) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.acquiredHandlers = append(c.acquiredHandlers, handler)
+}
+
+func (c *defaultCoordinator) AddShardsLostHandler(handler ShardsLostHandler) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lostHandlers = append(c.lostHandlers, handler)
+}
+
+// ownsShard checks whether this replica owns shard, mutex must be held by caller.
+func (c *defaultCoordinator) ownsShard(shard int) bool {
+	ownedUntil, ok := c.ownedShards[shard]
+	return ok && c.clock.Now().Before(ownedUntil)
+}
+
+// Start coordinates the ownership of shards periodically until ctx is done, then releases the owned shards.
+func (c *defaultCoordinator) Start(ctx context.Context) error {
+	c.logger.Info("starting shard coordinator",
+		"shardCount", c.shardingConfig.ShardCount,
+		"identity", c.identity)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Coordinate(ctx); err != nil {
+			c.logger.Error(err, "failed to coordinate shards")
+		}
+	}, c.shardingConfig.LeaseDuration/renewIntervalDivisor)
+	c.release(context.Background())
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, the coordinator runs on all replicas.
+func (c *defaultCoordinator) NeedLeaderElection() bool {
+	return false
+}
+
+// Coordinate renews the replica Lease and the Leases of owned shards, acquires or releases shards to reach the fair share,
+// and notifies the handlers if shards are acquired.
+func (c *defaultCoordinator) Coordinate(ctx context.Context) error {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := c.apiReader.List(ctx, leaseList, client.InNamespace(c.shardingConfig.LeaseNamespace)); err != nil {
+		return err
+	}
+	now := c.clock.Now()
+	if err := c.renewReplicaLease(ctx, leaseList.Items, now); err != nil {
+		return err
+	}
+	liveReplicas := c.countLiveReplicas(ctx, leaseList.Items, now)
+	fairShare := (c.shardingConfig.ShardCount + liveReplicas - 1) / liveReplicas
+
+	shardLeaseByShard := make(map[int]coordinationv1.Lease)
+	for _, lease := range leaseList.Items {
+		if shard, ok := c.parseShardLeaseName(lease.Name); ok {
+			shardLeaseByShard[shard] = lease
+		}
+	}
+	var heldShards, freeShards []int
+	for shard := 0; shard < c.shardingConfig.ShardCount; shard++ {
+		lease, exists := shardLeaseByShard[shard]
+		switch {
+		case exists && pointer.StringDeref(lease.Spec.HolderIdentity, "") == c.identity:
+			heldShards = append(heldShards, shard)
+		case !exists || !isLeaseHeld(lease, now):
+			freeShards = append(freeShards, shard)
+		}
+	}
+
+	// excess shards stop being owned before their Leases are released, so that no new reconcile claims them meanwhile.
+	var excessShards []int
+	if len(heldShards) > fairShare {
+		excessShards = heldShards[fairShare:]
+		heldShards = heldShards[:fairShare]
+	}
+	var lostShards []int
+	c.mutex.Lock()
+	claimedExcessShards := make(map[int]bool)
+	for _, shard := range excessShards {
+		if _, ok := c.ownedShards[shard]; ok {
+			lostShards = append(lostShards, shard)
+		}
+		delete(c.ownedShards, shard)
+		claimedExcessShards[shard] = c.claims[shard] > 0
+	}
+	c.mutex.Unlock()
+	for _, shard := range excessShards {
+		lease := shardLeaseByShard[shard]
+		if claimedExcessShards[shard] {
+			// the Lease is kept held until the reconciles that claimed the shard have finished.
+			c.logger.V(1).Info("draining shard before release", "shard", shard)
+			if err := c.holdLease(ctx, &lease, now); err != nil {
+				c.logger.Error(err, "failed to renew draining shard", "shard", shard)
+			}
+			continue
+		}
+		if err := c.releaseLease(ctx, lease); err != nil {
+			c.logger.Error(err, "failed to release shard", "shard", shard)
+		}
+	}
+
+	ownedShards := make(map[int]time.Time)
+	var acquiredShards []int
+	for _, shard := range heldShards {
+		lease := shardLeaseByShard[shard]
+		if err := c.holdLease(ctx, &lease, now); err != nil {
+			c.logger.Error(err, "failed to renew shard", "shard", shard)
+			continue
+		}
+		ownedShards[shard] = now.Add(c.shardingConfig.LeaseDuration)
+	}
+	for _, shard := range freeShards {
+		if len(ownedShards) >= fairShare {
+			break
+		}
+		lease, exists := shardLeaseByShard[shard]
+		if !exists {
+			lease = c.buildLease(c.shardLeaseName(shard))
+		}
+		if err := c.holdLease(ctx, &lease, now); err != nil {
+			c.logger.Error(err, "failed to acquire shard", "shard", shard)
+			continue
+		}
+		ownedShards[shard] = now.Add(c.shardingConfig.LeaseDuration)
+		acquiredShards = append(acquiredShards, shard)
+	}
+
+	c.mutex.Lock()
+	for shard := range c.ownedShards {
+		if _, ok := ownedShards[shard]; !ok {
+			lostShards = append(lostShards, shard)
+		}
+	}
+	c.ownedShards = ownedShards
+	acquiredHandlers := append([]ShardsAcquiredHandler(nil), c.acquiredHandlers...)
+	lostHandlers := append([]ShardsLostHandler(nil), c.lostHandlers...)
+	c.mutex.Unlock()
+	if len(acquiredShards) != 0 {
+		c.logger.Info("acquired shards", "shards", acquiredShards, "liveReplicas", liveReplicas)
+		for _, handler := range acquiredHandlers {
+			go handler(ctx)
+		}
+	}
+	if len(lostShards) != 0 {
+		c.logger.Info("lost shards", "shards", lostShards, "liveReplicas", liveReplicas)
+		for _, handler := range lostHandlers {
+			go handler(ctx)
+		}
+	}
+	return nil
+}
+
+// renewReplicaLease announces this replica via its replica Lease.
+func (c *defaultCoordinator) renewReplicaLease(ctx context.Context, leases []coordinationv1.Lease, now time.Time) error {
+	replicaLeaseName := c.replicaLeaseName(c.identity)
+	for _, lease := range leases {
+		if lease.Name == replicaLeaseName {
+			return c.holdLease(ctx, &lease, now)
+		}
+	}
+	lease := c.buildLease(replicaLeaseName)
+	return c.holdLease(ctx, &lease, now)
+}
+
+// countLiveReplicas counts the replicas whose replica Lease is held, including this replica.
+// replica Leases of departed replicas are deleted once they expire.
+func (c *defaultCoordinator) countLiveReplicas(ctx context.Context, leases []coordinationv1.Lease, now time.Time) int {
+	liveReplicas := 1
+	replicaLeasePrefix := c.replicaLeaseName("")
+	for _, lease := range leases {
+		if !strings.HasPrefix(lease.Name, replicaLeasePrefix) || lease.Name == c.replicaLeaseName(c.identity) {
+			continue
+		}
+		if isLeaseHeld(lease, now) {
+			liveReplicas++
+			continue
+		}
+		if err := c.k8sClient.Delete(ctx, &lease, client.Preconditions{ResourceVersion: &lease.ResourceVersion}); client.IgnoreNotFound(err) != nil {
+			c.logger.V(1).Info("failed to delete expired replica lease", "lease", lease.Name, "error", err)
+		}
+	}
+	return liveReplicas
+}
+
+// holdLease creates or updates lease to be held by this replica since now.
+func (c *defaultCoordinator) holdLease(ctx context.Context, lease *coordinationv1.Lease, now time.Time) error {
+	renewTime := metav1.NewMicroTime(now)
+	if pointer.StringDeref(lease.Spec.HolderIdentity, "") != c.identity {
+		lease.Spec.HolderIdentity = pointer.String(c.identity)
+		lease.Spec.AcquireTime = &renewTime
+		if lease.ResourceVersion != "" {
+			lease.Spec.LeaseTransitions = pointer.Int32(pointer.Int32Deref(lease.Spec.LeaseTransitions, 0) + 1)
+		}
+	}
+	lease.Spec.LeaseDurationSeconds = pointer.Int32(int32(c.shardingConfig.LeaseDuration / time.Second))
+	lease.Spec.RenewTime = &renewTime
+	if lease.ResourceVersion == "" {
+		return c.k8sClient.Create(ctx, lease)
+	}
+	return c.k8sClient.Update(ctx, lease)
+}
+
+// releaseLease releases lease held by this replica, so that it can be acquired by other replicas immediately.
+func (c *defaultCoordinator) releaseLease(ctx context.Context, lease coordinationv1.Lease) error {
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	return c.k8sClient.Update(ctx, &lease)
+}
+
+// release releases all shards held by this replica and deletes its replica Lease.
+// shards stop being owned first, and their Leases are released once the claims on them are released,
+// or the lease duration passed, after which other replicas would acquire them anyway.
+func (c *defaultCoordinator) release(ctx context.Context) {
+	c.mutex.Lock()
+	c.ownedShards = make(map[int]time.Time)
+	c.mutex.Unlock()
+	drainCtx, cancel := context.WithTimeout(ctx, c.shardingConfig.LeaseDuration)
+	defer cancel()
+	_ = wait.PollImmediateUntilWithContext(drainCtx, drainPollInterval, func(_ context.Context) (bool, error) {
+		c.mutex.RLock()
+		defer c.mutex.RUnlock()
+		return len(c.claims) == 0, nil
+	})
+
+	leaseList := &coordinationv1.LeaseList{}
+	if err := c.apiReader.List(ctx, leaseList, client.InNamespace(c.shardingConfig.LeaseNamespace)); err != nil {
+		c.logger.Error(err, "failed to release shards")
+	}
+	for _, lease := range leaseList.Items {
+		shard, ok := c.parseShardLeaseName(lease.Name)
+		if !ok || pointer.StringDeref(lease.Spec.HolderIdentity, "") != c.identity {
+			continue
+		}
+		if err := c.releaseLease(ctx, lease); err != nil {
+			c.logger.Error(err, "failed to release shard", "shard", shard)
+		}
+	}
+	replicaLease := c.buildLease(c.replicaLeaseName(c.identity))
+	if err := c.k8sClient.Delete(ctx, &replicaLease); client.IgnoreNotFound(err) != nil && !apierrors.IsConflict(err) {
+		c.logger.Error(err, "failed to delete replica lease")
+	}
+}
+
+func (c *defaultCoordinator) buildLease(name string) coordinationv1.Lease {
+	return coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: c.shardingConfig.LeaseNamespace,
+			Name:      name,
+		},
+	}
+}
+
+func (c *defaultCoordinator) shardLeaseName(shard int) string {
+	return c.leaseNamePrefix + "-shard-" + strconv.Itoa(shard)
+}
+
+func (c *defaultCoordinator) replicaLeaseName(identity string) string {
+	return c.leaseNamePrefix + "-replica-" + identity
+}
+
+// parseShardLeaseName parses the shard from the name of shard Lease.
+func (c *defaultCoordinator) parseShardLeaseName(name string) (int, bool) {
+	shardLeasePrefix := c.leaseNamePrefix + "-shard-"
+	if !strings.HasPrefix(name, shardLeasePrefix) {
+		return 0, false
+	}
+	shard, err := strconv.Atoi(strings.TrimPrefix(name, shardLeasePrefix))
+	if err != nil || shard < 0 || shard >= c.shardingConfig.ShardCount {
+		return 0, false
+	}
+	return shard, true
+}
+
+// isLeaseHeld checks whether lease is held by any replica at now.
+func isLeaseHeld(lease coordinationv1.Lease, now time.Time) bool {
+	if pointer.StringDeref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return false
+	}
+	leaseDuration := time.Duration(pointer.Int32Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	return now.Before(lease.Spec.RenewTime.Add(leaseDuration))
+}
+
+// ShardOf returns the shard that key is hashed into.
+func ShardOf(key string, shardCount int) int {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(key))
+	return int(hasher.Sum32() % uint32(shardCount))
+}
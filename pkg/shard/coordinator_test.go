@@ -0,0 +1,308 @@
+package shard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_ShardOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		shardCount int
+	}{
+		{
+			name:       "implicit ingress group",
+			key:        "ns-1/ing-1",
+			shardCount: 4,
+		},
+		{
+			name:       "explicit ingress group",
+			key:        "my-group",
+			shardCount: 4,
+		},
+		{
+			name:       "single shard",
+			key:        "ns-1/svc-1",
+			shardCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShardOf(tt.key, tt.shardCount)
+			assert.True(t, got >= 0 && got < tt.shardCount)
+			assert.Equal(t, got, ShardOf(tt.key, tt.shardCount))
+		})
+	}
+}
+
+func Test_defaultCoordinator_Coordinate(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	renewTime := metav1.NewMicroTime(now.Add(-5 * time.Second))
+	expiredRenewTime := metav1.NewMicroTime(now.Add(-time.Minute))
+	buildLease := func(name string, holder string, renewTime metav1.MicroTime) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kube-system",
+				Name:      name,
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String(holder),
+				LeaseDurationSeconds: pointer.Int32(15),
+				RenewTime:            &renewTime,
+			},
+		}
+	}
+	tests := []struct {
+		name             string
+		existingLeases   []*coordinationv1.Lease
+		ownedShards      []int
+		claimedShards    []int
+		wantOwnedShards  []int
+		wantShardHolders map[int]string
+		wantAcquired     bool
+		wantLost         bool
+	}{
+		{
+			name:            "single replica acquires all shards",
+			wantOwnedShards: []int{0, 1, 2, 3},
+			wantShardHolders: map[int]string{
+				0: "replica-a",
+				1: "replica-a",
+				2: "replica-a",
+				3: "replica-a",
+			},
+			wantAcquired: true,
+		},
+		{
+			name: "replica releases excess shards when another replica joins",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-replica-replica-a", "replica-a", renewTime),
+				buildLease("lbc-replica-replica-b", "replica-b", renewTime),
+				buildLease("lbc-shard-0", "replica-a", renewTime),
+				buildLease("lbc-shard-1", "replica-a", renewTime),
+				buildLease("lbc-shard-2", "replica-a", renewTime),
+				buildLease("lbc-shard-3", "replica-a", renewTime),
+			},
+			ownedShards:     []int{0, 1, 2, 3},
+			wantOwnedShards: []int{0, 1},
+			wantShardHolders: map[int]string{
+				0: "replica-a",
+				1: "replica-a",
+				2: "",
+				3: "",
+			},
+			wantAcquired: false,
+			wantLost:     true,
+		},
+		{
+			name: "replica keeps holding excess shards until their claims are released",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-replica-replica-a", "replica-a", renewTime),
+				buildLease("lbc-replica-replica-b", "replica-b", renewTime),
+				buildLease("lbc-shard-0", "replica-a", renewTime),
+				buildLease("lbc-shard-1", "replica-a", renewTime),
+				buildLease("lbc-shard-2", "replica-a", renewTime),
+				buildLease("lbc-shard-3", "replica-a", renewTime),
+			},
+			ownedShards:     []int{0, 1, 2, 3},
+			claimedShards:   []int{3},
+			wantOwnedShards: []int{0, 1},
+			wantShardHolders: map[int]string{
+				0: "replica-a",
+				1: "replica-a",
+				2: "",
+				3: "replica-a",
+			},
+			wantAcquired: false,
+			wantLost:     true,
+		},
+		{
+			name: "replica acquires its fair share of free shards",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-replica-replica-b", "replica-b", renewTime),
+				buildLease("lbc-shard-0", "replica-b", renewTime),
+				buildLease("lbc-shard-1", "replica-b", renewTime),
+				buildLease("lbc-shard-2", "", renewTime),
+			},
+			wantOwnedShards: []int{2, 3},
+			wantShardHolders: map[int]string{
+				0: "replica-b",
+				1: "replica-b",
+				2: "replica-a",
+				3: "replica-a",
+			},
+			wantAcquired: true,
+		},
+		{
+			name: "replica acquires shards of departed replica once their leases expire",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-replica-replica-a", "replica-a", renewTime),
+				buildLease("lbc-replica-replica-b", "replica-b", expiredRenewTime),
+				buildLease("lbc-shard-0", "replica-a", renewTime),
+				buildLease("lbc-shard-1", "replica-a", renewTime),
+				buildLease("lbc-shard-2", "replica-b", expiredRenewTime),
+				buildLease("lbc-shard-3", "replica-b", expiredRenewTime),
+			},
+			wantOwnedShards: []int{0, 1, 2, 3},
+			wantShardHolders: map[int]string{
+				0: "replica-a",
+				1: "replica-a",
+				2: "replica-a",
+				3: "replica-a",
+			},
+			wantAcquired: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := fake.NewClientBuilder().WithScheme(k8sSchema).Build()
+			ctx := context.Background()
+			for _, lease := range tt.existingLeases {
+				assert.NoError(t, k8sClient.Create(ctx, lease.DeepCopy()))
+			}
+
+			c := NewDefaultCoordinator(k8sClient, k8sClient, config.ShardingConfig{
+				ShardCount:     4,
+				LeaseDuration:  15 * time.Second,
+				LeaseNamespace: "kube-system",
+			}, "lbc", "replica-a", log.Log)
+			c.clock = clocktesting.NewFakeClock(now)
+			for _, shard := range tt.ownedShards {
+				c.ownedShards[shard] = now.Add(time.Second)
+			}
+			for _, shard := range tt.claimedShards {
+				c.claims[shard] = 1
+			}
+			acquired := make(chan struct{}, 1)
+			c.AddShardsAcquiredHandler(func(_ context.Context) {
+				acquired <- struct{}{}
+			})
+			lost := make(chan struct{}, 1)
+			c.AddShardsLostHandler(func(_ context.Context) {
+				lost <- struct{}{}
+			})
+			assert.NoError(t, c.Coordinate(ctx))
+
+			var gotOwnedShards []int
+			for shard := 0; shard < 4; shard++ {
+				if _, ok := c.ownedShards[shard]; ok {
+					gotOwnedShards = append(gotOwnedShards, shard)
+				}
+			}
+			assert.Equal(t, tt.wantOwnedShards, gotOwnedShards)
+			for shard, wantHolder := range tt.wantShardHolders {
+				lease := &coordinationv1.Lease{}
+				assert.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: c.shardLeaseName(shard)}, lease))
+				assert.Equal(t, wantHolder, pointer.StringDeref(lease.Spec.HolderIdentity, ""))
+			}
+			replicaLease := &coordinationv1.Lease{}
+			assert.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "lbc-replica-replica-a"}, replicaLease))
+			assert.Equal(t, "replica-a", pointer.StringDeref(replicaLease.Spec.HolderIdentity, ""))
+			if tt.wantAcquired {
+				select {
+				case <-acquired:
+				case <-time.After(time.Second):
+					t.Error("expected shards acquired handler to be invoked")
+				}
+			}
+			if tt.wantLost {
+				select {
+				case <-lost:
+				case <-time.After(time.Second):
+					t.Error("expected shards lost handler to be invoked")
+				}
+			}
+		})
+	}
+}
+
+func Test_defaultCoordinator_Owns(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := "ns-1/svc-1"
+	shard := ShardOf(key, 4)
+	tests := []struct {
+		name        string
+		ownedShards map[int]time.Time
+		want        bool
+	}{
+		{
+			name:        "shard is owned",
+			ownedShards: map[int]time.Time{shard: now.Add(time.Second)},
+			want:        true,
+		},
+		{
+			name:        "shard is owned but not renewed in time",
+			ownedShards: map[int]time.Time{shard: now},
+			want:        false,
+		},
+		{
+			name:        "shard isn't owned",
+			ownedShards: map[int]time.Time{(shard + 1) % 4: now.Add(time.Second)},
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewDefaultCoordinator(nil, nil, config.ShardingConfig{ShardCount: 4}, "lbc", "replica-a", log.Log)
+			c.clock = clocktesting.NewFakeClock(now)
+			c.ownedShards = tt.ownedShards
+			assert.Equal(t, tt.want, c.Owns(key))
+		})
+	}
+}
+
+func Test_defaultCoordinator_Claim(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := "ns-1/svc-1"
+	shard := ShardOf(key, 4)
+	tests := []struct {
+		name        string
+		ownedShards map[int]time.Time
+		wantOwned   bool
+	}{
+		{
+			name:        "shard is owned",
+			ownedShards: map[int]time.Time{shard: now.Add(time.Second)},
+			wantOwned:   true,
+		},
+		{
+			name:        "shard isn't owned",
+			ownedShards: map[int]time.Time{(shard + 1) % 4: now.Add(time.Second)},
+			wantOwned:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewDefaultCoordinator(nil, nil, config.ShardingConfig{ShardCount: 4}, "lbc", "replica-a", log.Log)
+			c.clock = clocktesting.NewFakeClock(now)
+			c.ownedShards = tt.ownedShards
+			release, owned := c.Claim(key)
+			assert.Equal(t, tt.wantOwned, owned)
+			if !owned {
+				assert.Empty(t, c.claims)
+				return
+			}
+			_, _ = c.Claim(key)
+			assert.Equal(t, map[int]int{shard: 2}, c.claims)
+			release()
+			release()
+			assert.Equal(t, map[int]int{shard: 1}, c.claims)
+		})
+	}
+}
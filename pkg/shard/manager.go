@@ -0,0 +1,41 @@
+package shard
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// WithoutLeaderElection wraps mgr so that runnables added via it run on all replicas regardless of leader election,
+// e.g. controllers that only reconcile the shards owned by their replica.
+func WithoutLeaderElection(mgr manager.Manager) manager.Manager {
+	return &nonLeaderElectedManager{Manager: mgr}
+}
+
+// nonLeaderElectedManager adds runnables as nonLeaderElectedRunnable.
+type nonLeaderElectedManager struct {
+	manager.Manager
+}
+
+func (m *nonLeaderElectedManager) Add(runnable manager.Runnable) error {
+	// dependencies are set on runnable itself, as they're not injected through the wrapper.
+	if err := m.Manager.SetFields(runnable); err != nil {
+		return err
+	}
+	return m.Manager.Add(&nonLeaderElectedRunnable{runnable: runnable})
+}
+
+var _ manager.LeaderElectionRunnable = &nonLeaderElectedRunnable{}
+
+// nonLeaderElectedRunnable is a runnable that doesn't need leader election.
+type nonLeaderElectedRunnable struct {
+	runnable manager.Runnable
+}
+
+func (r *nonLeaderElectedRunnable) Start(ctx context.Context) error {
+	return r.runnable.Start(ctx)
+}
+
+func (r *nonLeaderElectedRunnable) NeedLeaderElection() bool {
+	return false
+}
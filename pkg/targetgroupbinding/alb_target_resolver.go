@@ -0,0 +1,130 @@
+package targetgroupbinding
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrALBTargetNotFound is an error that the ALB referenced by TargetGroupBinding cannot be found.
+var ErrALBTargetNotFound = errors.New("alb target not found")
+
+// IngressALBFinder finds the ALB provisioned for ingress stacks.
+type IngressALBFinder interface {
+	// FindALB returns the ALB provisioned for ingress stack, or nil if it isn't provisioned yet.
+	FindALB(ctx context.Context, stackID core.StackID) (*elbv2sdk.LoadBalancer, error)
+}
+
+// ALBTargetResolver resolves the ALB that should be registered as target for alb TargetType TargetGroupBinding.
+type ALBTargetResolver interface {
+	// ResolveALBTarget returns the ALB's target description for TargetGroupBinding.
+	// ErrALBTargetNotFound will be returned if the ALB isn't provisioned yet.
+	ResolveALBTarget(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2sdk.TargetDescription, error)
+}
+
+// NewDefaultALBTargetResolver constructs new defaultALBTargetResolver.
+func NewDefaultALBTargetResolver(k8sClient client.Client, elbv2Client services.ELBV2, albFinder IngressALBFinder) *defaultALBTargetResolver {
+	return &defaultALBTargetResolver{
+		k8sClient:        k8sClient,
+		elbv2Client:      elbv2Client,
+		albFinder:        albFinder,
+		annotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+	}
+}
+
+var _ ALBTargetResolver = &defaultALBTargetResolver{}
+
+// default implementation for ALBTargetResolver.
+type defaultALBTargetResolver struct {
+	k8sClient        client.Client
+	elbv2Client      services.ELBV2
+	albFinder        IngressALBFinder
+	annotationParser annotations.Parser
+}
+
+func (r *defaultALBTargetResolver) ResolveALBTarget(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2sdk.TargetDescription, error) {
+	if tgb.Spec.ALBTargetRef == nil {
+		return elbv2sdk.TargetDescription{}, errors.New("albTargetRef must be specified for alb targetType")
+	}
+	stackID, err := r.resolveIngressStackID(ctx, tgb.Namespace, *tgb.Spec.ALBTargetRef)
+	if err != nil {
+		return elbv2sdk.TargetDescription{}, err
+	}
+	lb, err := r.albFinder.FindALB(ctx, stackID)
+	if err != nil {
+		return elbv2sdk.TargetDescription{}, err
+	}
+	if lb == nil {
+		return elbv2sdk.TargetDescription{}, errors.Wrapf(ErrALBTargetNotFound, "ALB for ingress stack %v", stackID.String())
+	}
+	lbARN := awssdk.StringValue(lb.LoadBalancerArn)
+	tgPort, err := r.fetchTargetGroupPort(ctx, tgb.Spec.TargetGroupARN)
+	if err != nil {
+		return elbv2sdk.TargetDescription{}, err
+	}
+	if err := r.checkListenerPortCompatibility(ctx, lbARN, tgPort); err != nil {
+		return elbv2sdk.TargetDescription{}, err
+	}
+	return elbv2sdk.TargetDescription{
+		Id:   awssdk.String(lbARN),
+		Port: awssdk.Int64(tgPort),
+	}, nil
+}
+
+// resolveIngressStackID resolves the stackID of the ingress stack referenced by albTargetRef.
+func (r *defaultALBTargetResolver) resolveIngressStackID(ctx context.Context, tgbNamespace string, ref elbv2api.ALBTargetReference) (core.StackID, error) {
+	if ref.IngressGroupName != nil {
+		return core.StackID{Name: awssdk.StringValue(ref.IngressGroupName)}, nil
+	}
+	ingKey := types.NamespacedName{Namespace: tgbNamespace, Name: awssdk.StringValue(ref.IngressName)}
+	ing := &networking.Ingress{}
+	if err := r.k8sClient.Get(ctx, ingKey, ing); err != nil {
+		return core.StackID{}, err
+	}
+	groupName := ""
+	if exists := r.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); exists && groupName != "" {
+		return core.StackID{Name: groupName}, nil
+	}
+	return core.StackID(ingKey), nil
+}
+
+// fetchTargetGroupPort returns the port of targetGroup.
+func (r *defaultALBTargetResolver) fetchTargetGroupPort(ctx context.Context, tgARN string) (int64, error) {
+	tgs, err := r.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(tgs) != 1 {
+		return 0, errors.Errorf("expect exactly one targetGroup with ARN %v, got %v", tgARN, len(tgs))
+	}
+	return awssdk.Int64Value(tgs[0].Port), nil
+}
+
+// checkListenerPortCompatibility checks whether the ALB has a listener on the targetGroup's port.
+func (r *defaultALBTargetResolver) checkListenerPortCompatibility(ctx context.Context, lbARN string, tgPort int64) error {
+	listeners, err := r.elbv2Client.DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		LoadBalancerArn: awssdk.String(lbARN),
+	})
+	if err != nil {
+		return err
+	}
+	var listenerPorts []int64
+	for _, listener := range listeners {
+		if awssdk.Int64Value(listener.Port) == tgPort {
+			return nil
+		}
+		listenerPorts = append(listenerPorts, awssdk.Int64Value(listener.Port))
+	}
+	return errors.Errorf("targetGroup port %v doesn't match any listener port %v of ALB %v", tgPort, listenerPorts, lbARN)
+}
@@ -0,0 +1,243 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// stubIngressALBFinder finds the ALBs of ingress stacks from a static map.
+type stubIngressALBFinder struct {
+	albByStackID map[core.StackID]*elbv2sdk.LoadBalancer
+}
+
+func (f *stubIngressALBFinder) FindALB(_ context.Context, stackID core.StackID) (*elbv2sdk.LoadBalancer, error) {
+	return f.albByStackID[stackID], nil
+}
+
+func Test_defaultALBTargetResolver_ResolveALBTarget(t *testing.T) {
+	type describeTargetGroupsAsListCall struct {
+		resp []*elbv2sdk.TargetGroup
+		err  error
+	}
+	type describeListenersAsListCall struct {
+		resp []*elbv2sdk.Listener
+		err  error
+	}
+	type fields struct {
+		albByStackID                    map[core.StackID]*elbv2sdk.LoadBalancer
+		describeTargetGroupsAsListCalls []describeTargetGroupsAsListCall
+		describeListenersAsListCalls    []describeListenersAsListCall
+	}
+	albTargetType := elbv2api.TargetTypeALB
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-arn",
+			TargetType:     &albTargetType,
+			ALBTargetRef: &elbv2api.ALBTargetReference{
+				IngressGroupName: awssdk.String("awesome-group"),
+			},
+		},
+	}
+	alb := &elbv2sdk.LoadBalancer{
+		LoadBalancerArn: awssdk.String("lb-1"),
+		VpcId:           awssdk.String("vpc-1"),
+		Type:            awssdk.String("application"),
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		tgb     *elbv2api.TargetGroupBinding
+		want    elbv2sdk.TargetDescription
+		wantErr error
+	}{
+		{
+			name: "ALB found and listener port compatible",
+			fields: fields{
+				albByStackID: map[core.StackID]*elbv2sdk.LoadBalancer{
+					{Name: "awesome-group"}: alb,
+				},
+				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
+					{
+						resp: []*elbv2sdk.TargetGroup{
+							{
+								TargetGroupArn: awssdk.String("tg-arn"),
+								Port:           awssdk.Int64(443),
+							},
+						},
+					},
+				},
+				describeListenersAsListCalls: []describeListenersAsListCall{
+					{
+						resp: []*elbv2sdk.Listener{
+							{Port: awssdk.Int64(80)},
+							{Port: awssdk.Int64(443)},
+						},
+					},
+				},
+			},
+			tgb: tgb,
+			want: elbv2sdk.TargetDescription{
+				Id:   awssdk.String("lb-1"),
+				Port: awssdk.Int64(443),
+			},
+		},
+		{
+			name: "ALB not found",
+			fields: fields{
+				albByStackID: map[core.StackID]*elbv2sdk.LoadBalancer{
+					{Name: "other-group"}: alb,
+				},
+			},
+			tgb:     tgb,
+			wantErr: errors.New("ALB for ingress stack awesome-group: alb target not found"),
+		},
+		{
+			name: "listener port incompatible",
+			fields: fields{
+				albByStackID: map[core.StackID]*elbv2sdk.LoadBalancer{
+					{Name: "awesome-group"}: alb,
+				},
+				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
+					{
+						resp: []*elbv2sdk.TargetGroup{
+							{
+								TargetGroupArn: awssdk.String("tg-arn"),
+								Port:           awssdk.Int64(8080),
+							},
+						},
+					},
+				},
+				describeListenersAsListCalls: []describeListenersAsListCall{
+					{
+						resp: []*elbv2sdk.Listener{
+							{Port: awssdk.Int64(80)},
+						},
+					},
+				},
+			},
+			tgb:     tgb,
+			wantErr: errors.New("targetGroup port 8080 doesn't match any listener port [80] of ALB lb-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeTargetGroupsAsListCalls {
+				elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), gomock.Any()).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.describeListenersAsListCalls {
+				elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), gomock.Any()).Return(call.resp, call.err)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			albFinder := &stubIngressALBFinder{albByStackID: tt.fields.albByStackID}
+			r := NewDefaultALBTargetResolver(k8sClient, elbv2Client, albFinder)
+			got, err := r.ResolveALBTarget(context.Background(), tt.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultALBTargetResolver_resolveIngressStackID(t *testing.T) {
+	tests := []struct {
+		name    string
+		ings    []*networking.Ingress
+		ref     elbv2api.ALBTargetReference
+		want    core.StackID
+		wantErr error
+	}{
+		{
+			name: "explicit IngressGroup",
+			ref: elbv2api.ALBTargetReference{
+				IngressGroupName: awssdk.String("awesome-group"),
+			},
+			want: core.StackID{Name: "awesome-group"},
+		},
+		{
+			name: "Ingress within explicit IngressGroup",
+			ings: []*networking.Ingress{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "awesome-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/group.name": "awesome-group",
+						},
+					},
+				},
+			},
+			ref: elbv2api.ALBTargetReference{
+				IngressName: awssdk.String("awesome-ing"),
+			},
+			want: core.StackID{Name: "awesome-group"},
+		},
+		{
+			name: "Ingress within implicit IngressGroup",
+			ings: []*networking.Ingress{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "awesome-ing",
+					},
+				},
+			},
+			ref: elbv2api.ALBTargetReference{
+				IngressName: awssdk.String("awesome-ing"),
+			},
+			want: core.StackID{Namespace: "awesome-ns", Name: "awesome-ing"},
+		},
+		{
+			name: "Ingress not found",
+			ref: elbv2api.ALBTargetReference{
+				IngressName: awssdk.String("awesome-ing"),
+			},
+			wantErr: errors.New("ingresses.networking.k8s.io \"awesome-ing\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, ing := range tt.ings {
+				assert.NoError(t, k8sClient.Create(context.Background(), ing.DeepCopy()))
+			}
+			r := NewDefaultALBTargetResolver(k8sClient, nil, nil)
+			got, err := r.resolveIngressStackID(context.Background(), "awesome-ns", tt.ref)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package targetgroupbinding
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AssumeRoleGrantChecker checks whether TargetGroupBindings are permitted to assume IAM roles by AssumeRoleGrants.
+type AssumeRoleGrantChecker interface {
+	// CheckAssumeRole checks whether tgb is permitted to assume the IAM role it specifies.
+	// TargetGroupBindings without IAM role to assume are always permitted.
+	CheckAssumeRole(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+}
+
+// NewDefaultAssumeRoleGrantChecker constructs new defaultAssumeRoleGrantChecker.
+func NewDefaultAssumeRoleGrantChecker(k8sClient client.Client) *defaultAssumeRoleGrantChecker {
+	return &defaultAssumeRoleGrantChecker{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ AssumeRoleGrantChecker = &defaultAssumeRoleGrantChecker{}
+
+// default implementation for AssumeRoleGrantChecker
+type defaultAssumeRoleGrantChecker struct {
+	k8sClient client.Client
+}
+
+func (c *defaultAssumeRoleGrantChecker) CheckAssumeRole(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if len(tgb.Spec.IAMRoleARNToAssume) == 0 {
+		return nil
+	}
+	grantList := &elbv2api.AssumeRoleGrantList{}
+	if err := c.k8sClient.List(ctx, grantList); err != nil {
+		return errors.Wrap(err, "failed to list AssumeRoleGrants")
+	}
+	var nsLabels labels.Set
+	nsLabelsLoaded := false
+	for _, grant := range grantList.Items {
+		if grant.Spec.IAMRoleARN != tgb.Spec.IAMRoleARNToAssume {
+			continue
+		}
+		if grant.Spec.AssumeRoleExternalID != "" && grant.Spec.AssumeRoleExternalID != tgb.Spec.AssumeRoleExternalID {
+			continue
+		}
+		if !nsLabelsLoaded {
+			nsObj := &corev1.Namespace{}
+			if err := c.k8sClient.Get(ctx, types.NamespacedName{Name: tgb.Namespace}, nsObj); err != nil {
+				return errors.Wrapf(err, "failed to get namespace %v", tgb.Namespace)
+			}
+			nsLabels = nsObj.Labels
+			nsLabelsLoaded = true
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&grant.Spec.NamespaceSelector)
+		if err != nil {
+			return errors.Wrapf(err, "invalid namespaceSelector in AssumeRoleGrant %v", grant.Name)
+		}
+		if selector.Matches(nsLabels) {
+			return nil
+		}
+	}
+	return errors.Errorf("IAM role %v is not permitted to be assumed in namespace %v by any AssumeRoleGrant",
+		tgb.Spec.IAMRoleARNToAssume, tgb.Namespace)
+}
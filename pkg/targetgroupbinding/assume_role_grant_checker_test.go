@@ -0,0 +1,153 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultAssumeRoleGrantChecker_CheckAssumeRole(t *testing.T) {
+	teamNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-ns",
+			Labels: map[string]string{"team": "payments"},
+		},
+	}
+	otherNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "other-ns",
+		},
+	}
+	grantTeam := &elbv2api.AssumeRoleGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "payments",
+		},
+		Spec: elbv2api.AssumeRoleGrantSpec{
+			IAMRoleARN: "arn:aws:iam::123456789012:role/payments",
+			NamespaceSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "payments"},
+			},
+		},
+	}
+	grantAllWithExternalID := &elbv2api.AssumeRoleGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "shared",
+		},
+		Spec: elbv2api.AssumeRoleGrantSpec{
+			IAMRoleARN:           "arn:aws:iam::123456789012:role/shared",
+			AssumeRoleExternalID: "external-id",
+		},
+	}
+	type env struct {
+		grants []*elbv2api.AssumeRoleGrant
+	}
+	type args struct {
+		namespace  string
+		roleARN    string
+		externalID string
+	}
+	tests := []struct {
+		name    string
+		env     env
+		args    args
+		wantErr error
+	}{
+		{
+			name: "without IAM role is always permitted",
+			args: args{
+				namespace: "other-ns",
+			},
+		},
+		{
+			name: "no AssumeRoleGrant",
+			args: args{
+				namespace: "team-ns",
+				roleARN:   "arn:aws:iam::123456789012:role/payments",
+			},
+			wantErr: errors.New("IAM role arn:aws:iam::123456789012:role/payments is not permitted to be assumed in namespace team-ns by any AssumeRoleGrant"),
+		},
+		{
+			name: "AssumeRoleGrant selects namespace",
+			env: env{
+				grants: []*elbv2api.AssumeRoleGrant{grantTeam, grantAllWithExternalID},
+			},
+			args: args{
+				namespace: "team-ns",
+				roleARN:   "arn:aws:iam::123456789012:role/payments",
+			},
+		},
+		{
+			name: "AssumeRoleGrant doesn't select namespace",
+			env: env{
+				grants: []*elbv2api.AssumeRoleGrant{grantTeam, grantAllWithExternalID},
+			},
+			args: args{
+				namespace: "other-ns",
+				roleARN:   "arn:aws:iam::123456789012:role/payments",
+			},
+			wantErr: errors.New("IAM role arn:aws:iam::123456789012:role/payments is not permitted to be assumed in namespace other-ns by any AssumeRoleGrant"),
+		},
+		{
+			name: "AssumeRoleGrant with empty namespaceSelector and matching external ID",
+			env: env{
+				grants: []*elbv2api.AssumeRoleGrant{grantTeam, grantAllWithExternalID},
+			},
+			args: args{
+				namespace:  "other-ns",
+				roleARN:    "arn:aws:iam::123456789012:role/shared",
+				externalID: "external-id",
+			},
+		},
+		{
+			name: "AssumeRoleGrant with mismatched external ID",
+			env: env{
+				grants: []*elbv2api.AssumeRoleGrant{grantTeam, grantAllWithExternalID},
+			},
+			args: args{
+				namespace:  "other-ns",
+				roleARN:    "arn:aws:iam::123456789012:role/shared",
+				externalID: "other-external-id",
+			},
+			wantErr: errors.New("IAM role arn:aws:iam::123456789012:role/shared is not permitted to be assumed in namespace other-ns by any AssumeRoleGrant"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			assert.NoError(t, k8sClient.Create(ctx, teamNS.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, otherNS.DeepCopy()))
+			for _, grant := range tt.env.grants {
+				assert.NoError(t, k8sClient.Create(ctx, grant.DeepCopy()))
+			}
+
+			c := NewDefaultAssumeRoleGrantChecker(k8sClient)
+			err := c.CheckAssumeRole(ctx, &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: tt.args.namespace,
+					Name:      "tgb",
+				},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					IAMRoleARNToAssume:   tt.args.roleARN,
+					AssumeRoleExternalID: tt.args.externalID,
+				},
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"sync"
 	"time"
 
 	"k8s.io/client-go/tools/record"
@@ -18,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -26,7 +28,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const defaultTargetHealthRequeueDuration = 15 * time.Second
+const (
+	defaultTargetHealthRequeueDuration = 15 * time.Second
+	defaultALBTargetRequeueDuration    = 30 * time.Second
+)
 
 // ResourceManager manages the TargetGroupBinding resource.
 type ResourceManager interface {
@@ -36,8 +41,9 @@ type ResourceManager interface {
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
 func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, ec2Client services.EC2,
+	cloudProvider aws.CloudProvider, assumeRoleGrantChecker AssumeRoleGrantChecker,
 	podInfoRepo k8s.PodInfoRepo, sgManager networking.SecurityGroupManager, sgReconciler networking.SecurityGroupReconciler,
-	vpcInfoProvider networking.VPCInfoProvider,
+	vpcInfoProvider networking.VPCInfoProvider, albFinder IngressALBFinder,
 	vpcID string, clusterName string, failOpenEnabled bool, endpointSliceEnabled bool, disabledRestrictedSGRulesFlag bool,
 	endpointSGTags map[string]string,
 	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
@@ -49,18 +55,25 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 	nodeENIResolver := networking.NewDefaultNodeENIInfoResolver(nodeInfoProvider, logger)
 
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, endpointSGTags, logger, disabledRestrictedSGRulesFlag)
+	albTargetResolver := NewDefaultALBTargetResolver(k8sClient, elbv2Client, albFinder)
 	return &defaultResourceManager{
 		k8sClient:         k8sClient,
 		targetsManager:    targetsManager,
 		endpointResolver:  endpointResolver,
 		networkingManager: networkingManager,
+		albTargetResolver: albTargetResolver,
 		eventRecorder:     eventRecorder,
 		logger:            logger,
 		vpcID:             vpcID,
 		vpcInfoProvider:   vpcInfoProvider,
 		podInfoRepo:       podInfoRepo,
+		cloudProvider:     cloudProvider,
+		roleTargetsScopes: make(map[assumeRoleKey]targetsScope),
+
+		assumeRoleGrantChecker: assumeRoleGrantChecker,
 
 		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
+		albTargetRequeueDuration:    defaultALBTargetRequeueDuration,
 	}
 }
 
@@ -72,27 +85,61 @@ type defaultResourceManager struct {
 	targetsManager    TargetsManager
 	endpointResolver  backend.EndpointResolver
 	networkingManager NetworkingManager
+	albTargetResolver ALBTargetResolver
 	eventRecorder     record.EventRecorder
 	logger            logr.Logger
 	vpcInfoProvider   networking.VPCInfoProvider
 	podInfoRepo       k8s.PodInfoRepo
 	vpcID             string
+	// cloudProvider provides the AWS clients for the IAM role that TargetGroupBindings assume.
+	cloudProvider aws.CloudProvider
+	// assumeRoleGrantChecker checks TargetGroupBindings are permitted to assume their IAM role.
+	assumeRoleGrantChecker AssumeRoleGrantChecker
+	// roleTargetsScopes caches the targetsScope per IAM role to assume.
+	roleTargetsScopes      map[assumeRoleKey]targetsScope
+	roleTargetsScopesMutex sync.Mutex
 
 	targetHealthRequeueDuration time.Duration
+	albTargetRequeueDuration    time.Duration
+}
+
+// assumeRoleKey identifies the IAM role to assume.
+type assumeRoleKey struct {
+	roleARN    string
+	externalID string
+}
+
+// targetsScope contains the components that manage targets of TargetGroup with the credentials of TargetGroupBinding.
+type targetsScope struct {
+	targetsManager TargetsManager
+	// vpcInfoProvider looks up the VPC of TargetGroup if it's different from the cluster's VPC.
+	vpcInfoProvider networking.VPCInfoProvider
 }
 
 func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	if tgb.Spec.TargetType == nil {
 		return errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
 	}
-	if *tgb.Spec.TargetType == elbv2api.TargetTypeIP {
-		return m.reconcileWithIPTargetType(ctx, tgb)
+	scope, err := m.resolveTargetsScope(ctx, tgb, true)
+	if err != nil {
+		return err
+	}
+	switch *tgb.Spec.TargetType {
+	case elbv2api.TargetTypeIP:
+		return m.reconcileWithIPTargetType(ctx, tgb, scope)
+	case elbv2api.TargetTypeALB:
+		return m.reconcileWithALBTargetType(ctx, tgb, scope)
 	}
-	return m.reconcileWithInstanceTargetType(ctx, tgb)
+	return m.reconcileWithInstanceTargetType(ctx, tgb, scope)
 }
 
 func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if err := m.cleanupTargets(ctx, tgb); err != nil {
+	// the AssumeRoleGrant isn't checked again, so that targets are still deregistered if it's removed before TargetGroupBinding.
+	scope, err := m.resolveTargetsScope(ctx, tgb, false)
+	if err != nil {
+		return err
+	}
+	if err := m.cleanupTargets(ctx, tgb, scope); err != nil {
 		return err
 	}
 	if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
@@ -104,7 +151,44 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	return nil
 }
 
-func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+// resolveTargetsScope resolves the targetsScope for the IAM role that TargetGroupBinding assumes.
+// TargetGroupBindings without IAM role use the controller's own credentials.
+// When checkGrant is true, the IAM role is only assumed while TargetGroupBinding is permitted to by an AssumeRoleGrant.
+func (m *defaultResourceManager) resolveTargetsScope(ctx context.Context, tgb *elbv2api.TargetGroupBinding, checkGrant bool) (targetsScope, error) {
+	if len(tgb.Spec.IAMRoleARNToAssume) == 0 {
+		return targetsScope{
+			targetsManager:  m.targetsManager,
+			vpcInfoProvider: m.vpcInfoProvider,
+		}, nil
+	}
+	if checkGrant {
+		if err := m.assumeRoleGrantChecker.CheckAssumeRole(ctx, tgb); err != nil {
+			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedAssumeRole, err.Error())
+			return targetsScope{}, err
+		}
+	}
+	key := assumeRoleKey{roleARN: tgb.Spec.IAMRoleARNToAssume, externalID: tgb.Spec.AssumeRoleExternalID}
+	m.roleTargetsScopesMutex.Lock()
+	defer m.roleTargetsScopesMutex.Unlock()
+	if scope, ok := m.roleTargetsScopes[key]; ok {
+		return scope, nil
+	}
+	cloud, err := m.cloudProvider.CloudForRole(key.roleARN, key.externalID)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to assume IAM role %v", key.roleARN)
+		m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedAssumeRole, err.Error())
+		return targetsScope{}, err
+	}
+	logger := m.logger.WithValues("iamRole", key.roleARN)
+	scope := targetsScope{
+		targetsManager:  NewCachedTargetsManager(cloud.ELBV2(), logger),
+		vpcInfoProvider: networking.NewDefaultVPCInfoProvider(cloud.EC2(), logger),
+	}
+	m.roleTargetsScopes[key] = scope
+	return scope, nil
+}
+
+func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding, scope targetsScope) error {
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
 
 	targetHealthCondType := BuildTargetHealthPodConditionType(tgb)
@@ -128,7 +212,7 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 
 	tgARN := tgb.Spec.TargetGroupARN
 	vpcID := tgb.Spec.VpcID
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	targets, err := scope.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
 		return err
 	}
@@ -141,12 +225,12 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		needNetworkingRequeue = true
 	}
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, scope, tgARN, unmatchedTargets); err != nil {
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerPodEndpoints(ctx, tgARN, vpcID, unmatchedEndpoints); err != nil {
+		if err := m.registerPodEndpoints(ctx, scope, tgARN, vpcID, unmatchedEndpoints); err != nil {
 			return err
 		}
 	}
@@ -175,7 +259,7 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	return nil
 }
 
-func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding, scope targetsScope) error {
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
 	nodeSelector, err := backend.GetTrafficProxyNodeSelector(tgb)
 	if err != nil {
@@ -192,7 +276,7 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		return err
 	}
 	tgARN := tgb.Spec.TargetGroupARN
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	targets, err := scope.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
 		return err
 	}
@@ -203,12 +287,12 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		return err
 	}
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, scope, tgARN, unmatchedTargets); err != nil {
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerNodePortEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+		if err := m.registerNodePortEndpoints(ctx, scope, tgARN, unmatchedEndpoints); err != nil {
 			return err
 		}
 	}
@@ -216,8 +300,50 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	return nil
 }
 
-func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	targets, err := m.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
+func (m *defaultResourceManager) reconcileWithALBTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding, scope targetsScope) error {
+	albTarget, err := m.albTargetResolver.ResolveALBTarget(ctx, tgb)
+	if err != nil {
+		if errors.Is(err, ErrALBTargetNotFound) {
+			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonBackendNotFound, err.Error())
+			if err := m.cleanupTargets(ctx, tgb, scope); err != nil {
+				return err
+			}
+			return runtime.NewRequeueNeededAfter("ALB target not found", m.albTargetRequeueDuration)
+		}
+		return err
+	}
+
+	tgARN := tgb.Spec.TargetGroupARN
+	targets, err := scope.targetsManager.ListTargets(ctx, tgARN)
+	if err != nil {
+		return err
+	}
+	notDrainingTargets, _ := partitionTargetsByDrainingStatus(targets)
+	albTargetRegistered := false
+	var unmatchedTargets []TargetInfo
+	for _, target := range notDrainingTargets {
+		if awssdk.StringValue(target.Target.Id) == awssdk.StringValue(albTarget.Id) {
+			albTargetRegistered = true
+			continue
+		}
+		unmatchedTargets = append(unmatchedTargets, target)
+	}
+
+	if len(unmatchedTargets) > 0 {
+		if err := m.deregisterTargets(ctx, scope, tgARN, unmatchedTargets); err != nil {
+			return err
+		}
+	}
+	if !albTargetRegistered {
+		if err := scope.targetsManager.RegisterTargets(ctx, tgARN, []elbv2sdk.TargetDescription{albTarget}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, scope targetsScope) error {
+	targets, err := scope.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
@@ -226,7 +352,7 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 		}
 		return err
 	}
-	if err := m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targets); err != nil {
+	if err := m.deregisterTargets(ctx, scope, tgb.Spec.TargetGroupARN, targets); err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
 		} else if isELBV2TargetGroupARNInvalidError(err) {
@@ -375,23 +501,25 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 	return nil
 }
 
-func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN string, targets []TargetInfo) error {
+func (m *defaultResourceManager) deregisterTargets(ctx context.Context, scope targetsScope, tgARN string, targets []TargetInfo) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(targets))
 	for _, target := range targets {
 		sdkTargets = append(sdkTargets, target.Target)
 	}
-	return m.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
+	return scope.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN, tgVpcID string, endpoints []backend.PodEndpoint) error {
+func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, scope targetsScope, tgARN, tgVpcID string, endpoints []backend.PodEndpoint) error {
 	vpcID := m.vpcID
+	vpcInfoProvider := m.vpcInfoProvider
 	// Target group is in a different VPC from the cluster's VPC
 	if tgVpcID != "" && tgVpcID != m.vpcID {
 		vpcID = tgVpcID
+		vpcInfoProvider = scope.vpcInfoProvider
 		m.logger.Info("registering endpoints using the targetGroup's vpcID", tgVpcID,
 			"which is different from the cluster's vpcID", m.vpcID)
 	}
-	vpcInfo, err := m.vpcInfoProvider.FetchVPCInfo(ctx, vpcID)
+	vpcInfo, err := vpcInfoProvider.FetchVPCInfo(ctx, vpcID)
 	if err != nil {
 		return err
 	}
@@ -418,10 +546,10 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN
 		}
 		sdkTargets = append(sdkTargets, target)
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return scope.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgARN string, endpoints []backend.NodePortEndpoint) error {
+func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, scope targetsScope, tgARN string, endpoints []backend.NodePortEndpoint) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
 		sdkTargets = append(sdkTargets, elbv2sdk.TargetDescription{
@@ -429,7 +557,7 @@ func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context,
 			Port: awssdk.Int64(endpoint.Port),
 		})
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return scope.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
 }
 
 type podEndpointAndTargetPair struct {
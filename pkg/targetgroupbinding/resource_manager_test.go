@@ -9,12 +9,17 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -463,3 +468,78 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 		})
 	}
 }
+
+// denyingAssumeRoleGrantChecker denies all IAM roles to assume.
+type denyingAssumeRoleGrantChecker struct{}
+
+func (c *denyingAssumeRoleGrantChecker) CheckAssumeRole(_ context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	return errors.Errorf("IAM role %v is not permitted to be assumed in namespace %v by any AssumeRoleGrant",
+		tgb.Spec.IAMRoleARNToAssume, tgb.Namespace)
+}
+
+// stubCloudProvider provides Clouds without AWS clients, and records the IAM roles assumed.
+type stubCloudProvider struct {
+	assumedRoles []string
+}
+
+func (p *stubCloudProvider) CloudForRole(roleARN string, _ string) (aws.Cloud, error) {
+	p.assumedRoles = append(p.assumedRoles, roleARN)
+	return &stubCloud{}, nil
+}
+
+// stubCloud is a Cloud without AWS clients.
+type stubCloud struct {
+	aws.Cloud
+}
+
+func (c *stubCloud) EC2() services.EC2     { return nil }
+func (c *stubCloud) ELBV2() services.ELBV2 { return nil }
+
+func Test_defaultResourceManager_resolveTargetsScope(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			IAMRoleARNToAssume: "arn:aws:iam::123456789012:role/lbc-target-registration",
+		},
+	}
+	tests := []struct {
+		name             string
+		checkGrant       bool
+		wantAssumedRoles []string
+		wantErr          error
+	}{
+		{
+			name:       "reconcile is denied without AssumeRoleGrant",
+			checkGrant: true,
+			wantErr:    errors.New("IAM role arn:aws:iam::123456789012:role/lbc-target-registration is not permitted to be assumed in namespace awesome-ns by any AssumeRoleGrant"),
+		},
+		{
+			name:             "cleanup assumes IAM role without AssumeRoleGrant",
+			checkGrant:       false,
+			wantAssumedRoles: []string{"arn:aws:iam::123456789012:role/lbc-target-registration"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloudProvider := &stubCloudProvider{}
+			m := &defaultResourceManager{
+				eventRecorder:          record.NewFakeRecorder(10),
+				logger:                 log.Log,
+				cloudProvider:          cloudProvider,
+				assumeRoleGrantChecker: &denyingAssumeRoleGrantChecker{},
+				roleTargetsScopes:      make(map[assumeRoleKey]targetsScope),
+			}
+			got, err := m.resolveTargetsScope(context.Background(), tgb, tt.checkGrant)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, got.targetsManager)
+			}
+			assert.Equal(t, tt.wantAssumedRoles, cloudProvider.assumedRoles)
+		})
+	}
+}
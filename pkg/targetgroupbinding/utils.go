@@ -16,6 +16,10 @@ const (
 
 	// Index Key for "ServiceReference" index.
 	IndexKeyServiceRefName = "spec.serviceRef.name"
+	// Index Key for "ALBTargetReference" index by IngressName.
+	IndexKeyALBTargetRefIngressName = "spec.albTargetRef.ingressName"
+	// Index Key for "ALBTargetReference" index by IngressGroupName.
+	IndexKeyALBTargetRefIngressGroupName = "spec.albTargetRef.ingressGroupName"
 )
 
 // BuildTargetHealthPodConditionType constructs the condition type for TargetHealth pod condition.
@@ -29,6 +33,24 @@ func IndexFuncServiceRefName(obj client.Object) []string {
 	return []string{tgb.Spec.ServiceRef.Name}
 }
 
+// IndexFuncALBTargetRefIngressName is IndexFunc for "ALBTargetReference" index by IngressName.
+func IndexFuncALBTargetRefIngressName(obj client.Object) []string {
+	tgb := obj.(*elbv2api.TargetGroupBinding)
+	if tgb.Spec.ALBTargetRef == nil || tgb.Spec.ALBTargetRef.IngressName == nil {
+		return nil
+	}
+	return []string{*tgb.Spec.ALBTargetRef.IngressName}
+}
+
+// IndexFuncALBTargetRefIngressGroupName is IndexFunc for "ALBTargetReference" index by IngressGroupName.
+func IndexFuncALBTargetRefIngressGroupName(obj client.Object) []string {
+	tgb := obj.(*elbv2api.TargetGroupBinding)
+	if tgb.Spec.ALBTargetRef == nil || tgb.Spec.ALBTargetRef.IngressGroupName == nil {
+		return nil
+	}
+	return []string{*tgb.Spec.ALBTargetRef.IngressGroupName}
+}
+
 func buildServiceReferenceKey(tgb *elbv2api.TargetGroupBinding, svcRef elbv2api.ServiceReference) types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: tgb.Namespace,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -45,6 +46,48 @@ func TestMutateUpdate_WhenOldServiceDoesNotHaveLoadBalancerClass(t *testing.T) {
 	assert.Nil(t, newSvc.Spec.LoadBalancerClass)
 }
 
+func TestMutateCreate(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want *string
+	}{
+		{
+			name: "service is not load balancer",
+			svc:  &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}},
+			want: nil,
+		},
+		{
+			name: "service without loadBalancerClass gets default class",
+			svc:  &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			want: stringPtr("service.k8s.aws/nlb"),
+		},
+		{
+			name: "service with nlb loadBalancerClass",
+			svc:  &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, LoadBalancerClass: stringPtr("service.k8s.aws/nlb")}},
+			want: stringPtr("service.k8s.aws/nlb"),
+		},
+		{
+			name: "service with alb loadBalancerClass",
+			svc:  &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, LoadBalancerClass: stringPtr("service.k8s.aws/alb")}},
+			want: stringPtr("service.k8s.aws/alb"),
+		},
+		{
+			name: "service with other loadBalancerClass",
+			svc:  &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, LoadBalancerClass: stringPtr("other-class")}},
+			want: stringPtr("other-class"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewServiceMutator("service.k8s.aws/nlb", logr.Discard())
+			got, err := m.MutateCreate(context.Background(), tt.svc)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.(*corev1.Service).Spec.LoadBalancerClass)
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/policy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidateService  = "/validate-v1-service"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// NewServiceValidator returns a validator for Service.
+func NewServiceValidator(k8sClient client.Client, modelBuilder service.ModelBuilder, logger logr.Logger) *serviceValidator {
+	return &serviceValidator{
+		annotationParser:    annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+		lbClassParamsLoader: service.NewDefaultLoadBalancerClassParamsLoader(k8sClient),
+		modelBuilder:        modelBuilder,
+		policyEvaluator:     policy.NewDefaultLoadBalancerPolicyEvaluator(k8sClient, logger),
+		logger:              logger,
+	}
+}
+
+var _ webhook.Validator = &serviceValidator{}
+
+type serviceValidator struct {
+	annotationParser    annotations.Parser
+	lbClassParamsLoader service.LoadBalancerClassParamsLoader
+	modelBuilder        service.ModelBuilder
+	policyEvaluator     policy.LoadBalancerPolicyEvaluator
+	logger              logr.Logger
+}
+
+func (v *serviceValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Service{}, nil
+}
+
+func (v *serviceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	if err := v.checkLoadBalancerClassParamsConflicts(ctx, svc, nil); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerSettings(ctx, svc, nil); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerPolicies(ctx, svc); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	oldSvc := oldObj.(*corev1.Service)
+	if err := v.checkLoadBalancerClassParamsConflicts(ctx, svc, oldSvc); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerSettings(ctx, svc, oldSvc); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerPolicies(ctx, svc); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v *serviceValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkLoadBalancerClassParamsConflicts checks whether annotations on Service conflict with enforced settings of its LoadBalancerClassParams.
+// for updates, only conflicts not present in the old Service are rejected, so that existing Services can still be updated after LoadBalancerClassParams changes.
+func (v *serviceValidator) checkLoadBalancerClassParamsConflicts(ctx context.Context, svc *corev1.Service, oldSvc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	params, err := v.lbClassParamsLoader.Load(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return nil
+	}
+	conflicts, err := service.FindLoadBalancerClassParamsConflicts(v.annotationParser, svc, params)
+	if err != nil {
+		return err
+	}
+	if oldSvc != nil && len(conflicts) != 0 {
+		oldConflicts, err := service.FindLoadBalancerClassParamsConflicts(v.annotationParser, oldSvc, params)
+		if err != nil {
+			return err
+		}
+		conflicts = sets.NewString(conflicts...).Difference(sets.NewString(oldConflicts...)).List()
+	}
+	if len(conflicts) != 0 {
+		return errors.Errorf("service conflicts with LoadBalancerClassParams %v: %v", params.Name, strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+// checkLoadBalancerSettings checks the load balancer settings of Service with the validations of model builder that don't need AWS resources,
+// e.g. subnet mappings, TLS ports and attributes.
+// for updates, Services with unchanged annotations and spec are not checked, so that existing Services can still be updated(e.g. finalizers).
+func (v *serviceValidator) checkLoadBalancerSettings(ctx context.Context, svc *corev1.Service, oldSvc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	if oldSvc != nil && equality.Semantic.DeepEqual(svc.Annotations, oldSvc.Annotations) &&
+		equality.Semantic.DeepEqual(svc.Spec, oldSvc.Spec) {
+		return nil
+	}
+	if err := v.modelBuilder.Validate(ctx, svc); err != nil {
+		return errors.Wrap(err, "invalid load balancer settings")
+	}
+	return nil
+}
+
+// checkLoadBalancerPolicies checks the load balancer of Service against LoadBalancerPolicies.
+// building the model calls AWS APIs to resolve subnets and security groups without modifying AWS resources.
+// if any LoadBalancerPolicy applies, failures to build the model reject the Service, so that the policies are never bypassed.
+func (v *serviceValidator) checkLoadBalancerPolicies(ctx context.Context, svc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	namespaces := []string{svc.Namespace}
+	policies, err := v.policyEvaluator.ListPolicies(ctx, namespaces)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	stack, _, _, err := v.modelBuilder.Build(ctx, svc)
+	if err != nil {
+		return errors.Wrap(err, "unable to check LoadBalancerPolicies")
+	}
+	violations, err := v.policyEvaluator.Evaluate(ctx, policies, namespaces, stack)
+	if err != nil {
+		return err
+	}
+	if len(violations) != 0 {
+		return policy.NewViolationError(violations)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-v1-service,mutating=false,failurePolicy=fail,groups="",resources=services,verbs=create;update,versions=v1,name=vservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *serviceValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateService, webhook.ValidatingWebhookForValidator(v))
+}
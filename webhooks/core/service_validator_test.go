@@ -0,0 +1,272 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_serviceValidator_checkLoadBalancerClassParamsConflicts(t *testing.T) {
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	params := &elbv2api.LoadBalancerClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nlb-params",
+		},
+		Spec: elbv2api.LoadBalancerClassParamsSpec{
+			LoadBalancerClass: "service.k8s.aws/nlb",
+			Enforced: &elbv2api.LoadBalancerClassSettings{
+				Scheme: &schemeInternal,
+			},
+		},
+	}
+	newService := func(lbClass string, annotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        "awesome-svc",
+				Annotations: annotations,
+			},
+			Spec: corev1.ServiceSpec{
+				Type:              corev1.ServiceTypeLoadBalancer,
+				LoadBalancerClass: &lbClass,
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		oldSvc  *corev1.Service
+		wantErr error
+	}{
+		{
+			name: "service without conflicts",
+			svc: newService("service.k8s.aws/nlb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+			}),
+		},
+		{
+			name: "service of other loadBalancerClass",
+			svc: newService("service.k8s.aws/alb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}),
+		},
+		{
+			name: "service creation with conflicts",
+			svc: newService("service.k8s.aws/nlb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}),
+			wantErr: errors.New("service conflicts with LoadBalancerClassParams nlb-params: annotation aws-load-balancer-scheme: internet-facing conflicts with enforced value internal"),
+		},
+		{
+			name: "service update introducing conflicts",
+			svc: newService("service.k8s.aws/nlb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}),
+			oldSvc:  newService("service.k8s.aws/nlb", nil),
+			wantErr: errors.New("service conflicts with LoadBalancerClassParams nlb-params: annotation aws-load-balancer-scheme: internet-facing conflicts with enforced value internal"),
+		},
+		{
+			name: "service update with existing conflicts",
+			svc: newService("service.k8s.aws/nlb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				"other-annotation": "value",
+			}),
+			oldSvc: newService("service.k8s.aws/nlb", map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, params.DeepCopy()))
+
+			v := NewServiceValidator(k8sClient, &stubModelBuilder{}, logr.Discard())
+			var err error
+			if tt.oldSvc == nil {
+				err = v.ValidateCreate(ctx, tt.svc)
+			} else {
+				err = v.ValidateUpdate(ctx, tt.svc, tt.oldSvc)
+			}
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// stubModelBuilder builds a load balancer with the scheme, or fails with the error.
+type stubModelBuilder struct {
+	scheme      elbv2model.LoadBalancerScheme
+	err         error
+	validateErr error
+}
+
+func (b *stubModelBuilder) Build(_ context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	if b.err != nil {
+		return nil, nil, false, b.err
+	}
+	stack := core.NewDefaultStack(core.StackID{Namespace: svc.Namespace, Name: svc.Name})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Scheme: &b.scheme,
+	})
+	return stack, lb, false, nil
+}
+
+func (b *stubModelBuilder) Validate(_ context.Context, _ *corev1.Service) error {
+	return b.validateErr
+}
+
+func Test_serviceValidator_checkLoadBalancerPolicies(t *testing.T) {
+	policy := &elbv2api.LoadBalancerPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internet-facing-policy",
+		},
+		Spec: elbv2api.LoadBalancerPolicySpec{
+			Rules: elbv2api.LoadBalancerPolicyRules{
+				InternetFacing: &elbv2api.InternetFacingRule{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"internet-facing": "allowed"},
+					},
+				},
+			},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+		},
+	}
+	tests := []struct {
+		name         string
+		policies     []*elbv2api.LoadBalancerPolicy
+		modelBuilder *stubModelBuilder
+		wantErr      error
+	}{
+		{
+			name:         "no policies",
+			modelBuilder: &stubModelBuilder{scheme: elbv2model.LoadBalancerSchemeInternetFacing},
+		},
+		{
+			name:         "compliant load balancer",
+			policies:     []*elbv2api.LoadBalancerPolicy{policy},
+			modelBuilder: &stubModelBuilder{scheme: elbv2model.LoadBalancerSchemeInternal},
+		},
+		{
+			name:         "non-compliant load balancer",
+			policies:     []*elbv2api.LoadBalancerPolicy{policy},
+			modelBuilder: &stubModelBuilder{scheme: elbv2model.LoadBalancerSchemeInternetFacing},
+			wantErr:      errors.New("load balancer violates LoadBalancerPolicy: loadBalancer.scheme: Forbidden: internet-facing load balancer is not allowed in namespace awesome-ns by LoadBalancerPolicy internet-facing-policy"),
+		},
+		{
+			name:         "model build failure without policies",
+			modelBuilder: &stubModelBuilder{err: errors.New("failed to resolve subnets")},
+		},
+		{
+			name:         "model build failure with policies",
+			policies:     []*elbv2api.LoadBalancerPolicy{policy},
+			modelBuilder: &stubModelBuilder{err: errors.New("failed to resolve subnets")},
+			wantErr:      errors.New("unable to check LoadBalancerPolicies: failed to resolve subnets"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "awesome-ns"}}))
+			for _, policy := range tt.policies {
+				assert.NoError(t, k8sClient.Create(ctx, policy.DeepCopy()))
+			}
+
+			v := NewServiceValidator(k8sClient, tt.modelBuilder, logr.Discard())
+			err := v.checkLoadBalancerPolicies(ctx, svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_serviceValidator_checkLoadBalancerSettings(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "8443",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+		},
+	}
+	svcWithFinalizer := svc.DeepCopy()
+	svcWithFinalizer.Finalizers = []string{"service.k8s.aws/resources"}
+	clusterIPSvc := svc.DeepCopy()
+	clusterIPSvc.Spec.Type = corev1.ServiceTypeClusterIP
+	validateErr := errors.New("Unused port in ssl-ports annotation [8443]")
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		oldSvc  *corev1.Service
+		wantErr error
+	}{
+		{
+			name:    "invalid settings on create",
+			svc:     svc,
+			wantErr: errors.New("invalid load balancer settings: Unused port in ssl-ports annotation [8443]"),
+		},
+		{
+			name:    "invalid settings on update",
+			svc:     svc,
+			oldSvc:  clusterIPSvc,
+			wantErr: errors.New("invalid load balancer settings: Unused port in ssl-ports annotation [8443]"),
+		},
+		{
+			name:   "unchanged settings on update",
+			svc:    svcWithFinalizer,
+			oldSvc: svc,
+		},
+		{
+			name: "non-LoadBalancer service",
+			svc:  clusterIPSvc,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewServiceValidator(testclient.NewClientBuilder().Build(), &stubModelBuilder{validateErr: validateErr}, logr.Discard())
+			err := v.checkLoadBalancerSettings(context.Background(), tt.svc, tt.oldSvc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
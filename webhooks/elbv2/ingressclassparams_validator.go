@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
@@ -35,6 +36,12 @@ func (v *ingressClassParamsValidator) ValidateCreate(ctx context.Context, obj ru
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkListenPorts(icp)...)
+	allErrs = append(allErrs, v.checkCertificates(icp)...)
+	allErrs = append(allErrs, v.checkDefaultAction(icp)...)
+	allErrs = append(allErrs, v.checkMutualAuthentication(icp)...)
+	allErrs = append(allErrs, v.checkTargetGroup(icp)...)
+	allErrs = append(allErrs, v.checkTenantPolicy(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -44,6 +51,12 @@ func (v *ingressClassParamsValidator) ValidateUpdate(ctx context.Context, obj ru
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkListenPorts(icp)...)
+	allErrs = append(allErrs, v.checkCertificates(icp)...)
+	allErrs = append(allErrs, v.checkDefaultAction(icp)...)
+	allErrs = append(allErrs, v.checkMutualAuthentication(icp)...)
+	allErrs = append(allErrs, v.checkTargetGroup(icp)...)
+	allErrs = append(allErrs, v.checkTenantPolicy(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -132,6 +145,155 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	return allErrs
 }
 
+// checkListenPorts will check for valid listenPorts and sslRedirectPort.
+func (v *ingressClassParamsValidator) checkListenPorts(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	fieldPath := field.NewPath("spec", "listenPorts")
+	protocolByPort := make(map[int32]elbv2api.ListenerProtocol, len(icp.Spec.ListenPorts))
+	for i, listenPort := range icp.Spec.ListenPorts {
+		if _, seen := protocolByPort[listenPort.Port]; seen {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Index(i).Child("port"), listenPort.Port))
+		}
+		protocolByPort[listenPort.Port] = listenPort.Protocol
+	}
+
+	if icp.Spec.SSLRedirectPort != nil && len(icp.Spec.ListenPorts) != 0 {
+		sslRedirectPort := *icp.Spec.SSLRedirectPort
+		if protocolByPort[sslRedirectPort] != elbv2api.ListenerProtocolHTTPS {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "sslRedirectPort"), sslRedirectPort, "must be an HTTPS port in `listenPorts`"))
+		}
+	}
+	return allErrs
+}
+
+// checkCertificates will check for valid certificates selector.
+func (v *ingressClassParamsValidator) checkCertificates(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.Certificates == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "certificates")
+	if len(icp.Spec.Certificates.ARNs) == 0 && len(icp.Spec.Certificates.Hostnames) == 0 {
+		allErrs = append(allErrs, field.Required(fieldPath, "must have either `arns` or `hostnames`"))
+	}
+	for i, certARN := range icp.Spec.Certificates.ARNs {
+		if !strings.HasPrefix(certARN, "arn:") {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("arns").Index(i), certARN, "must be a certificate ARN"))
+		}
+	}
+	return allErrs
+}
+
+// checkDefaultAction will check exactly one of fixedResponse or redirect is set in defaultAction.
+func (v *ingressClassParamsValidator) checkDefaultAction(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.DefaultAction == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "defaultAction")
+	defaultAction := icp.Spec.DefaultAction
+	if defaultAction.FixedResponse == nil && defaultAction.Redirect == nil {
+		allErrs = append(allErrs, field.Required(fieldPath, "must have either `fixedResponse` or `redirect`"))
+	} else if defaultAction.FixedResponse != nil && defaultAction.Redirect != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("redirect"), "may not have both `fixedResponse` and `redirect` set"))
+	}
+	return allErrs
+}
+
+// checkMutualAuthentication will check for valid mutualAuthentication settings.
+func (v *ingressClassParamsValidator) checkMutualAuthentication(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	fieldPath := field.NewPath("spec", "mutualAuthentication")
+	seenPorts := sets.NewInt32()
+	for i, mtls := range icp.Spec.MutualAuthentication {
+		fieldPath := fieldPath.Index(i)
+		if seenPorts.Has(mtls.Port) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("port"), mtls.Port))
+		}
+		seenPorts.Insert(mtls.Port)
+		if mtls.Mode == elbv2api.MutualAuthenticationModeVerify {
+			if mtls.TrustStore == nil || *mtls.TrustStore == "" {
+				allErrs = append(allErrs, field.Required(fieldPath.Child("trustStore"), "must be specified in verify mode"))
+			}
+			continue
+		}
+		if mtls.TrustStore != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("trustStore"), fmt.Sprintf("not supported in %v mode", mtls.Mode)))
+		}
+		if mtls.IgnoreClientCertificateExpiry != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("ignoreClientCertificateExpiry"), fmt.Sprintf("not supported in %v mode", mtls.Mode)))
+		}
+	}
+	return allErrs
+}
+
+// checkTargetGroup will check for valid targetGroup settings.
+func (v *ingressClassParamsValidator) checkTargetGroup(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	tgParams := icp.Spec.TargetGroup
+	if tgParams == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "targetGroup")
+	allErrs = append(allErrs, validateTargetGroupStringParam(tgParams.TargetType, fieldPath.Child("targetType"), "instance", "ip")...)
+	allErrs = append(allErrs, validateTargetGroupStringParam(tgParams.BackendProtocol, fieldPath.Child("backendProtocol"), "HTTP", "HTTPS")...)
+	allErrs = append(allErrs, validateTargetGroupStringParam(tgParams.BackendProtocolVersion, fieldPath.Child("backendProtocolVersion"), "HTTP1", "HTTP2", "GRPC")...)
+	allErrs = append(allErrs, validateTargetGroupStringParam(tgParams.HealthCheckProtocol, fieldPath.Child("healthCheckProtocol"), "HTTP", "HTTPS")...)
+	allErrs = append(allErrs, validateTargetGroupInt64Param(tgParams.HealthCheckIntervalSeconds, fieldPath.Child("healthCheckIntervalSeconds"))...)
+	allErrs = append(allErrs, validateTargetGroupInt64Param(tgParams.HealthCheckTimeoutSeconds, fieldPath.Child("healthCheckTimeoutSeconds"))...)
+	allErrs = append(allErrs, validateTargetGroupInt64Param(tgParams.HealthyThresholdCount, fieldPath.Child("healthyThresholdCount"))...)
+	allErrs = append(allErrs, validateTargetGroupInt64Param(tgParams.UnhealthyThresholdCount, fieldPath.Child("unhealthyThresholdCount"))...)
+	seenKeys := sets.NewString()
+	for i, attr := range tgParams.Attributes {
+		if seenKeys.Has(attr.Key) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("attributes").Index(i).Child("key"), attr.Key))
+		}
+		seenKeys.Insert(attr.Key)
+	}
+	return allErrs
+}
+
+// validateTargetGroupStringParam will check the value of target group setting is one of the supported values.
+func validateTargetGroupStringParam(param *elbv2api.TargetGroupStringParam, fieldPath *field.Path, supportedValues ...string) field.ErrorList {
+	if param == nil || sets.NewString(supportedValues...).Has(param.Value) {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fieldPath.Child("value"), param.Value, supportedValues)}
+}
+
+// validateTargetGroupInt64Param will check the value of target group setting is positive.
+func validateTargetGroupInt64Param(param *elbv2api.TargetGroupInt64Param, fieldPath *field.Path) field.ErrorList {
+	if param == nil || param.Value > 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fieldPath.Child("value"), param.Value, "must be positive")}
+}
+
+// tenantOverrideFieldsWithAllowedValues are the tenantPolicy fields that support allowedValues.
+var tenantOverrideFieldsWithAllowedValues = sets.NewString(
+	string(elbv2api.TenantOverrideFieldGroup),
+	string(elbv2api.TenantOverrideFieldScheme),
+	string(elbv2api.TenantOverrideFieldInboundCIDRs),
+	string(elbv2api.TenantOverrideFieldSSLPolicy),
+	string(elbv2api.TenantOverrideFieldIPAddressType),
+	string(elbv2api.TenantOverrideFieldSSLRedirectPort),
+)
+
+// checkTenantPolicy will check for valid tenantPolicy.
+func (v *ingressClassParamsValidator) checkTenantPolicy(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.TenantPolicy == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "tenantPolicy", "allowedOverrides")
+	seenFields := sets.NewString()
+	for i, override := range icp.Spec.TenantPolicy.AllowedOverrides {
+		fieldPath := fieldPath.Index(i)
+		if seenFields.Has(string(override.Field)) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("field"), override.Field))
+		}
+		seenFields.Insert(string(override.Field))
+		if len(override.AllowedValues) != 0 && !tenantOverrideFieldsWithAllowedValues.Has(string(override.Field)) {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("allowedValues"), fmt.Sprintf("not supported for field %v", override.Field)))
+		}
+	}
+	return allErrs
+}
+
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-ingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=create;update,versions=v1beta1,name=vingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 )
@@ -165,6 +166,210 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: "spec.subnets.tags: Required value: must have at least one tag key",
 		},
+		{
+			name: "listener settings are valid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					ListenPorts: []elbv2api.ListenPort{
+						{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+					},
+					SSLRedirectPort: awssdk.Int32(443),
+					Certificates: &elbv2api.CertificateSelector{
+						ARNs:      []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+						Hostnames: []string{"example.com"},
+					},
+					DefaultAction: &elbv2api.DefaultAction{
+						FixedResponse: &elbv2api.FixedResponseActionConfig{StatusCode: "404"},
+					},
+					MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+						{Port: 443, Mode: elbv2api.MutualAuthenticationModeVerify, TrustStore: awssdk.String("my-ts")},
+					},
+				},
+			},
+		},
+		{
+			name: "listenPorts duplicate port",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					ListenPorts: []elbv2api.ListenPort{
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTP},
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+					},
+				},
+			},
+			wantErr: "spec.listenPorts[1].port: Duplicate value: 443",
+		},
+		{
+			name: "sslRedirectPort is not an HTTPS listen port",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					ListenPorts: []elbv2api.ListenPort{
+						{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+					},
+					SSLRedirectPort: awssdk.Int32(80),
+				},
+			},
+			wantErr: "spec.sslRedirectPort: Invalid value: 80: must be an HTTPS port in `listenPorts`",
+		},
+		{
+			name: "certificates selector empty",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Certificates: &elbv2api.CertificateSelector{},
+				},
+			},
+			wantErr: "spec.certificates: Required value: must have either `arns` or `hostnames`",
+		},
+		{
+			name: "certificates invalid ARN",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Certificates: &elbv2api.CertificateSelector{
+						ARNs: []string{"my-cert"},
+					},
+				},
+			},
+			wantErr: "spec.certificates.arns[0]: Invalid value: \"my-cert\": must be a certificate ARN",
+		},
+		{
+			name: "defaultAction empty",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{},
+				},
+			},
+			wantErr: "spec.defaultAction: Required value: must have either `fixedResponse` or `redirect`",
+		},
+		{
+			name: "defaultAction with both fixedResponse and redirect",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						FixedResponse: &elbv2api.FixedResponseActionConfig{StatusCode: "404"},
+						Redirect:      &elbv2api.RedirectActionConfig{StatusCode: "HTTP_301"},
+					},
+				},
+			},
+			wantErr: "spec.defaultAction.redirect: Forbidden: may not have both `fixedResponse` and `redirect` set",
+		},
+		{
+			name: "mutualAuthentication verify without trustStore",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+						{Port: 443, Mode: elbv2api.MutualAuthenticationModeVerify},
+					},
+				},
+			},
+			wantErr: "spec.mutualAuthentication[0].trustStore: Required value: must be specified in verify mode",
+		},
+		{
+			name: "mutualAuthentication passthrough with trustStore",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+						{Port: 443, Mode: elbv2api.MutualAuthenticationModePassthrough, TrustStore: awssdk.String("my-ts")},
+					},
+				},
+			},
+			wantErr: "spec.mutualAuthentication[0].trustStore: Forbidden: not supported in passthrough mode",
+		},
+		{
+			name: "targetGroup settings are valid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroup: &elbv2api.TargetGroupParams{
+						TargetType:                 &elbv2api.TargetGroupStringParam{Value: "ip", Enforced: true},
+						BackendProtocol:            &elbv2api.TargetGroupStringParam{Value: "HTTPS"},
+						HealthCheckPath:            &elbv2api.TargetGroupStringParam{Value: "/healthz"},
+						HealthCheckIntervalSeconds: &elbv2api.TargetGroupInt64Param{Value: 10},
+						Attributes: []elbv2api.TargetGroupAttributeParam{
+							{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "targetGroup unsupported targetType",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroup: &elbv2api.TargetGroupParams{
+						TargetType: &elbv2api.TargetGroupStringParam{Value: "alb"},
+					},
+				},
+			},
+			wantErr: "spec.targetGroup.targetType.value: Unsupported value: \"alb\": supported values: \"instance\", \"ip\"",
+		},
+		{
+			name: "targetGroup non-positive healthyThresholdCount",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroup: &elbv2api.TargetGroupParams{
+						HealthyThresholdCount: &elbv2api.TargetGroupInt64Param{Value: 0},
+					},
+				},
+			},
+			wantErr: "spec.targetGroup.healthyThresholdCount.value: Invalid value: 0: must be positive",
+		},
+		{
+			name: "targetGroup duplicate attribute",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroup: &elbv2api.TargetGroupParams{
+						Attributes: []elbv2api.TargetGroupAttributeParam{
+							{Key: "slow_start.duration_seconds", Value: "30"},
+							{Key: "slow_start.duration_seconds", Value: "60", Enforced: true},
+						},
+					},
+				},
+			},
+			wantErr: "spec.targetGroup.attributes[1].key: Duplicate value: \"slow_start.duration_seconds\"",
+		},
+		{
+			name: "tenantPolicy is valid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TenantPolicy: &elbv2api.TenantPolicy{
+						IngressClassNames: []string{"tenant-class"},
+						AllowedOverrides: []elbv2api.TenantOverride{
+							{Field: elbv2api.TenantOverrideFieldScheme, AllowedValues: []string{"internal"}},
+							{Field: elbv2api.TenantOverrideFieldTags},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tenantPolicy duplicate field",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TenantPolicy: &elbv2api.TenantPolicy{
+						IngressClassNames: []string{"tenant-class"},
+						AllowedOverrides: []elbv2api.TenantOverride{
+							{Field: elbv2api.TenantOverrideFieldTags},
+							{Field: elbv2api.TenantOverrideFieldTags},
+						},
+					},
+				},
+			},
+			wantErr: "spec.tenantPolicy.allowedOverrides[1].field: Duplicate value: \"tags\"",
+		},
+		{
+			name: "tenantPolicy allowedValues for unsupported field",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TenantPolicy: &elbv2api.TenantPolicy{
+						IngressClassNames: []string{"tenant-class"},
+						AllowedOverrides: []elbv2api.TenantOverride{
+							{Field: elbv2api.TenantOverrideFieldTargetGroup, AllowedValues: []string{"ip"}},
+						},
+					},
+				},
+			},
+			wantErr: "spec.tenantPolicy.allowedOverrides[0].allowedValues: Forbidden: not supported for field targetGroup",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,83 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const apiPathValidateELBv2NamespacedIngressClassParams = "/validate-elbv2-k8s-aws-v1beta1-namespacedingressclassparams"
+
+// NewNamespacedIngressClassParamsValidator returns a validator for the NamespacedIngressClassParams CRD.
+func NewNamespacedIngressClassParamsValidator(k8sClient client.Client, logger logr.Logger) *namespacedIngressClassParamsValidator {
+	return &namespacedIngressClassParamsValidator{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ webhook.Validator = &namespacedIngressClassParamsValidator{}
+
+type namespacedIngressClassParamsValidator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (v *namespacedIngressClassParamsValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2api.NamespacedIngressClassParams{}, nil
+}
+
+func (v *namespacedIngressClassParamsValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	nsParams := obj.(*elbv2api.NamespacedIngressClassParams)
+	return v.checkTenantPolicy(ctx, nsParams)
+}
+
+func (v *namespacedIngressClassParamsValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	nsParams := obj.(*elbv2api.NamespacedIngressClassParams)
+	return v.checkTenantPolicy(ctx, nsParams)
+}
+
+func (v *namespacedIngressClassParamsValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkTenantPolicy will check NamespacedIngressClassParams against the tenantPolicy of every IngressClass referencing it.
+func (v *namespacedIngressClassParamsValidator) checkTenantPolicy(ctx context.Context, nsParams *elbv2api.NamespacedIngressClassParams) error {
+	ingClassList := &networking.IngressClassList{}
+	if err := v.k8sClient.List(ctx, ingClassList); err != nil {
+		return err
+	}
+	for _, ingClass := range ingClassList.Items {
+		if !ingress.IsNamespacedIngressClassParamsRef(&ingClass, k8s.NamespacedName(nsParams)) {
+			continue
+		}
+		baseParams, err := ingress.FindTenantPolicyIngressClassParams(ctx, v.k8sClient, ingClass.Name)
+		if err != nil {
+			return err
+		}
+		if baseParams == nil {
+			return errors.Errorf("no IngressClassParams defines tenantPolicy for IngressClass %v", ingClass.Name)
+		}
+		if violations := ingress.FindTenantPolicyViolations(*baseParams.Spec.TenantPolicy, nsParams.Spec); len(violations) != 0 {
+			return errors.Errorf("violates tenantPolicy of IngressClassParams %v: %v", baseParams.Name, strings.Join(violations, "; "))
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-namespacedingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=namespacedingressclassparams,verbs=create;update,versions=v1beta1,name=vnamespacedingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *namespacedIngressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateELBv2NamespacedIngressClassParams, webhook.ValidatingWebhookForValidator(v))
+}
@@ -0,0 +1,122 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_namespacedIngressClassParamsValidator_ValidateCreate(t *testing.T) {
+	namespaceScope := networking.IngressClassParametersReferenceScopeNamespace
+	tenantIngClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup:  awssdk.String("elbv2.k8s.aws"),
+				Kind:      "NamespacedIngressClassParams",
+				Name:      "tenant-params",
+				Scope:     &namespaceScope,
+				Namespace: awssdk.String("tenant-ns"),
+			},
+		},
+	}
+	tenantPolicyParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-policy",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			TenantPolicy: &elbv2api.TenantPolicy{
+				IngressClassNames: []string{"tenant-class"},
+				AllowedOverrides: []elbv2api.TenantOverride{
+					{
+						Field:         elbv2api.TenantOverrideFieldScheme,
+						AllowedValues: []string{"internal"},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name               string
+		ingClassList       []*networking.IngressClass
+		ingClassParamsList []*elbv2api.IngressClassParams
+		obj                *elbv2api.NamespacedIngressClassParams
+		wantErr            string
+	}{
+		{
+			name: "not referenced by any IngressClass",
+			obj: &elbv2api.NamespacedIngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-ns", Name: "tenant-params"},
+				Spec: elbv2api.NamespacedIngressClassParamsSpec{
+					SSLPolicy: "ELBSecurityPolicy-2016-08",
+				},
+			},
+		},
+		{
+			name:               "allowed by tenantPolicy",
+			ingClassList:       []*networking.IngressClass{tenantIngClass},
+			ingClassParamsList: []*elbv2api.IngressClassParams{tenantPolicyParams},
+			obj: &elbv2api.NamespacedIngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-ns", Name: "tenant-params"},
+				Spec: elbv2api.NamespacedIngressClassParamsSpec{
+					Scheme: (*elbv2api.LoadBalancerScheme)(awssdk.String("internal")),
+				},
+			},
+		},
+		{
+			name:               "violates tenantPolicy",
+			ingClassList:       []*networking.IngressClass{tenantIngClass},
+			ingClassParamsList: []*elbv2api.IngressClassParams{tenantPolicyParams},
+			obj: &elbv2api.NamespacedIngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-ns", Name: "tenant-params"},
+				Spec: elbv2api.NamespacedIngressClassParamsSpec{
+					Scheme:    (*elbv2api.LoadBalancerScheme)(awssdk.String("internet-facing")),
+					SSLPolicy: "ELBSecurityPolicy-2016-08",
+				},
+			},
+			wantErr: "violates tenantPolicy of IngressClassParams tenant-policy: value internet-facing of field scheme is not allowed, allowed values: [internal]; field sslPolicy is not allowed to be overridden",
+		},
+		{
+			name:         "referenced by IngressClass without tenantPolicy",
+			ingClassList: []*networking.IngressClass{tenantIngClass},
+			obj: &elbv2api.NamespacedIngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-ns", Name: "tenant-params"},
+			},
+			wantErr: "no IngressClassParams defines tenantPolicy for IngressClass tenant-class",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ingClass := range tt.ingClassList {
+				assert.NoError(t, k8sClient.Create(ctx, ingClass.DeepCopy()))
+			}
+			for _, ingClassParams := range tt.ingClassParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, ingClassParams.DeepCopy()))
+			}
+			v := NewNamespacedIngressClassParamsValidator(k8sClient, logr.Discard())
+			err := v.ValidateCreate(ctx, tt.obj)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -17,10 +19,13 @@ import (
 const apiPathMutateELBv2TargetGroupBinding = "/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
 
 // NewTargetGroupBindingMutator returns a mutator for TargetGroupBinding CRD.
-func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingMutator {
+func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, cloudProvider aws.CloudProvider,
+	assumeRoleGrantChecker targetgroupbinding.AssumeRoleGrantChecker, logger logr.Logger) *targetGroupBindingMutator {
 	return &targetGroupBindingMutator{
-		elbv2Client: elbv2Client,
-		logger:      logger,
+		elbv2Client:            elbv2Client,
+		cloudProvider:          cloudProvider,
+		assumeRoleGrantChecker: assumeRoleGrantChecker,
+		logger:                 logger,
 	}
 }
 
@@ -28,7 +33,11 @@ var _ webhook.Mutator = &targetGroupBindingMutator{}
 
 type targetGroupBindingMutator struct {
 	elbv2Client services.ELBV2
-	logger      logr.Logger
+	// cloudProvider provides the ELBV2 client for TargetGroupBindings that assume an IAM role.
+	cloudProvider aws.CloudProvider
+	// assumeRoleGrantChecker checks TargetGroupBindings are permitted to assume their IAM role.
+	assumeRoleGrantChecker targetgroupbinding.AssumeRoleGrantChecker
+	logger                 logr.Logger
 }
 
 func (m *targetGroupBindingMutator) Prototype(_ admission.Request) (runtime.Object, error) {
@@ -57,8 +66,7 @@ func (m *targetGroupBindingMutator) defaultingTargetType(ctx context.Context, tg
 	if tgb.Spec.TargetType != nil {
 		return nil
 	}
-	tgARN := tgb.Spec.TargetGroupARN
-	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgARN)
+	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "couldn't determine TargetType")
 	}
@@ -68,6 +76,8 @@ func (m *targetGroupBindingMutator) defaultingTargetType(ctx context.Context, tg
 		targetType = elbv2api.TargetTypeInstance
 	case elbv2sdk.TargetTypeEnumIp:
 		targetType = elbv2api.TargetTypeIP
+	case elbv2sdk.TargetTypeEnumAlb:
+		targetType = elbv2api.TargetTypeALB
 	default:
 		return errors.Errorf("unsupported TargetType: %v", sdkTargetType)
 	}
@@ -80,7 +90,7 @@ func (m *targetGroupBindingMutator) defaultingIPAddressType(ctx context.Context,
 	if tgb.Spec.IPAddressType != nil {
 		return nil
 	}
-	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group IP address type")
 	}
@@ -92,7 +102,7 @@ func (m *targetGroupBindingMutator) defaultingVpcID(ctx context.Context, tgb *el
 	if tgb.Spec.VpcID != "" {
 		return nil
 	}
-	vpcId, err := m.getVpcIDFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	vpcId, err := m.getVpcIDFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group VpcID")
 	}
@@ -100,8 +110,8 @@ func (m *targetGroupBindingMutator) defaultingVpcID(ctx context.Context, tgb *el
 	return nil
 }
 
-func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -109,8 +119,8 @@ func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Conte
 }
 
 // getTargetGroupIPAddressTypeFromAWS returns the target group IP address type of AWS target group
-func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgARN string) (elbv2api.TargetGroupIPAddressType, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2api.TargetGroupIPAddressType, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -126,11 +136,15 @@ func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx conte
 	return ipAddressType, nil
 }
 
-func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgARN string) (*elbv2sdk.TargetGroup, error) {
+func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2sdk.TargetGroup, error) {
+	elbv2Client, err := elbv2ClientForTargetGroupBinding(ctx, m.elbv2Client, m.cloudProvider, m.assumeRoleGrantChecker, tgb)
+	if err != nil {
+		return nil, err
+	}
 	req := &elbv2sdk.DescribeTargetGroupsInput{
-		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		TargetGroupArns: awssdk.StringSlice([]string{tgb.Spec.TargetGroupARN}),
 	}
-	tgList, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	tgList, err := elbv2Client.DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -140,8 +154,8 @@ func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, t
 	return tgList[0], nil
 }
 
-func (m *targetGroupBindingMutator) getVpcIDFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) getVpcIDFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -153,3 +167,21 @@ func (m *targetGroupBindingMutator) getVpcIDFromAWS(ctx context.Context, tgARN s
 func (m *targetGroupBindingMutator) SetupWithManager(mgr ctrl.Manager) {
 	mgr.GetWebhookServer().Register(apiPathMutateELBv2TargetGroupBinding, webhook.MutatingWebhookForMutator(m))
 }
+
+// elbv2ClientForTargetGroupBinding returns the ELBV2 client to look up the TargetGroup of TargetGroupBinding,
+// which uses the credentials of IAM role to assume if TargetGroupBinding specifies it.
+// The IAM role is only assumed if TargetGroupBinding is permitted to by an AssumeRoleGrant.
+func elbv2ClientForTargetGroupBinding(ctx context.Context, elbv2Client services.ELBV2, cloudProvider aws.CloudProvider,
+	assumeRoleGrantChecker targetgroupbinding.AssumeRoleGrantChecker, tgb *elbv2api.TargetGroupBinding) (services.ELBV2, error) {
+	if len(tgb.Spec.IAMRoleARNToAssume) == 0 {
+		return elbv2Client, nil
+	}
+	if err := assumeRoleGrantChecker.CheckAssumeRole(ctx, tgb); err != nil {
+		return nil, err
+	}
+	cloud, err := cloudProvider.CloudForRole(tgb.Spec.IAMRoleARNToAssume, tgb.Spec.AssumeRoleExternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to assume IAM role %v", tgb.Spec.IAMRoleARNToAssume)
+	}
+	return cloud.ELBV2(), nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -30,6 +32,7 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 	targetGroupIPAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
 	instanceTargetType := elbv2api.TargetTypeInstance
 	ipTargetType := elbv2api.TargetTypeIP
+	albTargetType := elbv2api.TargetTypeALB
 	type args struct {
 		obj *elbv2api.TargetGroupBinding
 	}
@@ -130,6 +133,39 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "targetGroupBinding with TargetType absent will be defaulted via AWS API - alb",
+			fields: fields{
+				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
+					{
+						req: &elbv2sdk.DescribeTargetGroupsInput{
+							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+						},
+						resp: []*elbv2sdk.TargetGroup{
+							{
+								TargetGroupArn: awssdk.String("tg-1"),
+								TargetType:     awssdk.String("alb"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				obj: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-1",
+						TargetType:     nil,
+					},
+				},
+			},
+			want: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-1",
+					TargetType:     &albTargetType,
+					IPAddressType:  &targetGroupIPAddressTypeIPv4,
+				},
+			},
+		},
 		{
 			name: "targetGroupBinding with TargetType absent will be defaulted via AWS API - lambda",
 			fields: fields{
@@ -356,7 +392,9 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: tt.args.tgARN},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -483,7 +521,9 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: tt.args.tgARN},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -566,7 +606,9 @@ func Test_targetGroupBindingMutator_obtainSDKVpcIDFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.getVpcIDFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.getVpcIDFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: tt.args.tgARN},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -576,3 +618,109 @@ func Test_targetGroupBindingMutator_obtainSDKVpcIDFromAWS(t *testing.T) {
 		})
 	}
 }
+
+// fakeCloud is a Cloud that only provides the ELBV2 client.
+type fakeCloud struct {
+	aws.Cloud
+	elbv2Client services.ELBV2
+}
+
+func (c *fakeCloud) ELBV2() services.ELBV2 {
+	return c.elbv2Client
+}
+
+// fakeCloudProvider is a CloudProvider that provides the Clouds of IAM roles by ARN.
+type fakeCloudProvider struct {
+	roleClouds map[string]aws.Cloud
+}
+
+func (p *fakeCloudProvider) CloudForRole(roleARN string, _ string) (aws.Cloud, error) {
+	cloud, ok := p.roleClouds[roleARN]
+	if !ok {
+		return nil, errors.Errorf("not authorized to assume %v", roleARN)
+	}
+	return cloud, nil
+}
+
+// fakeAssumeRoleGrantChecker is an AssumeRoleGrantChecker that permits IAM roles by ARN.
+type fakeAssumeRoleGrantChecker struct {
+	permittedRoles sets.Set[string]
+}
+
+func (c *fakeAssumeRoleGrantChecker) CheckAssumeRole(_ context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if !c.permittedRoles.Has(tgb.Spec.IAMRoleARNToAssume) {
+		return errors.Errorf("IAM role %v is not permitted", tgb.Spec.IAMRoleARNToAssume)
+	}
+	return nil
+}
+
+func Test_elbv2ClientForTargetGroupBinding(t *testing.T) {
+	defaultELBV2Client := services.NewMockELBV2(gomock.NewController(t))
+	roleELBV2Client := services.NewMockELBV2(gomock.NewController(t))
+	cloudProvider := &fakeCloudProvider{
+		roleClouds: map[string]aws.Cloud{
+			"arn:aws:iam::123456789012:role/lbc-target-registration": &fakeCloud{elbv2Client: roleELBV2Client},
+			"arn:aws:iam::123456789012:role/lbc-other-team":          &fakeCloud{elbv2Client: roleELBV2Client},
+		},
+	}
+	assumeRoleGrantChecker := &fakeAssumeRoleGrantChecker{
+		permittedRoles: sets.New("arn:aws:iam::123456789012:role/lbc-target-registration", "arn:aws:iam::210987654321:role/lbc-target-registration"),
+	}
+	tests := []struct {
+		name    string
+		tgb     *elbv2api.TargetGroupBinding
+		want    services.ELBV2
+		wantErr error
+	}{
+		{
+			name: "without IAM role",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-1",
+				},
+			},
+			want: defaultELBV2Client,
+		},
+		{
+			name: "with IAM role",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:     "tg-1",
+					IAMRoleARNToAssume: "arn:aws:iam::123456789012:role/lbc-target-registration",
+				},
+			},
+			want: roleELBV2Client,
+		},
+		{
+			name: "with IAM role cannot be assumed",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:     "tg-1",
+					IAMRoleARNToAssume: "arn:aws:iam::210987654321:role/lbc-target-registration",
+				},
+			},
+			wantErr: errors.New("failed to assume IAM role arn:aws:iam::210987654321:role/lbc-target-registration: not authorized to assume arn:aws:iam::210987654321:role/lbc-target-registration"),
+		},
+		{
+			name: "with IAM role not permitted by AssumeRoleGrant",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:     "tg-1",
+					IAMRoleARNToAssume: "arn:aws:iam::123456789012:role/lbc-other-team",
+				},
+			},
+			wantErr: errors.New("IAM role arn:aws:iam::123456789012:role/lbc-other-team is not permitted"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := elbv2ClientForTargetGroupBinding(context.Background(), defaultELBV2Client, cloudProvider, assumeRoleGrantChecker, tt.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Same(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,12 +23,15 @@ import (
 const apiPathValidateELBv2TargetGroupBinding = "/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
 
 // NewTargetGroupBindingValidator returns a validator for TargetGroupBinding CRD.
-func NewTargetGroupBindingValidator(k8sClient client.Client, elbv2Client services.ELBV2, vpcID string, logger logr.Logger) *targetGroupBindingValidator {
+func NewTargetGroupBindingValidator(k8sClient client.Client, elbv2Client services.ELBV2, cloudProvider aws.CloudProvider,
+	assumeRoleGrantChecker targetgroupbinding.AssumeRoleGrantChecker, vpcID string, logger logr.Logger) *targetGroupBindingValidator {
 	return &targetGroupBindingValidator{
-		k8sClient:   k8sClient,
-		elbv2Client: elbv2Client,
-		logger:      logger,
-		vpcID:       vpcID,
+		k8sClient:              k8sClient,
+		elbv2Client:            elbv2Client,
+		cloudProvider:          cloudProvider,
+		assumeRoleGrantChecker: assumeRoleGrantChecker,
+		logger:                 logger,
+		vpcID:                  vpcID,
 	}
 }
 
@@ -35,8 +40,12 @@ var _ webhook.Validator = &targetGroupBindingValidator{}
 type targetGroupBindingValidator struct {
 	k8sClient   client.Client
 	elbv2Client services.ELBV2
-	logger      logr.Logger
-	vpcID       string
+	// cloudProvider provides the ELBV2 client for TargetGroupBindings that assume an IAM role.
+	cloudProvider aws.CloudProvider
+	// assumeRoleGrantChecker checks TargetGroupBindings are permitted to assume their IAM role.
+	assumeRoleGrantChecker targetgroupbinding.AssumeRoleGrantChecker
+	logger                 logr.Logger
+	vpcID                  string
 }
 
 func (v *targetGroupBindingValidator) Prototype(_ admission.Request) (runtime.Object, error) {
@@ -51,9 +60,15 @@ func (v *targetGroupBindingValidator) ValidateCreate(ctx context.Context, obj ru
 	if err := v.checkNodeSelector(tgb); err != nil {
 		return err
 	}
+	if err := v.checkALBTargetRef(tgb); err != nil {
+		return err
+	}
 	if err := v.checkExistingTargetGroups(tgb); err != nil {
 		return err
 	}
+	if err := v.assumeRoleGrantChecker.CheckAssumeRole(ctx, tgb); err != nil {
+		return err
+	}
 	if err := v.checkTargetGroupIPAddressType(ctx, tgb); err != nil {
 		return err
 	}
@@ -75,6 +90,9 @@ func (v *targetGroupBindingValidator) ValidateUpdate(ctx context.Context, obj ru
 	if err := v.checkNodeSelector(tgb); err != nil {
 		return err
 	}
+	if err := v.checkALBTargetRef(tgb); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -118,6 +136,12 @@ func (v *targetGroupBindingValidator) checkImmutableFields(tgb *elbv2api.TargetG
 		(oldTGB.Spec.VpcID == "" && tgb.Spec.VpcID != "" && tgb.Spec.VpcID != v.vpcID) {
 		changedImmutableFields = append(changedImmutableFields, "spec.vpcID")
 	}
+	if tgb.Spec.IAMRoleARNToAssume != oldTGB.Spec.IAMRoleARNToAssume {
+		changedImmutableFields = append(changedImmutableFields, "spec.iamRoleArnToAssume")
+	}
+	if tgb.Spec.AssumeRoleExternalID != oldTGB.Spec.AssumeRoleExternalID {
+		changedImmutableFields = append(changedImmutableFields, "spec.assumeRoleExternalId")
+	}
 	if len(changedImmutableFields) != 0 {
 		return errors.Errorf("%s update may not change these fields: %s", "TargetGroupBinding", strings.Join(changedImmutableFields, ","))
 	}
@@ -147,9 +171,27 @@ func (v *targetGroupBindingValidator) checkNodeSelector(tgb *elbv2api.TargetGrou
 	return nil
 }
 
+// checkALBTargetRef ensures that ALBTargetRef is set if and only if TargetType is alb,
+// and that fields only applicable to Service backends are not set when TargetType is alb.
+func (v *targetGroupBindingValidator) checkALBTargetRef(tgb *elbv2api.TargetGroupBinding) error {
+	if *tgb.Spec.TargetType != elbv2api.TargetTypeALB {
+		if tgb.Spec.ALBTargetRef != nil {
+			return errors.Errorf("TargetGroupBinding cannot set ALBTargetRef when TargetType is %v", *tgb.Spec.TargetType)
+		}
+		return nil
+	}
+	if tgb.Spec.ALBTargetRef == nil {
+		return errors.Errorf("TargetGroupBinding must set ALBTargetRef when TargetType is alb")
+	}
+	if tgb.Spec.ServiceRef.Name != "" || tgb.Spec.NodeSelector != nil || tgb.Spec.Networking != nil {
+		return errors.Errorf("TargetGroupBinding cannot set ServiceRef, NodeSelector or Networking when TargetType is alb")
+	}
+	return nil
+}
+
 // checkTargetGroupIPAddressType ensures IP address type matches with that on the AWS target group
 func (v *targetGroupBindingValidator) checkTargetGroupIPAddressType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	targetGroupIPAddressType, err := v.getTargetGroupIPAddressTypeFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	targetGroupIPAddressType, err := v.getTargetGroupIPAddressTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group IP address type")
 	}
@@ -165,7 +207,7 @@ func (v *targetGroupBindingValidator) checkTargetGroupVpcID(ctx context.Context,
 	if tgb.Spec.VpcID == "" {
 		return nil
 	}
-	vpcID, err := v.getVpcIDFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	vpcID, err := v.getVpcIDFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group VpcID")
 	}
@@ -176,8 +218,8 @@ func (v *targetGroupBindingValidator) checkTargetGroupVpcID(ctx context.Context,
 }
 
 // getTargetGroupIPAddressTypeFromAWS returns the target group IP address type of AWS target group
-func (v *targetGroupBindingValidator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgARN string) (elbv2api.TargetGroupIPAddressType, error) {
-	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgARN)
+func (v *targetGroupBindingValidator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2api.TargetGroupIPAddressType, error) {
+	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -194,11 +236,15 @@ func (v *targetGroupBindingValidator) getTargetGroupIPAddressTypeFromAWS(ctx con
 }
 
 // getTargetGroupFromAWS returns the AWS target group corresponding to the ARN
-func (v *targetGroupBindingValidator) getTargetGroupFromAWS(ctx context.Context, tgARN string) (*elbv2sdk.TargetGroup, error) {
+func (v *targetGroupBindingValidator) getTargetGroupFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2sdk.TargetGroup, error) {
+	elbv2Client, err := elbv2ClientForTargetGroupBinding(ctx, v.elbv2Client, v.cloudProvider, v.assumeRoleGrantChecker, tgb)
+	if err != nil {
+		return nil, err
+	}
 	req := &elbv2sdk.DescribeTargetGroupsInput{
-		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		TargetGroupArns: awssdk.StringSlice([]string{tgb.Spec.TargetGroupARN}),
 	}
-	tgList, err := v.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	tgList, err := elbv2Client.DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +254,8 @@ func (v *targetGroupBindingValidator) getTargetGroupFromAWS(ctx context.Context,
 	return tgList[0], nil
 }
 
-func (v *targetGroupBindingValidator) getVpcIDFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgARN)
+func (v *targetGroupBindingValidator) getVpcIDFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, error) {
+	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -284,9 +285,10 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 				elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
 			}
 			v := &targetGroupBindingValidator{
-				k8sClient:   k8sClient,
-				elbv2Client: elbv2Client,
-				logger:      logr.New(&log.NullLogSink{}),
+				k8sClient:              k8sClient,
+				elbv2Client:            elbv2Client,
+				assumeRoleGrantChecker: targetgroupbinding.NewDefaultAssumeRoleGrantChecker(k8sClient),
+				logger:                 logr.New(&log.NullLogSink{}),
 			}
 			err := v.ValidateCreate(context.Background(), tt.args.obj)
 			if tt.wantErr != nil {
@@ -767,6 +769,68 @@ func Test_targetGroupBindingValidator_checkImmutableFields(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "iamRoleArnToAssume modified",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:     "tg-2",
+						TargetType:         &ipTargetType,
+						IAMRoleARNToAssume: "arn:aws:iam::210987654321:role/lbc-target-registration",
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:     "tg-2",
+						TargetType:         &ipTargetType,
+						IAMRoleARNToAssume: "arn:aws:iam::123456789012:role/lbc-target-registration",
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.iamRoleArnToAssume"),
+		},
+		{
+			name: "iamRoleArnToAssume and assumeRoleExternalId added",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:       "tg-2",
+						TargetType:           &ipTargetType,
+						IAMRoleARNToAssume:   "arn:aws:iam::123456789012:role/lbc-target-registration",
+						AssumeRoleExternalID: "external-id",
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "tg-2",
+						TargetType:     &ipTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.iamRoleArnToAssume,spec.assumeRoleExternalId"),
+		},
+		{
+			name: "assumeRoleExternalId modified",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:       "tg-2",
+						TargetType:           &ipTargetType,
+						IAMRoleARNToAssume:   "arn:aws:iam::123456789012:role/lbc-target-registration",
+						AssumeRoleExternalID: "external-id-2",
+					},
+				},
+				oldTGB: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:       "tg-2",
+						TargetType:           &ipTargetType,
+						IAMRoleARNToAssume:   "arn:aws:iam::123456789012:role/lbc-target-registration",
+						AssumeRoleExternalID: "external-id-1",
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding update may not change these fields: spec.assumeRoleExternalId"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -858,6 +922,114 @@ func Test_targetGroupBindingValidator_checkNodeSelector(t *testing.T) {
 	}
 }
 
+func Test_targetGroupBindingValidator_checkALBTargetRef(t *testing.T) {
+	type args struct {
+		tgb *elbv2api.TargetGroupBinding
+	}
+	instanceTargetType := elbv2api.TargetTypeInstance
+	albTargetType := elbv2api.TargetTypeALB
+	albTargetRef := elbv2api.ALBTargetReference{
+		IngressGroupName: awssdk.String("awesome-group"),
+	}
+	nodeSelector := v1.LabelSelector{}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "[ok] targetType is instance, albTargetRef is nil",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &instanceTargetType,
+						ServiceRef: elbv2api.ServiceReference{
+							Name: "awesome-svc",
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] targetType is alb, albTargetRef is set",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType:   &albTargetType,
+						ALBTargetRef: &albTargetRef,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] targetType is instance, albTargetRef is set",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType:   &instanceTargetType,
+						ALBTargetRef: &albTargetRef,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding cannot set ALBTargetRef when TargetType is instance"),
+		},
+		{
+			name: "[err] targetType is alb, albTargetRef is nil",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &albTargetType,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding must set ALBTargetRef when TargetType is alb"),
+		},
+		{
+			name: "[err] targetType is alb, serviceRef is set",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType:   &albTargetType,
+						ALBTargetRef: &albTargetRef,
+						ServiceRef: elbv2api.ServiceReference{
+							Name: "awesome-svc",
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding cannot set ServiceRef, NodeSelector or Networking when TargetType is alb"),
+		},
+		{
+			name: "[err] targetType is alb, nodeSelector is set",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType:   &albTargetType,
+						ALBTargetRef: &albTargetRef,
+						NodeSelector: &nodeSelector,
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding cannot set ServiceRef, NodeSelector or Networking when TargetType is alb"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkALBTargetRef(tt.args.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 
 	type env struct {
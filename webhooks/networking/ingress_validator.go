@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/policy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,14 +24,26 @@ const (
 )
 
 // NewIngressValidator returns a validator for Ingress API.
-func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, logger logr.Logger) *ingressValidator {
+func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, modelBuilder ingress.ModelBuilder, logger logr.Logger) *ingressValidator {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass)
+	manageIngressesWithoutIngressClass := ingConfig.IngressClass == ""
+	backendGrantChecker := ingress.NewDefaultBackendGrantChecker(client)
+	// non-existent backend actions are tolerated here, since ListenerActions might be created after the Ingress.
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(client, annotationParser, ingress.NewDefaultAuthConfigBuilder(annotationParser), backendGrantChecker, false, true)
 	return &ingressValidator{
-		annotationParser:                   annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
-		classAnnotationMatcher:             ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass),
+		annotationParser:                   annotationParser,
+		annotationValidator:                ingress.NewDefaultAnnotationValidator(annotationParser),
+		classAnnotationMatcher:             classAnnotationMatcher,
 		classLoader:                        ingress.NewDefaultClassLoader(client, false),
+		backendGrantChecker:                backendGrantChecker,
+		enhancedBackendBuilder:             enhancedBackendBuilder,
+		groupLoader:                        ingress.NewDefaultGroupLoader(client, nil, annotationParser, ingress.NewDefaultClassLoader(client, true), classAnnotationMatcher, manageIngressesWithoutIngressClass),
+		modelBuilder:                       modelBuilder,
+		policyEvaluator:                    policy.NewDefaultLoadBalancerPolicyEvaluator(client, logger),
 		disableIngressClassAnnotation:      ingConfig.DisableIngressClassAnnotation,
 		disableIngressGroupAnnotation:      ingConfig.DisableIngressGroupNameAnnotation,
-		manageIngressesWithoutIngressClass: ingConfig.IngressClass == "",
+		manageIngressesWithoutIngressClass: manageIngressesWithoutIngressClass,
 		logger:                             logger,
 	}
 }
@@ -39,8 +52,14 @@ var _ webhook.Validator = &ingressValidator{}
 
 type ingressValidator struct {
 	annotationParser              annotations.Parser
+	annotationValidator           ingress.AnnotationValidator
 	classAnnotationMatcher        ingress.ClassAnnotationMatcher
 	classLoader                   ingress.ClassLoader
+	backendGrantChecker           ingress.BackendGrantChecker
+	enhancedBackendBuilder        ingress.EnhancedBackendBuilder
+	groupLoader                   ingress.GroupLoader
+	modelBuilder                  ingress.ModelBuilder
+	policyEvaluator               policy.LoadBalancerPolicyEvaluator
 	disableIngressClassAnnotation bool
 	disableIngressGroupAnnotation bool
 	// manageIngressesWithoutIngressClass specifies whether ingresses without "kubernetes.io/ingress.class" annotation
@@ -70,6 +89,15 @@ func (v *ingressValidator) ValidateCreate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.annotationValidator.Validate(ing, nil); err != nil {
+		return err
+	}
+	if err := v.checkCrossNamespaceServiceReferences(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerPolicies(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -91,6 +119,15 @@ func (v *ingressValidator) ValidateUpdate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.annotationValidator.Validate(ing, oldIng); err != nil {
+		return err
+	}
+	if err := v.checkCrossNamespaceServiceReferences(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkLoadBalancerPolicies(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -203,8 +240,17 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 			continue
 		}
 		for _, path := range rule.HTTP.Paths {
+			var backendName string
+			switch {
+			case path.Backend.Service != nil:
+				backendName = path.Backend.Service.Name
+			case path.Backend.Resource != nil:
+				backendName = path.Backend.Resource.Name
+			default:
+				continue
+			}
 			var conditions []ingress.RuleCondition
-			annotationKey := fmt.Sprintf("conditions.%v", path.Backend.Service.Name)
+			annotationKey := fmt.Sprintf("conditions.%v", backendName)
 			_, err := v.annotationParser.ParseJSONAnnotation(annotationKey, &conditions, ing.Annotations)
 			if err != nil {
 				return err
@@ -215,7 +261,7 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 					return fmt.Errorf("ignoring Ingress %s/%s since invalid alb.ingress.kubernetes.io/conditions.%s annotation: %w",
 						ing.Namespace,
 						ing.Name,
-						path.Backend.Service.Name,
+						backendName,
 						err,
 					)
 				}
@@ -226,6 +272,54 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 	return nil
 }
 
+// checkCrossNamespaceServiceReferences checks Services in another namespace referenced by backend actions are permitted by BackendGrant.
+func (v *ingressValidator) checkCrossNamespaceServiceReferences(ctx context.Context, ing *networking.Ingress) error {
+	for _, backend := range ingress.ExtractIngressBackends(ing) {
+		enhancedBackend, err := v.enhancedBackendBuilder.Build(ctx, ing, backend,
+			ingress.WithLoadBackendServices(false, nil),
+			ingress.WithLoadAuthConfig(false))
+		if err != nil {
+			return err
+		}
+		if err := ingress.CheckBackendServiceReferences(ctx, v.backendGrantChecker, ing.Namespace, enhancedBackend.Action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLoadBalancerPolicies checks the load balancer of IngressGroup that Ingress belongs to against LoadBalancerPolicies.
+// failures to build the model are tolerated here, since they're reported when reconciling, where LoadBalancerPolicies are checked again.
+func (v *ingressValidator) checkLoadBalancerPolicies(ctx context.Context, ing *networking.Ingress) error {
+	ingGroup, err := v.groupLoader.LoadWithIngress(ctx, ing)
+	if err != nil {
+		return errors.Wrap(err, "unable to check LoadBalancerPolicies")
+	}
+	if ingGroup == nil {
+		return nil
+	}
+	namespaces := ingGroup.MemberNamespaces()
+	policies, err := v.policyEvaluator.ListPolicies(ctx, namespaces)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	stack, _, _, _, err := v.modelBuilder.Build(ctx, *ingGroup)
+	if err != nil {
+		return errors.Wrap(err, "unable to check LoadBalancerPolicies")
+	}
+	violations, err := v.policyEvaluator.Evaluate(ctx, policies, namespaces, stack)
+	if err != nil {
+		return err
+	}
+	if len(violations) != 0 {
+		return policy.NewViolationError(violations)
+	}
+	return nil
+}
+
 // +kubebuilder:webhook:path=/validate-networking-v1-ingress,mutating=false,failurePolicy=fail,groups=networking.k8s.io,resources=ingresses,verbs=create;update,versions=v1,name=vingress.elbv2.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -1168,3 +1168,111 @@ func Test_ingressValidator_checkIngressAnnotationConditions(t *testing.T) {
 		})
 	}
 }
+
+func Test_ingressValidator_checkCrossNamespaceServiceReferences(t *testing.T) {
+	type env struct {
+		grants []*elbv2api.BackendGrant
+	}
+	type args struct {
+		ing *networking.Ingress
+	}
+	grant := &elbv2api.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shared-ns",
+			Name:      "allow-ns-1",
+		},
+		Spec: elbv2api.BackendGrantSpec{
+			From: []elbv2api.BackendGrantFrom{
+				{
+					Namespace: "ns-1",
+				},
+			},
+			To: []elbv2api.BackendGrantTo{
+				{
+					Name: awssdk.String("auth-proxy"),
+				},
+			},
+		},
+	}
+	buildIngress := func(actionAnnotation string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "ing-1",
+				Annotations: map[string]string{
+					"alb.ingress.kubernetes.io/actions.auth": actionAnnotation,
+				},
+			},
+			Spec: networking.IngressSpec{
+				DefaultBackend: &networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "auth",
+						Port: networking.ServiceBackendPort{
+							Name: "use-annotation",
+						},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		env     env
+		args    args
+		wantErr error
+	}{
+		{
+			name: "reference to service in same namespace",
+			args: args{
+				ing: buildIngress(`{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"svc-1","servicePort":"http"}]}}`),
+			},
+		},
+		{
+			name: "reference to service in another namespace - permitted",
+			env: env{
+				grants: []*elbv2api.BackendGrant{grant},
+			},
+			args: args{
+				ing: buildIngress(`{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"auth-proxy","serviceNamespace":"shared-ns","servicePort":"http"}]}}`),
+			},
+		},
+		{
+			name: "reference to service in another namespace - not permitted",
+			env: env{
+				grants: []*elbv2api.BackendGrant{grant},
+			},
+			args: args{
+				ing: buildIngress(`{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"metrics","serviceNamespace":"shared-ns","servicePort":"http"}]}}`),
+			},
+			wantErr: errors.New("reference to service shared-ns/metrics from namespace ns-1 is not permitted by any BackendGrant"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, grant := range tt.env.grants {
+				assert.NoError(t, k8sClient.Create(ctx, grant.DeepCopy()))
+			}
+
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			backendGrantChecker := ingress.NewDefaultBackendGrantChecker(k8sClient)
+			v := &ingressValidator{
+				annotationParser:    annotationParser,
+				backendGrantChecker: backendGrantChecker,
+				enhancedBackendBuilder: ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser,
+					ingress.NewDefaultAuthConfigBuilder(annotationParser), backendGrantChecker, false, true),
+				logger: logr.Discard(),
+			}
+			err := v.checkCrossNamespaceServiceReferences(ctx, tt.args.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}